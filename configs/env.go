@@ -12,9 +12,12 @@ import (
 
 type EnvConfig struct {
 	Server struct {
-		Port      string
-		JWTSecret string
-		Debug     bool
+		Port                  string
+		JWTSecret             string
+		JWTSigningKeys        string
+		Debug                 bool
+		AccessTokenTTLMinutes int
+		RefreshTokenTTLHours  int
 	}
 	Database struct {
 		Host     string
@@ -34,6 +37,50 @@ type EnvConfig struct {
 		Level       string
 		FileLogging bool
 	}
+	Mail struct {
+		Host        string
+		Port        string
+		Username    string
+		Password    string
+		From        string
+		LinkBaseURL string
+	}
+	LoginThrottle struct {
+		MaxAttemptsPerEmail int
+		MaxAttemptsPerIP    int
+		LockoutMinutes      int
+	}
+	MFA struct {
+		Issuer        string
+		EncryptionKey string
+		RequiredRoles string
+	}
+	Dispatch struct {
+		Strategy        string
+		MaxActiveOrders int
+		IntervalSeconds int
+	}
+	Webhook struct {
+		MaxAttempts           int
+		RetryBaseSeconds      int
+		TimeoutSeconds        int
+		WorkerIntervalSeconds int
+		AllowPrivateNetworks  bool
+	}
+	Outbox struct {
+		RelayIntervalMs int
+		MaxAttempts     int
+	}
+	WebSocket struct {
+		DisconnectStormThreshold     int
+		DisconnectStormWindowSeconds int
+	}
+	Push struct {
+		MaxDeviceFailures int
+	}
+	Zones struct {
+		IndexRefreshSeconds int
+	}
 }
 
 func NewEnvConfig() (*EnvConfig, error) {
@@ -99,9 +146,56 @@ func MapEnvKeys(v *viper.Viper) {
 	// .env keys for server configuration
 	v.Set("server.port", v.GetString("server_port"))
 	v.Set("server.jwtSecret", v.GetString("jwt_secret"))
+	v.Set("server.jwtSigningKeys", v.GetString("jwt_signing_keys"))
 	v.Set("server.debug", v.GetBool("debug"))
+	v.Set("server.accessTokenTTLMinutes", v.GetInt("access_token_ttl_minutes"))
+	v.Set("server.refreshTokenTTLHours", v.GetInt("refresh_token_ttl_hours"))
 
 	// .env keys for log configuration
 	v.Set("log.level", v.GetString("log_level"))
 	v.Set("log.fileLogging", v.GetString("log_file_logging"))
+
+	// .env keys for outgoing mail
+	v.Set("mail.host", v.GetString("mail_host"))
+	v.Set("mail.port", v.GetString("mail_port"))
+	v.Set("mail.username", v.GetString("mail_username"))
+	v.Set("mail.password", v.GetString("mail_password"))
+	v.Set("mail.from", v.GetString("mail_from"))
+	v.Set("mail.linkBaseURL", v.GetString("mail_link_base_url"))
+
+	// .env keys for login brute-force protection
+	v.Set("loginThrottle.maxAttemptsPerEmail", v.GetInt("login_max_attempts_per_email"))
+	v.Set("loginThrottle.maxAttemptsPerIP", v.GetInt("login_max_attempts_per_ip"))
+	v.Set("loginThrottle.lockoutMinutes", v.GetInt("login_lockout_minutes"))
+
+	// .env keys for multi-factor authentication
+	v.Set("mfa.issuer", v.GetString("mfa_issuer"))
+	v.Set("mfa.encryptionKey", v.GetString("mfa_encryption_key"))
+	v.Set("mfa.requiredRoles", v.GetString("mfa_required_roles"))
+
+	// .env keys for automatic driver dispatch
+	v.Set("dispatch.strategy", v.GetString("dispatch_strategy"))
+	v.Set("dispatch.maxActiveOrders", v.GetInt("dispatch_max_active_orders"))
+	v.Set("dispatch.intervalSeconds", v.GetInt("dispatch_interval_seconds"))
+
+	// .env keys for order webhooks
+	v.Set("webhook.maxAttempts", v.GetInt("webhook_max_attempts"))
+	v.Set("webhook.retryBaseSeconds", v.GetInt("webhook_retry_base_seconds"))
+	v.Set("webhook.timeoutSeconds", v.GetInt("webhook_timeout_seconds"))
+	v.Set("webhook.workerIntervalSeconds", v.GetInt("webhook_worker_interval_seconds"))
+	v.Set("webhook.allowPrivateNetworks", v.GetBool("webhook_allow_private_networks"))
+
+	// .env keys for the domain event outbox relay
+	v.Set("outbox.relayIntervalMs", v.GetInt("outbox_relay_interval_ms"))
+	v.Set("outbox.maxAttempts", v.GetInt("outbox_max_attempts"))
+
+	// .env keys for WebSocket disconnect storm detection
+	v.Set("webSocket.disconnectStormThreshold", v.GetInt("websocket_disconnect_storm_threshold"))
+	v.Set("webSocket.disconnectStormWindowSeconds", v.GetInt("websocket_disconnect_storm_window_seconds"))
+
+	// .env keys for push notifications
+	v.Set("push.maxDeviceFailures", v.GetInt("push_max_device_failures"))
+
+	// .env keys for the zone coverage index
+	v.Set("zones.indexRefreshSeconds", v.GetInt("zones_index_refresh_seconds"))
 }
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// OneTimeTokenProvider emite tokens de un solo uso con expiración para un propósito concreto
+type OneTimeTokenProvider interface {
+	Issue(ctx context.Context, purpose, subject string, ttl time.Duration) (string, error) // Emite un token e invalida el anterior del mismo sujeto
+	Consume(ctx context.Context, purpose, token string) (string, error)                    // Consume el token y retorna el sujeto asociado
+}
+
+// AccountRecoverer gestiona la recuperación de contraseña y la verificación de correo
+type AccountRecoverer interface {
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	RequestEmailVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+type AccountUseCase interface {
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	RequestEmailVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// APIKeyManager gestiona las API keys de integración de las empresas
+type APIKeyManager interface {
+	Create(ctx context.Context, input *auth.APIKeyInput) (*auth.IssuedAPIKey, error)
+	List(ctx context.Context, companyID string) ([]entities.CompanyAPIKey, error)
+	GetByID(ctx context.Context, keyID string) (*entities.CompanyAPIKey, error)
+	Rotate(ctx context.Context, keyID string) (*auth.IssuedAPIKey, error)
+	Revoke(ctx context.Context, keyID string) error
+	Authenticate(ctx context.Context, rawKey string) (*auth.AuthClaims, error) // Construye los claims de la identidad de integración
+}
+
+type APIKeyUseCase interface {
+	CreateAPIKey(ctx context.Context, input *auth.APIKeyInput) (*auth.IssuedAPIKey, error)
+	ListAPIKeys(ctx context.Context, companyID string) ([]entities.CompanyAPIKey, error)
+	RotateAPIKey(ctx context.Context, keyID string) (*auth.IssuedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, keyID string) error
+}
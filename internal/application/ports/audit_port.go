@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type AuditUseCase interface {
+	GetAuditLogs(ctx context.Context, request *http.Request) ([]entities.AuditLog, *entities.AuditLogQueryParams, int64, error)
+}
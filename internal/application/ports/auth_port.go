@@ -8,11 +8,20 @@ import (
 
 type Authenticator interface {
 	ValidateCredentials(ctx context.Context, email, password string) (*entities.User, error)
-	CreateSession(ctx context.Context, user *entities.User, deviceInfo map[string]interface{}, ipAddress string) (string, error)
+	CreateSession(ctx context.Context, user *entities.User, deviceInfo map[string]interface{}, ipAddress string) (*auth.TokenPair, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*auth.TokenPair, error)
 	InvalidateSession(ctx context.Context, token string) error
+	GetActiveSessions(ctx context.Context, userID string) ([]entities.UserSession, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	TouchSession(ctx context.Context, sessionID string) error
 }
 
 type AuthenticatorUseCase interface {
-	Authenticate(ctx context.Context, credentials *auth.Credentials) (string, error)
+	Authenticate(ctx context.Context, credentials *auth.Credentials) (*auth.LoginResult, error)
+	VerifyMFAChallenge(ctx context.Context, challengeToken, code, ipAddress string) (*auth.LoginResult, error)
+	BeginMFAChallengeEnrollment(ctx context.Context, challengeToken string) (*auth.MFAEnrollment, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*auth.TokenPair, error)
 	SignOut(ctx context.Context, token string) error
+	GetSessions(ctx context.Context) ([]entities.UserSession, error)
+	RevokeSession(ctx context.Context, sessionID string) error
 }
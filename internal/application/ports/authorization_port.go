@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// PermissionResolver resuelve el conjunto de permisos asociados a un rol
+type PermissionResolver interface {
+	GetRolePermissions(ctx context.Context, roleName string) (map[string]bool, error) // Obtiene los permisos del rol con clave "recurso:acción"
+	InvalidateRolePermissions(ctx context.Context, roleName string) error             // Elimina los permisos del rol de la caché
+}
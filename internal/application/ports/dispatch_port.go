@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type DispatchUseCase interface {
+	DispatchOrder(ctx context.Context, orderID string) (*entities.DispatchResult, error)
+	DispatchPendingOrders(ctx context.Context) ([]entities.DispatchResult, error)
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// Definición de la interfaz de casos de uso para Drivers
+type DriverUseCase interface {
+	// CRUD básico
+	GetDrivers(ctx context.Context, request *http.Request) ([]entities.Driver, *entities.DriverQueryParams, int64, error)
+	GetDriverByID(ctx context.Context, driverID string) (*entities.Driver, error)
+	CreateDriver(ctx context.Context, driver *entities.Driver) error
+	UpdateDriver(ctx context.Context, driverID string, driver *entities.Driver) error
+	DeactivateDriver(ctx context.Context, driverID string) error
+	ReactivateDriver(ctx context.Context, driverID string) error
+
+	// Operaciones relacionadas con zonas
+	AssignZonesToDriver(ctx context.Context, driverID, primaryZoneID string, secondaryZoneIDs []string) error
+	GetDriverZones(ctx context.Context, driverID string) ([]entities.DriverZone, error)
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+
+// KeyPublisher expone las claves públicas con las que otros servicios verifican los tokens emitidos
+type KeyPublisher interface {
+	JWKS() *auth.JSONWebKeySet
+}
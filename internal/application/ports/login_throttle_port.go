@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// LoginThrottler limita los intentos fallidos de login por correo y por IP, y los códigos MFA incorrectos por usuario y por IP
+type LoginThrottler interface {
+	Check(ctx context.Context, email, ipAddress string) (time.Duration, error)           // Retorna el tiempo que falta para poder intentar de nuevo
+	RegisterFailure(ctx context.Context, email, ipAddress string) (time.Duration, error) // Aplica la demora progresiva o el bloqueo temporal
+	RegisterSuccess(ctx context.Context, email, ipAddress string) error                  // Reinicia los intentos del correo
+
+	CheckMFA(ctx context.Context, userID, ipAddress string) (time.Duration, error)           // Retorna el tiempo que falta para poder enviar otro código
+	RegisterMFAFailure(ctx context.Context, userID, ipAddress string) (time.Duration, error) // Cuenta un código incorrecto, sin importar el reto usado
+	RegisterMFASuccess(ctx context.Context, userID string) error                             // Reinicia los intentos MFA del usuario
+}
@@ -0,0 +1,15 @@
+package ports
+
+import "context"
+
+// MailMessage representa un correo de texto plano a enviar
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// MailSender define el envío de correos, permite cambiar el proveedor sin afectar los casos de uso
+type MailSender interface {
+	Send(ctx context.Context, message *MailMessage) error
+}
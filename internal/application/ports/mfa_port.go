@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// MFAManager gestiona la inscripción TOTP, los códigos de recuperación y los retos del login en dos pasos
+type MFAManager interface {
+	GetStatus(ctx context.Context, userID string) (*auth.MFAStatus, error)
+	BeginEnrollment(ctx context.Context, userID string) (*auth.MFAEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, userID, code string) ([]string, error)       // Activa el MFA y retorna los códigos de recuperación
+	RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) // Invalida los códigos anteriores
+	Disable(ctx context.Context, userID, code string) error                             // Falla si el rol del usuario exige MFA
+	CreateChallenge(ctx context.Context, user *entities.User, deviceInfo map[string]interface{}, ipAddress string, enrollmentRequired bool) (*auth.MFAChallenge, error)
+	BeginChallengeEnrollment(ctx context.Context, challengeToken string) (*auth.MFAEnrollment, error) // Inscripción durante el login para roles que exigen MFA
+	VerifyChallenge(ctx context.Context, challengeToken, code string) (*auth.MFAChallengeResult, error)
+	ChallengeUserID(ctx context.Context, challengeToken string) (string, error) // Usuario del reto, sin consumirlo
+}
+
+type MFAUseCase interface {
+	GetStatus(ctx context.Context) (*auth.MFAStatus, error)
+	BeginEnrollment(ctx context.Context) (*auth.MFAEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, code string) ([]string, error)
+	RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error)
+	Disable(ctx context.Context, code string) error
+}
@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type NotificationUseCase interface {
+	GetNotifications(ctx context.Context, request *http.Request) ([]entities.Notification, *entities.NotificationQueryParams, int64, error)
+	GetUnreadCount(ctx context.Context) (int64, error)
+	MarkAsRead(ctx context.Context, notificationID string) (*entities.Notification, error)
+	MarkAllAsRead(ctx context.Context) (int64, error)
+}
+
+type NotificationDeviceUseCase interface {
+	RegisterDevice(ctx context.Context, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error)
+	RefreshDevice(ctx context.Context, deviceID string, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error)
+	UnregisterDevice(ctx context.Context, deviceID string) error
+}
+
+type NotificationPreferenceUseCase interface {
+	GetUserPreferences(ctx context.Context) ([]entities.NotificationChannelSettings, error)
+	UpdateUserPreferences(ctx context.Context, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error)
+	GetCompanyPreferences(ctx context.Context, companyID string) ([]entities.NotificationChannelSettings, error)
+	UpdateCompanyPreferences(ctx context.Context, companyID string, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error)
+}
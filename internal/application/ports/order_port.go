@@ -9,6 +9,7 @@ import (
 
 type OrdererUseCase interface {
 	CreateOrder(ctx context.Context, authUserID string, reqOrder *dto.OrderCreateRequest) error
+	QuoteOrder(ctx context.Context, authUserID string, reqQuote *dto.OrderQuoteRequest) (*entities.PriceQuote, error)
 	UpdateOrder(ctx context.Context, orderID string, reqOrder *dto.OrderUpdateRequest) error
 	GetOrdersByCompany(ctx context.Context, userID string, request *http.Request) ([]entities.Order, *entities.OrderQueryParams, int64, error)
 	GetOrderByID(ctx context.Context, orderID string) (*entities.Order, error)
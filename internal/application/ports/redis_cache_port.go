@@ -10,6 +10,7 @@ type Cacher interface {
 	Set(key string, claims []byte, ttl time.Duration) error // Set guarda un token en el cache
 	Get(key string) (string, error)                         // Get obtiene un token del cache
 	Delete(token string) error                              // Delete elimina un token del cache
+	Increment(key string, ttl time.Duration) (int64, error) // Increment incrementa un contador, el ttl se aplica al crearlo
 	GetRedisClient() *redis.Client                          // GetRedisClient retorna el cliente de Redis
 	CacherListService
 }
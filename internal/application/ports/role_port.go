@@ -8,4 +8,17 @@ import (
 type RolerUseCase interface {
 	GetRoles(ctx context.Context) ([]entities.Role, error)
 	GetRoleByIDOrName(ctx context.Context, id string) (*entities.Role, error)
+	CreateRole(ctx context.Context, role *entities.Role) error
+	UpdateRole(ctx context.Context, role *entities.Role) error
+	DeleteRole(ctx context.Context, id string) error
+
+	GetPermissions(ctx context.Context) ([]entities.Permission, error)
+	GetPermissionByID(ctx context.Context, id string) (*entities.Permission, error)
+	CreatePermission(ctx context.Context, permission *entities.Permission) error
+	UpdatePermission(ctx context.Context, permission *entities.Permission) error
+	DeletePermission(ctx context.Context, id string) error
+
+	GetRolePermissions(ctx context.Context, roleID string) ([]entities.Permission, error)
+	AssignPermissionsToRole(ctx context.Context, roleID string, permissionIDs []string) error
+	RemovePermissionFromRole(ctx context.Context, roleID, permissionID string) error
 }
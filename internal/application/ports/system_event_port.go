@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type SystemEventUseCase interface {
+	GetSystemEvents(ctx context.Context, request *http.Request) ([]entities.SystemEvent, *entities.SystemEventQueryParams, int64, error)
+	AggregateSystemEvents(ctx context.Context, request *http.Request) ([]entities.SystemEventSummary, error)
+}
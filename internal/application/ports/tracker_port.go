@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
 )
 
@@ -20,4 +21,7 @@ type TrackerUseCase interface {
 
 	// UpdateDriverLocation actualiza la ubicación de un repartidor
 	UpdateDriverLocation(ctx context.Context, orderID string, latitude, longitude float64) error
+
+	// GetLocationHistory obtiene el pedido y el recorrido registrado del repartidor
+	GetLocationHistory(ctx context.Context, orderID string) (*entities.Order, []entities.LocationPing, error)
 }
@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// WebhookManager gestiona las suscripciones de webhooks y el envío de sus entregas
+type WebhookManager interface {
+	CreateSubscription(ctx context.Context, input *entities.WebhookSubscriptionInput) (*entities.IssuedWebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, subscriptionID string) (*entities.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionID string) error
+	ListDeliveries(ctx context.Context, subscriptionID string) ([]entities.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error)
+	ReplayDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) // Reenvía el mismo evento en una nueva entrega
+	ProcessDueDeliveries(ctx context.Context) (int, error)                                    // Envía las entregas pendientes cuyo reintento ya venció
+}
+
+type WebhookUseCase interface {
+	CreateSubscription(ctx context.Context, input *entities.WebhookSubscriptionInput) (*entities.IssuedWebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionID string) error
+	ListDeliveries(ctx context.Context, subscriptionID string) ([]entities.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error)
+	ReplayDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error)
+}
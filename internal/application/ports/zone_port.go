@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type ZoneUseCase interface {
+	CreateZone(ctx context.Context, input *entities.ZoneInput) (*entities.Zone, error)
+	UpdateZone(ctx context.Context, zoneID string, input *entities.ZoneInput) (*entities.Zone, error)
+	DeleteZone(ctx context.Context, zoneID string) error
+	GetZone(ctx context.Context, zoneID string) (*entities.Zone, error)
+	GetZones(ctx context.Context, includeInactive bool) ([]entities.Zone, error)
+}
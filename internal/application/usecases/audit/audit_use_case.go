@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+const maxAuditPageSize = 100
+
+// AuditUseCase consulta la auditoría de la empresa del usuario autenticado.
+// Los administradores consultan todas las empresas o filtran por una.
+type AuditUseCase struct {
+	auditService interfaces.AuditLogger
+}
+
+func NewAuditUseCase(auditService interfaces.AuditLogger) ports.AuditUseCase {
+	return &AuditUseCase{
+		auditService: auditService,
+	}
+}
+
+// GetAuditLogs obtiene los registros de auditoría según los filtros de la petición
+func (uc *AuditUseCase) GetAuditLogs(ctx context.Context, request *http.Request) ([]entities.AuditLog, *entities.AuditLogQueryParams, int64, error) {
+	// 1. Obtener los claims
+	claims, err := tenant.Claims(ctx, "AuditUseCase", "GetAuditLogs")
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// 2. Parsear los filtros, un rango de fechas inválido no se ignora para no ampliar la consulta
+	params, err := parseAuditLogQueryParams(request)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// 3. Limitar la consulta a la empresa del usuario, salvo para administradores
+	if claims.Role != constants.AdminRole {
+		params.CompanyID = claims.CompanyID
+	}
+
+	// 4. Obtener los registros
+	auditLogs, total, err := uc.auditService.GetAuditLogs(ctx, params)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return auditLogs, params, total, nil
+}
+
+func parseAuditLogQueryParams(r *http.Request) (*entities.AuditLogQueryParams, error) {
+	query := r.URL.Query()
+	params := &entities.AuditLogQueryParams{
+		CompanyID:  query.Get("company_id"),
+		UserID:     query.Get("user_id"),
+		EntityType: query.Get("entity_type"),
+		EntityID:   query.Get("entity_id"),
+		Action:     query.Get("action"),
+	}
+
+	// Fechas
+	var err error
+	if params.StartDate, err = parseAuditDate(query.Get("start_date")); err != nil {
+		return nil, err
+	}
+	if params.EndDate, err = parseAuditDate(query.Get("end_date")); err != nil {
+		return nil, err
+	}
+	if params.StartDate != nil && params.EndDate != nil && params.StartDate.After(*params.EndDate) {
+		return nil, errPackage.NewGeneralServiceError("AuditUseCase", "GetAuditLogs", errPackage.ErrInvalidAuditDateFilter)
+	}
+
+	// Paginación
+	params.Page = 1
+	if page, err := strconv.Atoi(query.Get("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+	params.PageSize = 20
+	if pageSize, err := strconv.Atoi(query.Get("page_size")); err == nil && pageSize > 0 {
+		params.PageSize = min(pageSize, maxAuditPageSize)
+	}
+
+	// Ordenamiento, siempre por fecha
+	params.SortBy = "created_at"
+	params.SortDirection = query.Get("sort_direction")
+	if params.SortDirection != "asc" {
+		params.SortDirection = "desc"
+	}
+
+	return params, nil
+}
+
+func parseAuditDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("AuditUseCase", "GetAuditLogs", errPackage.ErrInvalidAuditDateFilter)
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+)
+
+type AccountUseCase struct {
+	accountService ports.AccountRecoverer
+}
+
+func NewAccountUseCase(accountService ports.AccountRecoverer) *AccountUseCase {
+	return &AccountUseCase{
+		accountService: accountService,
+	}
+}
+
+func (uc *AccountUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	return uc.accountService.RequestPasswordReset(ctx, email)
+}
+
+func (uc *AccountUseCase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	return uc.accountService.ResetPassword(ctx, token, newPassword)
+}
+
+func (uc *AccountUseCase) RequestEmailVerification(ctx context.Context, email string) error {
+	return uc.accountService.RequestEmailVerification(ctx, email)
+}
+
+func (uc *AccountUseCase) VerifyEmail(ctx context.Context, token string) error {
+	return uc.accountService.VerifyEmail(ctx, token)
+}
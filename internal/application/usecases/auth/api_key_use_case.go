@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// APIKeyUseCase gestiona las API keys de la empresa del usuario autenticado
+type APIKeyUseCase struct {
+	apiKeyService ports.APIKeyManager
+}
+
+func NewAPIKeyUseCase(apiKeyService ports.APIKeyManager) *APIKeyUseCase {
+	return &APIKeyUseCase{
+		apiKeyService: apiKeyService,
+	}
+}
+
+func (uc *APIKeyUseCase) CreateAPIKey(ctx context.Context, input *auth.APIKeyInput) (*auth.IssuedAPIKey, error) {
+	claims, err := tenant.Claims(ctx, "APIKeyUseCase", "CreateAPIKey")
+	if err != nil {
+		return nil, err
+	}
+
+	if input.CompanyID, err = tenant.ResolveCompany(claims, input.CompanyID, "APIKeyUseCase", "CreateAPIKey"); err != nil {
+		return nil, err
+	}
+	input.CreatedBy = claims.UserID
+
+	return uc.apiKeyService.Create(ctx, input)
+}
+
+func (uc *APIKeyUseCase) ListAPIKeys(ctx context.Context, companyID string) ([]entities.CompanyAPIKey, error) {
+	claims, err := tenant.Claims(ctx, "APIKeyUseCase", "ListAPIKeys")
+	if err != nil {
+		return nil, err
+	}
+
+	companyID, err = tenant.ResolveCompany(claims, companyID, "APIKeyUseCase", "ListAPIKeys")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.apiKeyService.List(ctx, companyID)
+}
+
+func (uc *APIKeyUseCase) RotateAPIKey(ctx context.Context, keyID string) (*auth.IssuedAPIKey, error) {
+	if err := uc.checkOwnership(ctx, keyID, "RotateAPIKey"); err != nil {
+		return nil, err
+	}
+
+	return uc.apiKeyService.Rotate(ctx, keyID)
+}
+
+func (uc *APIKeyUseCase) RevokeAPIKey(ctx context.Context, keyID string) error {
+	if err := uc.checkOwnership(ctx, keyID, "RevokeAPIKey"); err != nil {
+		return err
+	}
+
+	return uc.apiKeyService.Revoke(ctx, keyID)
+}
+
+// checkOwnership verifica que la key pertenezca a la empresa del usuario
+func (uc *APIKeyUseCase) checkOwnership(ctx context.Context, keyID, operation string) error {
+	// 1. Obtener los claims
+	claims, err := tenant.Claims(ctx, "APIKeyUseCase", operation)
+	if err != nil {
+		return err
+	}
+
+	// 2. Obtener la key
+	key, err := uc.apiKeyService.GetByID(ctx, keyID)
+	if err != nil {
+		return err
+	}
+
+	// 3. Comparar la empresa
+	if !tenant.CanAccess(claims, key.CompanyID) {
+		return errPackage.NewGeneralServiceError("APIKeyUseCase", operation, errPackage.ErrAPIKeyNotFound)
+	}
+
+	return nil
+}
@@ -2,17 +2,27 @@ package auth
 
 import (
 	"context"
+	"errors"
+
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
 type AuthUseCase struct {
-	authService ports.Authenticator
+	authService    ports.Authenticator
+	mfaService     ports.MFAManager
+	loginThrottler ports.LoginThrottler
 }
 
-func NewAuthUseCase(authService ports.Authenticator) *AuthUseCase {
+func NewAuthUseCase(authService ports.Authenticator, mfaService ports.MFAManager, loginThrottler ports.LoginThrottler) *AuthUseCase {
 	return &AuthUseCase{
-		authService: authService,
+		authService:    authService,
+		mfaService:     mfaService,
+		loginThrottler: loginThrottler,
 	}
 }
 
@@ -22,20 +32,96 @@ func NewAuthUseCase(authService ports.Authenticator) *AuthUseCase {
 // antes o después de llamar al servicio de autenticación.
 // Por poner un ejemplo puede ser eventos de dominio, metricas, etc etc xd
 
-func (uc *AuthUseCase) Authenticate(ctx context.Context, credentials *auth.Credentials) (string, error) {
-	// 1. Validar credenciales
+func (uc *AuthUseCase) Authenticate(ctx context.Context, credentials *auth.Credentials) (*auth.LoginResult, error) {
+	// 1. Rechazar el intento si el correo o la IP están bloqueados por intentos fallidos
+	retryAfter, err := uc.loginThrottler.Check(ctx, credentials.Email, credentials.IPAddress)
+	if err != nil {
+		logs.Warn("Failed to check login attempts", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	if retryAfter > 0 {
+		return nil, errPackage.NewGeneralServiceError("AuthUseCase", "Authenticate", errPackage.NewRetryAfterError(errPackage.ErrTooManyLoginAttempts, retryAfter))
+	}
+
+	// 2. Validar credenciales, solo las credenciales incorrectas cuentan como intento fallido
 	authUser, err := uc.authService.ValidateCredentials(ctx, credentials.Email, credentials.Password)
 	if err != nil {
-		return "", err
+		if errors.Is(err, errPackage.ErrInvalidCredentials) {
+			if _, throttleErr := uc.loginThrottler.RegisterFailure(ctx, credentials.Email, credentials.IPAddress); throttleErr != nil {
+				logs.Warn("Failed to register failed login attempt", map[string]interface{}{
+					"error": throttleErr.Error(),
+				})
+			}
+		}
+		return nil, err
+	}
+
+	// 3. Si el usuario tiene MFA o su rol lo exige, el login continúa con un reto. Los intentos del correo
+	// se reinician recién cuando el reto se supera
+	status, err := uc.mfaService.GetStatus(ctx, authUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Enabled || status.Required {
+		if err = checkMFAAttempts(ctx, uc.loginThrottler, "AuthUseCase", "Authenticate", authUser.ID, credentials.IPAddress); err != nil {
+			return nil, err
+		}
+
+		challenge, err := uc.mfaService.CreateChallenge(ctx, authUser, credentials.DeviceInfo, credentials.IPAddress, !status.Enabled)
+		if err != nil {
+			return nil, err
+		}
+		return &auth.LoginResult{Challenge: challenge}, nil
+	}
+	uc.registerLoginSuccess(ctx, credentials.Email, credentials.IPAddress)
+
+	// 4. Crear sesion y obtener tokens
+	tokens, err := uc.authService.CreateSession(ctx, authUser, credentials.DeviceInfo, credentials.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.LoginResult{Tokens: tokens}, nil
+}
+
+// VerifyMFAChallenge completa el segundo paso del login y crea la sesión. Los códigos incorrectos se
+// cuentan por usuario y por IP, así pedir un reto nuevo no da más intentos
+func (uc *AuthUseCase) VerifyMFAChallenge(ctx context.Context, challengeToken, code, ipAddress string) (*auth.LoginResult, error) {
+	// 1. Obtener el usuario del reto
+	userID, err := uc.mfaService.ChallengeUserID(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Verificar el código del reto respetando el límite de intentos
+	var result *auth.MFAChallengeResult
+	err = limitMFAAttempts(ctx, uc.loginThrottler, "AuthUseCase", "VerifyMFAChallenge", userID, ipAddress, func() error {
+		result, err = uc.mfaService.VerifyChallenge(ctx, challengeToken, code)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	uc.registerLoginSuccess(ctx, result.User.Email, ipAddress)
 
-	// 2. Crear sesion y obtener token
-	token, err := uc.authService.CreateSession(ctx, authUser, credentials.DeviceInfo, credentials.IPAddress)
+	// 3. Crear sesion con los datos del primer paso
+	tokens, err := uc.authService.CreateSession(ctx, result.User, result.DeviceInfo, result.IPAddress)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return token, nil
+	return &auth.LoginResult{Tokens: tokens, RecoveryCodes: result.RecoveryCodes}, nil
+}
+
+// BeginMFAChallengeEnrollment genera el secreto TOTP para un usuario que debe inscribirse durante el login
+func (uc *AuthUseCase) BeginMFAChallengeEnrollment(ctx context.Context, challengeToken string) (*auth.MFAEnrollment, error) {
+	return uc.mfaService.BeginChallengeEnrollment(ctx, challengeToken)
+}
+
+// RefreshSession rota el refresh token y emite un nuevo access token para la sesión
+func (uc *AuthUseCase) RefreshSession(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
+	return uc.authService.RefreshSession(ctx, refreshToken)
 }
 
 // TODO: Aqui irán algunas funciones que se encargarán de manejar la logica de negocio
@@ -46,3 +132,45 @@ func (uc *AuthUseCase) Authenticate(ctx context.Context, credentials *auth.Crede
 func (uc *AuthUseCase) SignOut(ctx context.Context, token string) error {
 	return uc.authService.InvalidateSession(ctx, token)
 }
+
+// GetSessions obtiene las sesiones activas del usuario autenticado
+func (uc *AuthUseCase) GetSessions(ctx context.Context) ([]entities.UserSession, error) {
+	claims, err := tenant.Claims(ctx, "AuthUseCase", "GetSessions")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.authService.GetActiveSessions(ctx, claims.UserID)
+}
+
+// RevokeSession revoca una de las sesiones del usuario autenticado
+func (uc *AuthUseCase) RevokeSession(ctx context.Context, sessionID string) error {
+	claims, err := tenant.Claims(ctx, "AuthUseCase", "RevokeSession")
+	if err != nil {
+		return err
+	}
+
+	return uc.authService.RevokeSession(ctx, claims.UserID, sessionID)
+}
+
+// registerLoginSuccess reinicia los intentos del correo una vez completado el login
+func (uc *AuthUseCase) registerLoginSuccess(ctx context.Context, email, ipAddress string) {
+	if err := uc.loginThrottler.RegisterSuccess(ctx, email, ipAddress); err != nil {
+		logs.Warn("Failed to reset login attempts", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// getClaims obtiene los claims del usuario autenticado, compartido por los casos de uso de este paquete
+func getClaims(ctx context.Context, useCase, operation string) (*auth.AuthClaims, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		logs.Error("Failed to get claims from context", map[string]interface{}{
+			"operation": operation,
+		})
+		return nil, errPackage.NewGeneralServiceError(useCase, operation, errPackage.ErrClaimsNotFound)
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// checkMFAAttempts rechaza la operación si el usuario o la IP están bloqueados por códigos MFA incorrectos
+func checkMFAAttempts(ctx context.Context, throttler ports.LoginThrottler, useCase, operation, userID, ipAddress string) error {
+	retryAfter, err := throttler.CheckMFA(ctx, userID, ipAddress)
+	if err != nil {
+		logs.Warn("Failed to check mfa attempts", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	if retryAfter > 0 {
+		return errPackage.NewGeneralServiceError(useCase, operation, errPackage.NewRetryAfterError(errPackage.ErrTooManyMFAAttempts, retryAfter))
+	}
+
+	return nil
+}
+
+// limitMFAAttempts ejecuta la verificación de un código MFA aplicando el límite de intentos del usuario.
+// Solo los códigos incorrectos cuentan como intento fallido y un código correcto reinicia el contador
+func limitMFAAttempts(ctx context.Context, throttler ports.LoginThrottler, useCase, operation, userID, ipAddress string, verify func() error) error {
+	// 1. Rechazar si el usuario o la IP están bloqueados
+	if err := checkMFAAttempts(ctx, throttler, useCase, operation, userID, ipAddress); err != nil {
+		return err
+	}
+
+	// 2. Verificar el código
+	err := verify()
+	if errors.Is(err, errPackage.ErrInvalidMFACode) {
+		if _, throttleErr := throttler.RegisterMFAFailure(ctx, userID, ipAddress); throttleErr != nil {
+			logs.Warn("Failed to register failed mfa attempt", map[string]interface{}{
+				"error": throttleErr.Error(),
+			})
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	// 3. Reiniciar los intentos del usuario
+	if throttleErr := throttler.RegisterMFASuccess(ctx, userID); throttleErr != nil {
+		logs.Warn("Failed to reset mfa attempts", map[string]interface{}{
+			"error": throttleErr.Error(),
+		})
+	}
+
+	return nil
+}
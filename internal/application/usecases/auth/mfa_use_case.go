@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+)
+
+// MFAUseCase gestiona el MFA del usuario autenticado. Las operaciones que reciben un código comparten
+// el límite de intentos del login
+type MFAUseCase struct {
+	mfaService     ports.MFAManager
+	loginThrottler ports.LoginThrottler
+}
+
+func NewMFAUseCase(mfaService ports.MFAManager, loginThrottler ports.LoginThrottler) *MFAUseCase {
+	return &MFAUseCase{
+		mfaService:     mfaService,
+		loginThrottler: loginThrottler,
+	}
+}
+
+func (uc *MFAUseCase) GetStatus(ctx context.Context) (*auth.MFAStatus, error) {
+	claims, err := tenant.Claims(ctx, "MFAUseCase", "GetStatus")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.mfaService.GetStatus(ctx, claims.UserID)
+}
+
+func (uc *MFAUseCase) BeginEnrollment(ctx context.Context) (*auth.MFAEnrollment, error) {
+	claims, err := tenant.Claims(ctx, "MFAUseCase", "BeginEnrollment")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.mfaService.BeginEnrollment(ctx, claims.UserID)
+}
+
+func (uc *MFAUseCase) ConfirmEnrollment(ctx context.Context, code string) ([]string, error) {
+	claims, err := tenant.Claims(ctx, "MFAUseCase", "ConfirmEnrollment")
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = limitMFAAttempts(ctx, uc.loginThrottler, "MFAUseCase", "ConfirmEnrollment", claims.UserID, "", func() error {
+		codes, err = uc.mfaService.ConfirmEnrollment(ctx, claims.UserID, code)
+		return err
+	})
+
+	return codes, err
+}
+
+func (uc *MFAUseCase) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	claims, err := tenant.Claims(ctx, "MFAUseCase", "RegenerateRecoveryCodes")
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = limitMFAAttempts(ctx, uc.loginThrottler, "MFAUseCase", "RegenerateRecoveryCodes", claims.UserID, "", func() error {
+		codes, err = uc.mfaService.RegenerateRecoveryCodes(ctx, claims.UserID, code)
+		return err
+	})
+
+	return codes, err
+}
+
+func (uc *MFAUseCase) Disable(ctx context.Context, code string) error {
+	claims, err := tenant.Claims(ctx, "MFAUseCase", "Disable")
+	if err != nil {
+		return err
+	}
+
+	return limitMFAAttempts(ctx, uc.loginThrottler, "MFAUseCase", "Disable", claims.UserID, "", func() error {
+		return uc.mfaService.Disable(ctx, claims.UserID, code)
+	})
+}
@@ -0,0 +1,140 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// Columnas por las que se permite ordenar el listado de repartidores
+var driverSortableColumns = map[string]bool{
+	"created_at":           true,
+	"rating":               true,
+	"completed_deliveries": true,
+	"license_expiry":       true,
+	"vehicle_type":         true,
+}
+
+type DriverUseCase struct {
+	driverService interfaces.Driverer
+}
+
+func NewDriverUseCase(driverService interfaces.Driverer) ports.DriverUseCase {
+	return &DriverUseCase{
+		driverService: driverService,
+	}
+}
+
+// GetDrivers obtiene los repartidores con filtros y paginación
+func (uc *DriverUseCase) GetDrivers(ctx context.Context, request *http.Request) ([]entities.Driver, *entities.DriverQueryParams, int64, error) {
+	// 1. Parsear parámetros de consulta
+	params := uc.parseDriverQueryParams(request)
+
+	// 2. Obtener los repartidores
+	drivers, total, err := uc.driverService.GetDrivers(ctx, params)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return drivers, params, total, nil
+}
+
+// GetDriverByID obtiene un repartidor por su ID
+func (uc *DriverUseCase) GetDriverByID(ctx context.Context, driverID string) (*entities.Driver, error) {
+	return uc.driverService.GetDriverByID(ctx, driverID)
+}
+
+// CreateDriver registra el perfil de repartidor de un usuario
+func (uc *DriverUseCase) CreateDriver(ctx context.Context, driver *entities.Driver) error {
+	return uc.driverService.CreateDriver(ctx, driver)
+}
+
+// UpdateDriver actualiza los datos de licencia y vehículo de un repartidor
+func (uc *DriverUseCase) UpdateDriver(ctx context.Context, driverID string, driver *entities.Driver) error {
+	// 1. Asignar el ID al repartidor a actualizar
+	driver.UserID = driverID
+
+	// 2. Actualizar el repartidor
+	return uc.driverService.UpdateDriver(ctx, driver)
+}
+
+// DeactivateDriver desactiva un repartidor
+func (uc *DriverUseCase) DeactivateDriver(ctx context.Context, driverID string) error {
+	return uc.driverService.ActivateOrDeactivateDriver(ctx, driverID, false)
+}
+
+// ReactivateDriver reactiva un repartidor
+func (uc *DriverUseCase) ReactivateDriver(ctx context.Context, driverID string) error {
+	return uc.driverService.ActivateOrDeactivateDriver(ctx, driverID, true)
+}
+
+// AssignZonesToDriver asigna la zona primaria y las secundarias de un repartidor
+func (uc *DriverUseCase) AssignZonesToDriver(ctx context.Context, driverID, primaryZoneID string, secondaryZoneIDs []string) error {
+	return uc.driverService.AssignZones(ctx, driverID, primaryZoneID, secondaryZoneIDs)
+}
+
+// GetDriverZones obtiene las zonas activas de un repartidor
+func (uc *DriverUseCase) GetDriverZones(ctx context.Context, driverID string) ([]entities.DriverZone, error) {
+	// 1. Verificar que el repartidor exista
+	if _, err := uc.driverService.GetDriverByID(ctx, driverID); err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener sus zonas
+	return uc.driverService.GetDriverZones(ctx, driverID)
+}
+
+// parseDriverQueryParams extrae los parámetros de consulta de la request
+func (uc *DriverUseCase) parseDriverQueryParams(r *http.Request) *entities.DriverQueryParams {
+	params := &entities.DriverQueryParams{}
+
+	// Filtros específicos para repartidores
+	params.Name = r.URL.Query().Get("name")
+	params.LicenseNumber = r.URL.Query().Get("license_number")
+	params.VehiclePlate = r.URL.Query().Get("vehicle_plate")
+	params.VehicleType = r.URL.Query().Get("vehicle_type")
+	params.ZoneID = r.URL.Query().Get("zone_id")
+
+	// Estado activo/inactivo
+	isActive := r.URL.Query().Get("is_active")
+	if isActive != "" {
+		active := isActive == "true" || isActive == "1"
+		params.IsActive = &active
+	}
+
+	// Paginación
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		if page, err := strconv.Atoi(pageStr); err == nil && page > 0 {
+			params.Page = page
+		} else {
+			params.Page = 1 // Default
+		}
+	} else {
+		params.Page = 1 // Default
+	}
+
+	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+		if pageSize, err := strconv.Atoi(pageSizeStr); err == nil && pageSize > 0 {
+			params.PageSize = pageSize
+		} else {
+			params.PageSize = 10 // Default
+		}
+	} else {
+		params.PageSize = 10 // Default
+	}
+
+	// Ordenamiento (solo columnas permitidas)
+	if sortBy := r.URL.Query().Get("sort_by"); driverSortableColumns[sortBy] {
+		params.SortBy = sortBy
+	}
+	params.SortDirection = r.URL.Query().Get("sort_direction")
+	if params.SortDirection != "asc" && params.SortDirection != "desc" {
+		params.SortDirection = "desc" // Default
+	}
+
+	return params
+}
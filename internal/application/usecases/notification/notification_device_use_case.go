@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// NotificationDeviceUseCase registra los dispositivos push del usuario autenticado, el usuario del
+// dispositivo siempre se toma de los claims
+type NotificationDeviceUseCase struct {
+	deviceService interfaces.NotificationDeviceManager
+}
+
+func NewNotificationDeviceUseCase(deviceService interfaces.NotificationDeviceManager) ports.NotificationDeviceUseCase {
+	return &NotificationDeviceUseCase{
+		deviceService: deviceService,
+	}
+}
+
+func (uc *NotificationDeviceUseCase) RegisterDevice(ctx context.Context, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error) {
+	claims, err := tenant.Claims(ctx, "NotificationDeviceUseCase", "RegisterDevice")
+	if err != nil {
+		return nil, err
+	}
+
+	input.UserID = claims.UserID
+	return uc.deviceService.RegisterDevice(ctx, input)
+}
+
+func (uc *NotificationDeviceUseCase) RefreshDevice(ctx context.Context, deviceID string, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error) {
+	claims, err := tenant.Claims(ctx, "NotificationDeviceUseCase", "RefreshDevice")
+	if err != nil {
+		return nil, err
+	}
+
+	input.UserID = claims.UserID
+	return uc.deviceService.RefreshDevice(ctx, deviceID, input)
+}
+
+func (uc *NotificationDeviceUseCase) UnregisterDevice(ctx context.Context, deviceID string) error {
+	claims, err := tenant.Claims(ctx, "NotificationDeviceUseCase", "UnregisterDevice")
+	if err != nil {
+		return err
+	}
+
+	return uc.deviceService.UnregisterDevice(ctx, claims.UserID, deviceID)
+}
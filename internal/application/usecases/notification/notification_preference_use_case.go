@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// NotificationPreferenceUseCase gestiona las preferencias de notificación del usuario autenticado y los
+// valores por defecto de su empresa
+type NotificationPreferenceUseCase struct {
+	preferenceService interfaces.NotificationPreferenceManager
+}
+
+func NewNotificationPreferenceUseCase(preferenceService interfaces.NotificationPreferenceManager) ports.NotificationPreferenceUseCase {
+	return &NotificationPreferenceUseCase{
+		preferenceService: preferenceService,
+	}
+}
+
+func (uc *NotificationPreferenceUseCase) GetUserPreferences(ctx context.Context) ([]entities.NotificationChannelSettings, error) {
+	claims, err := tenant.Claims(ctx, "NotificationPreferenceUseCase", "GetUserPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.preferenceService.GetUserPreferences(ctx, claims.UserID, claims.CompanyID)
+}
+
+func (uc *NotificationPreferenceUseCase) UpdateUserPreferences(ctx context.Context, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error) {
+	claims, err := tenant.Claims(ctx, "NotificationPreferenceUseCase", "UpdateUserPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.preferenceService.UpdateUserPreferences(ctx, claims.UserID, claims.CompanyID, settings)
+}
+
+func (uc *NotificationPreferenceUseCase) GetCompanyPreferences(ctx context.Context, companyID string) ([]entities.NotificationChannelSettings, error) {
+	claims, err := tenant.Claims(ctx, "NotificationPreferenceUseCase", "GetCompanyPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	companyID, err = tenant.ResolveCompany(claims, companyID, "NotificationPreferenceUseCase", "GetCompanyPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.preferenceService.GetCompanyPreferences(ctx, companyID)
+}
+
+func (uc *NotificationPreferenceUseCase) UpdateCompanyPreferences(ctx context.Context, companyID string, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error) {
+	claims, err := tenant.Claims(ctx, "NotificationPreferenceUseCase", "UpdateCompanyPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	companyID, err = tenant.ResolveCompany(claims, companyID, "NotificationPreferenceUseCase", "UpdateCompanyPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.preferenceService.UpdateCompanyPreferences(ctx, companyID, settings)
+}
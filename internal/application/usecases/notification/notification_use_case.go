@@ -0,0 +1,135 @@
+package notification
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const maxNotificationPageSize = 100
+
+// NotificationUseCase expone la bandeja de notificaciones del usuario autenticado, cada usuario solo ve
+// y marca sus propias notificaciones
+type NotificationUseCase struct {
+	notifier interfaces.Notifier
+}
+
+func NewNotificationUseCase(notifier interfaces.Notifier) ports.NotificationUseCase {
+	return &NotificationUseCase{
+		notifier: notifier,
+	}
+}
+
+// GetNotifications obtiene la bandeja del usuario según los filtros de la petición
+func (uc *NotificationUseCase) GetNotifications(ctx context.Context, request *http.Request) ([]entities.Notification, *entities.NotificationQueryParams, int64, error) {
+	// 1. Obtener los claims
+	claims, err := tenant.Claims(ctx, "NotificationUseCase", "GetNotifications")
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// 2. Parsear los filtros, la bandeja siempre es la del usuario autenticado
+	params, err := parseNotificationQueryParams(request)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	params.UserID = claims.UserID
+
+	// 3. Obtener las notificaciones
+	notifications, total, err := uc.notifier.GetUserNotifications(ctx, params)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return notifications, params, total, nil
+}
+
+// GetUnreadCount cuenta las notificaciones no leídas del usuario
+func (uc *NotificationUseCase) GetUnreadCount(ctx context.Context) (int64, error) {
+	claims, err := tenant.Claims(ctx, "NotificationUseCase", "GetUnreadCount")
+	if err != nil {
+		return 0, err
+	}
+
+	return uc.notifier.CountUnread(ctx, claims.UserID)
+}
+
+// MarkAsRead marca como leída una notificación del usuario
+func (uc *NotificationUseCase) MarkAsRead(ctx context.Context, notificationID string) (*entities.Notification, error) {
+	claims, err := tenant.Claims(ctx, "NotificationUseCase", "MarkAsRead")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.notifier.MarkAsRead(ctx, claims.UserID, notificationID)
+}
+
+// MarkAllAsRead marca como leídas todas las notificaciones del usuario
+func (uc *NotificationUseCase) MarkAllAsRead(ctx context.Context) (int64, error) {
+	claims, err := tenant.Claims(ctx, "NotificationUseCase", "MarkAllAsRead")
+	if err != nil {
+		return 0, err
+	}
+
+	return uc.notifier.MarkAllAsRead(ctx, claims.UserID)
+}
+
+func parseNotificationQueryParams(r *http.Request) (*entities.NotificationQueryParams, error) {
+	query := r.URL.Query()
+	params := &entities.NotificationQueryParams{
+		Type: strings.ToUpper(query.Get("type")),
+	}
+
+	// Filtros, un filtro inválido no se ignora para no ampliar la consulta
+	if params.Type != "" && !constants.ValidNotificationTypes[params.Type] {
+		return nil, errPackage.NewGeneralServiceError("NotificationUseCase", "GetNotifications", errPackage.ErrInvalidNotificationFilter)
+	}
+	if unread := query.Get("unread"); unread != "" {
+		unreadOnly, err := strconv.ParseBool(unread)
+		if err != nil {
+			return nil, errPackage.NewGeneralServiceError("NotificationUseCase", "GetNotifications", errPackage.ErrInvalidNotificationFilter)
+		}
+		params.UnreadOnly = unreadOnly
+	}
+
+	// Paginación
+	params.Page = 1
+	if page, err := strconv.Atoi(query.Get("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+	params.PageSize = 20
+	if pageSize, err := strconv.Atoi(query.Get("page_size")); err == nil && pageSize > 0 {
+		params.PageSize = min(pageSize, maxNotificationPageSize)
+	}
+
+	// Ordenamiento, siempre por fecha
+	params.SortBy = "created_at"
+	params.SortDirection = query.Get("sort_direction")
+	if params.SortDirection != "asc" {
+		params.SortDirection = "desc"
+	}
+
+	return params, nil
+}
+
+func getClaims(ctx context.Context, operation string) (*auth.AuthClaims, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		logs.Error("Failed to get claims from context", map[string]interface{}{
+			"operation": operation,
+		})
+		return nil, errPackage.NewGeneralServiceError("NotificationUseCase", operation, errPackage.ErrClaimsNotFound)
+	}
+
+	return claims, nil
+}
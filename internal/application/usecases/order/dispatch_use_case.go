@@ -0,0 +1,30 @@
+package order
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// DispatchUseCase implementa el caso de uso para la asignación automática de repartidores
+type DispatchUseCase struct {
+	dispatchService interfaces.Dispatcher
+}
+
+func NewDispatchUseCase(dispatchService interfaces.Dispatcher) ports.DispatchUseCase {
+	return &DispatchUseCase{
+		dispatchService: dispatchService,
+	}
+}
+
+// DispatchOrder asigna automáticamente un repartidor a un pedido
+func (uc *DispatchUseCase) DispatchOrder(ctx context.Context, orderID string) (*entities.DispatchResult, error) {
+	return uc.dispatchService.DispatchOrder(ctx, orderID)
+}
+
+// DispatchPendingOrders ejecuta el despacho sobre todos los pedidos pendientes sin repartidor
+func (uc *DispatchUseCase) DispatchPendingOrders(ctx context.Context) ([]entities.DispatchResult, error) {
+	return uc.dispatchService.DispatchPendingOrders(ctx)
+}
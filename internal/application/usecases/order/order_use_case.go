@@ -2,13 +2,13 @@ package order
 
 import (
 	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
 	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
-	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
 	"net/http"
 	"strconv"
@@ -18,12 +18,18 @@ import (
 type OrderUseCase struct {
 	orderService   interfaces.Orderer
 	companyService interfaces.Companyrer
+	pricingService interfaces.Pricer
+	zoneResolver   interfaces.ZoneResolver
+	orderAccess    interfaces.OrderAccessAuthorizer
 }
 
-func NewOrderUseCase(orderService interfaces.Orderer, companyService interfaces.Companyrer) *OrderUseCase {
+func NewOrderUseCase(orderService interfaces.Orderer, companyService interfaces.Companyrer, pricingService interfaces.Pricer, zoneResolver interfaces.ZoneResolver, orderAccess interfaces.OrderAccessAuthorizer) *OrderUseCase {
 	return &OrderUseCase{
 		orderService:   orderService,
 		companyService: companyService,
+		pricingService: pricingService,
+		zoneResolver:   zoneResolver,
+		orderAccess:    orderAccess,
 	}
 }
 
@@ -35,7 +41,7 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, authUserID string, reqO
 	}
 
 	//1. Obtener la dirección de la empresa según el ID
-	companyAddress, err := uc.companyService.GetAddressByID(ctx, reqOrder.CompanyPickUpID, claims.UserID)
+	companyAddress, err := uc.companyService.GetAddressByID(ctx, reqOrder.CompanyPickUpID, claims.CompanyID)
 	if err != nil {
 		return err
 	}
@@ -46,13 +52,26 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, authUserID string, reqO
 		return err
 	}
 
-	// 3. Obtener el branch y company ID del usuario
-	order.CompanyID, order.BranchID, err = uc.companyService.GetCompanyAndBranchForUser(ctx, authUserID)
+	// 3. Resolver la zona de la dirección de entrega, fuera de cobertura no se crea el pedido
+	if err = uc.resolveDeliveryZone(ctx, order); err != nil {
+		return err
+	}
+
+	// 4. Obtener el branch y company ID del usuario o de la API key
+	order.CompanyID, order.BranchID, err = uc.resolveCompanyAndBranch(ctx, claims, authUserID)
+	if err != nil {
+		return err
+	}
+
+	// 5. Calcular el precio y la distancia del envío
+	quote, err := uc.pricingService.QuoteOrder(ctx, order)
 	if err != nil {
 		return err
 	}
+	order.Detail.Price = quote.Total
+	order.Detail.Distance = quote.DistanceKm
 
-	//3. Create order
+	// 6. Crear pedido
 	err = uc.orderService.CreateOrder(ctx, order)
 	if err != nil {
 		return err
@@ -61,20 +80,74 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, authUserID string, reqO
 	return nil
 }
 
+// QuoteOrder calcula el precio de un pedido antes de crearlo
+func (uc *OrderUseCase) QuoteOrder(ctx context.Context, authUserID string, reqQuote *dto.OrderQuoteRequest) (*entities.PriceQuote, error) {
+	claims, err := tenant.Claims(ctx, "OrderUseCase", "QuoteOrder")
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Obtener la dirección de recogida de la empresa
+	companyAddress, err := uc.companyService.GetAddressByID(ctx, reqQuote.CompanyPickUpID, claims.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Usar el mapper para convertir el dto a entidad
+	order, err := request_mapper.OrderQuoteRequestToOrder(reqQuote, companyAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Resolver la zona de la dirección de entrega, igual que al crear el pedido
+	if err = uc.resolveDeliveryZone(ctx, order); err != nil {
+		return nil, err
+	}
+
+	// 4. Obtener el branch y company ID del usuario o de la API key
+	order.CompanyID, order.BranchID, err = uc.resolveCompanyAndBranch(ctx, claims, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Calcular el precio con la tarifa de la zona de entrega
+	return uc.pricingService.QuoteOrder(ctx, order)
+}
+
+// resolveDeliveryZone asigna al pedido la zona que cubre su dirección de entrega, una dirección fuera
+// de cobertura retorna ErrAddressOutOfCoverage
+func (uc *OrderUseCase) resolveDeliveryZone(ctx context.Context, order *entities.Order) error {
+	zoneID, err := uc.zoneResolver.ResolveZone(ctx, order.DeliveryAddress.Latitude, order.DeliveryAddress.Longitude)
+	if err != nil {
+		return err
+	}
+
+	order.ZoneID = &zoneID
+	return nil
+}
+
 // UpdateOrder actualiza un pedido
 func (uc *OrderUseCase) UpdateOrder(ctx context.Context, orderID string, reqOrder *dto.OrderUpdateRequest) error {
-	// 1. Usar el mapper para convertir el dto a entidad
+	// 1. Obtener el pedido verificando que el usuario pueda acceder a él
+	current, err := uc.authorizeOrder(ctx, orderID, "UpdateOrder")
+	if err != nil {
+		return err
+	}
+
+	// 2. Usar el mapper para convertir el dto a entidad
 	order, err := request_mapper.UpdateOrderFromRequest(orderID, reqOrder)
 	if err != nil {
 		return err
 	}
 
-	logs.Info("order to update", map[string]interface{}{
-		"deliveryNotesReq":   reqOrder.DeliveryNotes,
-		"orderDeliveryNotes": order.Detail.DeliveryNotes,
-	})
+	// 3. Si cambian los datos del paquete, calcular el precio con el pedido resultante antes de guardar nada
+	if order.PackageDetail != nil {
+		if err = uc.repriceOrder(ctx, current, order); err != nil {
+			return err
+		}
+	}
 
-	// 2. Actualizar el pedido
+	// 4. Actualizar el pedido, los datos del paquete y el precio se guardan en la misma transacción
 	if err = uc.orderService.UpdateOrder(ctx, orderID, order); err != nil {
 		return error2.NewGeneralServiceError("OrderUseCase", "UpdateOrderByID", err)
 	}
@@ -82,23 +155,79 @@ func (uc *OrderUseCase) UpdateOrder(ctx context.Context, orderID string, reqOrde
 	return nil
 }
 
-// GetOrderByID obtiene un pedido por su ID
-func (uc *OrderUseCase) GetOrderByID(ctx context.Context, orderID string) (*entities.Order, error) {
-	// 1. Verificar si el pedido no está eliminado
-	if uc.orderService.OrderIsDeleted(ctx, orderID) {
-		return nil, error2.NewGeneralServiceError("OrderUseCase", "GetOrderByID", errPackage.ErrOrderDeleted)
+// repriceOrder cotiza el pedido actual con los cambios del paquete aplicados y deja el precio en los cambios a guardar
+func (uc *OrderUseCase) repriceOrder(ctx context.Context, current, changes *entities.Order) error {
+	merged := *current
+	merged.PackageDetail = mergePackageDetail(current.PackageDetail, changes.PackageDetail)
+
+	quote, err := uc.pricingService.QuoteOrder(ctx, &merged)
+	if err != nil {
+		return err
+	}
+
+	if changes.Detail == nil {
+		changes.Detail = &entities.Details{
+			OrderID:   changes.ID,
+			UpdatedAt: time.Now(),
+		}
+	}
+	changes.Detail.Price = quote.Total
+	changes.Detail.Distance = quote.DistanceKm
+
+	return nil
+}
+
+// mergePackageDetail aplica los cambios sobre los datos actuales del paquete igual que la actualización parcial:
+// solo reemplaza los campos con valor
+func mergePackageDetail(current, changes *entities.PackageDetail) *entities.PackageDetail {
+	merged := entities.PackageDetail{OrderID: changes.OrderID}
+	if current != nil {
+		merged = *current
 	}
 
-	order, err := uc.orderService.GetOrderByID(ctx, orderID)
+	if changes.IsFragile {
+		merged.IsFragile = true
+	}
+	if changes.IsUrgent {
+		merged.IsUrgent = true
+	}
+	if changes.Weight != 0 {
+		merged.Weight = changes.Weight
+	}
+	if changes.Dimensions != "" {
+		merged.Dimensions = changes.Dimensions
+	}
+	if changes.SpecialInstructions != "" {
+		merged.SpecialInstructions = changes.SpecialInstructions
+	}
+
+	return &merged
+}
+
+// GetOrderByID obtiene un pedido por su ID
+func (uc *OrderUseCase) GetOrderByID(ctx context.Context, orderID string) (*entities.Order, error) {
+	// 1. Obtener el pedido verificando que el usuario o la API key puedan acceder a él
+	order, err := uc.authorizeOrder(ctx, orderID, "GetOrderByID")
 	if err != nil {
 		return nil, err
 	}
 
+	// 2. Verificar si el pedido no está eliminado
+	if uc.orderService.OrderIsDeleted(ctx, orderID) {
+		return nil, error2.NewGeneralServiceError("OrderUseCase", "GetOrderByID", errPackage.ErrOrderDeleted)
+	}
+
 	return order, nil
 }
 
 // ChangeStatus cambia el estado de un pedido
 func (uc *OrderUseCase) ChangeStatus(ctx context.Context, id, status string) error {
+	// 1. Verificar que el usuario pueda acceder al pedido, un repartidor solo a los que tiene asignados
+	if _, err := uc.authorizeOrder(ctx, id, "ChangeStatus"); err != nil {
+		return err
+	}
+
+	// 2. Cambiar el estado
 	err := uc.orderService.ChangeStatus(ctx, id, status)
 	if err != nil {
 		return err
@@ -112,8 +241,12 @@ func (uc *OrderUseCase) GetOrdersByCompany(ctx context.Context, userID string, r
 	// 1. Parsear los parámetros de consulta
 	params := uc.parseOrderQueryParams(request)
 
-	// 2. Obtener el ID de la empresa por el ID del usuario
-	companyID, _, err := uc.companyService.GetCompanyAndBranchForUser(ctx, userID)
+	// 2. Obtener el ID de la empresa por el ID del usuario o de la API key
+	claims, _ := ctx.Value("claims").(*auth.AuthClaims)
+	companyID, _, err := uc.resolveCompanyAndBranch(ctx, claims, userID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
 
 	// 3. Obtener los pedidos
 	orders, total, err := uc.orderService.GetOrdersByCompany(ctx, companyID, params)
@@ -126,8 +259,8 @@ func (uc *OrderUseCase) GetOrdersByCompany(ctx context.Context, userID string, r
 
 // DeleteOrder elimina un pedido
 func (uc *OrderUseCase) DeleteOrder(ctx context.Context, id string) error {
-	// 1. Obtener el pedido
-	order, err := uc.orderService.GetOrderByID(ctx, id)
+	// 1. Obtener el pedido verificando que el usuario pueda acceder a él
+	order, err := uc.authorizeOrder(ctx, id, "DeleteOrder")
 	if err != nil {
 		return err
 	}
@@ -149,7 +282,12 @@ func (uc *OrderUseCase) DeleteOrder(ctx context.Context, id string) error {
 
 // RestoreOrder restaura un pedido
 func (uc *OrderUseCase) RestoreOrder(ctx context.Context, id string) error {
-	// 1. Restaurar el pedido de la base de datos
+	// 1. Verificar que el usuario pueda acceder al pedido
+	if _, err := uc.authorizeOrder(ctx, id, "RestoreOrder"); err != nil {
+		return err
+	}
+
+	// 2. Restaurar el pedido de la base de datos
 	err := uc.orderService.RestoreOrder(ctx, id)
 	if err != nil {
 		return err
@@ -158,6 +296,27 @@ func (uc *OrderUseCase) RestoreOrder(ctx context.Context, id string) error {
 	return nil
 }
 
+// authorizeOrder obtiene el pedido y verifica que el usuario o la API key de la petición puedan acceder a él,
+// los pedidos de otra empresa se rechazan aunque el rol tenga el permiso de la ruta
+func (uc *OrderUseCase) authorizeOrder(ctx context.Context, orderID, operation string) (*entities.Order, error) {
+	claims, err := tenant.Claims(ctx, "OrderUseCase", operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.orderAccess.AuthorizeOrderAccess(ctx, claims, orderID)
+}
+
+// resolveCompanyAndBranch obtiene la empresa y sucursal de la petición. Las API keys las traen en los claims,
+// los usuarios se resuelven por su ID.
+func (uc *OrderUseCase) resolveCompanyAndBranch(ctx context.Context, claims *auth.AuthClaims, userID string) (string, string, error) {
+	if claims != nil && claims.IsAPIKey() {
+		return claims.CompanyID, claims.BranchID, nil
+	}
+
+	return uc.companyService.GetCompanyAndBranchForUser(ctx, userID)
+}
+
 // parseOrderQueryParams extrae los parámetros de consulta de la request
 func (uc *OrderUseCase) parseOrderQueryParams(r *http.Request) *entities.OrderQueryParams {
 	params := &entities.OrderQueryParams{}
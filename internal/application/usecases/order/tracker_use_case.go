@@ -3,8 +3,10 @@ package order
 import (
 	"context"
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	wsModels "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/websocket"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
@@ -16,15 +18,17 @@ import (
 type TrackerUseCase struct {
 	trackerService interfaces.OrderTracker
 	orderService   interfaces.Orderer
+	orderAccess    interfaces.OrderAccessAuthorizer
 	hub            *websocket.Hub
 	upgrader       ws.Upgrader
 }
 
 // NewTrackerUseCase crea una nueva instancia del caso de uso
-func NewTrackerUseCase(trackerService interfaces.OrderTracker, orderService interfaces.Orderer, hub *websocket.Hub) ports.TrackerUseCase {
+func NewTrackerUseCase(trackerService interfaces.OrderTracker, orderService interfaces.Orderer, orderAccess interfaces.OrderAccessAuthorizer, hub *websocket.Hub) ports.TrackerUseCase {
 	return &TrackerUseCase{
 		trackerService: trackerService,
 		orderService:   orderService,
+		orderAccess:    orderAccess,
 		hub:            hub,
 		upgrader: ws.Upgrader{
 			ReadBufferSize:  1024,
@@ -60,7 +64,7 @@ func (uc *TrackerUseCase) HandleWebSocket(w http.ResponseWriter, r *http.Request
 	}
 
 	// 3. Crear un nuevo cliente
-	client := websocket.NewClient(uc.hub, conn, claims.UserID)
+	client := websocket.NewClient(uc.hub, conn, claims)
 
 	// 4. Iniciar el cliente
 	client.Start()
@@ -82,5 +86,41 @@ func (uc *TrackerUseCase) SendLocationUpdate(ctx context.Context, orderID string
 
 // UpdateDriverLocation actualiza la ubicación del repartidor para un pedido
 func (uc *TrackerUseCase) UpdateDriverLocation(ctx context.Context, orderID string, latitude, longitude float64) error {
+	// 1. Obtener los claims del contexto
+	claims, err := tenant.Claims(ctx, "TrackerUseCase", "UpdateDriverLocation")
+	if err != nil {
+		return err
+	}
+
+	// 2. Verificar que el usuario pueda acceder al pedido, un repartidor solo a los que tiene asignados
+	// y el resto de usuarios solo a los de su compañía
+	if _, err := uc.orderAccess.AuthorizeOrderAccess(ctx, claims, orderID); err != nil {
+		return err
+	}
+
+	// 3. Guardar y notificar la ubicación
 	return uc.orderService.UpdateDriverLocation(ctx, orderID, latitude, longitude)
 }
+
+// GetLocationHistory obtiene el recorrido registrado de un pedido
+func (uc *TrackerUseCase) GetLocationHistory(ctx context.Context, orderID string) (*entities.Order, []entities.LocationPing, error) {
+	// 1. Obtener los claims del contexto
+	claims, err := tenant.Claims(ctx, "TrackerUseCase", "GetLocationHistory")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 2. Obtener el pedido y verificar que el usuario pueda consultarlo
+	order, err := uc.orderAccess.AuthorizeOrderAccess(ctx, claims, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 3. Obtener el recorrido
+	pings, err := uc.orderService.GetLocationHistory(ctx, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return order, pings, nil
+}
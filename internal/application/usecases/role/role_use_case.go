@@ -5,15 +5,18 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
 type RolerUseCase struct {
-	roleService interfaces.Roler
+	roleService        interfaces.Roler
+	permissionResolver ports.PermissionResolver
 }
 
-func NewRolerUseCase(roleRepo interfaces.Roler) ports.RolerUseCase {
+func NewRolerUseCase(roleRepo interfaces.Roler, permissionResolver ports.PermissionResolver) ports.RolerUseCase {
 	return &RolerUseCase{
-		roleService: roleRepo,
+		roleService:        roleRepo,
+		permissionResolver: permissionResolver,
 	}
 }
 
@@ -36,3 +39,131 @@ func (r RolerUseCase) GetRoleByIDOrName(ctx context.Context, param string) (*ent
 
 	return role, nil
 }
+
+func (r RolerUseCase) CreateRole(ctx context.Context, role *entities.Role) error {
+	// 1. Crear el rol, un rol nuevo no tiene permisos en caché
+	return r.roleService.CreateRole(ctx, role)
+}
+
+func (r RolerUseCase) UpdateRole(ctx context.Context, role *entities.Role) error {
+	// 1. Actualizar el rol
+	if err := r.roleService.UpdateRole(ctx, role); err != nil {
+		return err
+	}
+
+	// 2. El estado del rol afecta sus permisos efectivos
+	r.invalidateRoles(ctx, *role)
+	return nil
+}
+
+func (r RolerUseCase) DeleteRole(ctx context.Context, id string) error {
+	// 1. Obtener el rol para conocer su nombre
+	role, err := r.roleService.GetRoleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// 2. Eliminar el rol
+	if err = r.roleService.DeleteRole(ctx, id); err != nil {
+		return err
+	}
+
+	// 3. Invalidar los permisos en caché
+	r.invalidateRoles(ctx, *role)
+	return nil
+}
+
+func (r RolerUseCase) GetPermissions(ctx context.Context) ([]entities.Permission, error) {
+	return r.roleService.GetPermissions(ctx)
+}
+
+func (r RolerUseCase) GetPermissionByID(ctx context.Context, id string) (*entities.Permission, error) {
+	return r.roleService.GetPermissionByID(ctx, id)
+}
+
+func (r RolerUseCase) CreatePermission(ctx context.Context, permission *entities.Permission) error {
+	return r.roleService.CreatePermission(ctx, permission)
+}
+
+func (r RolerUseCase) UpdatePermission(ctx context.Context, permission *entities.Permission) error {
+	// 1. Actualizar el permiso
+	if err := r.roleService.UpdatePermission(ctx, permission); err != nil {
+		return err
+	}
+
+	// 2. Invalidar los roles que tienen asignado el permiso
+	return r.invalidatePermissionRoles(ctx, permission.ID)
+}
+
+func (r RolerUseCase) DeletePermission(ctx context.Context, id string) error {
+	// 1. Obtener los roles afectados antes de eliminar las asignaciones
+	roles, err := r.roleService.GetPermissionRoles(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// 2. Eliminar el permiso
+	if err = r.roleService.DeletePermission(ctx, id); err != nil {
+		return err
+	}
+
+	// 3. Invalidar los permisos en caché de los roles afectados
+	r.invalidateRoles(ctx, roles...)
+	return nil
+}
+
+func (r RolerUseCase) GetRolePermissions(ctx context.Context, roleID string) ([]entities.Permission, error) {
+	return r.roleService.GetRolePermissions(ctx, roleID)
+}
+
+func (r RolerUseCase) AssignPermissionsToRole(ctx context.Context, roleID string, permissionIDs []string) error {
+	// 1. Asignar los permisos
+	if err := r.roleService.AssignPermissionsToRole(ctx, roleID, permissionIDs); err != nil {
+		return err
+	}
+
+	// 2. Invalidar los permisos en caché del rol
+	return r.invalidateRoleByID(ctx, roleID)
+}
+
+func (r RolerUseCase) RemovePermissionFromRole(ctx context.Context, roleID, permissionID string) error {
+	// 1. Remover el permiso
+	if err := r.roleService.RemovePermissionFromRole(ctx, roleID, permissionID); err != nil {
+		return err
+	}
+
+	// 2. Invalidar los permisos en caché del rol
+	return r.invalidateRoleByID(ctx, roleID)
+}
+
+func (r RolerUseCase) invalidateRoleByID(ctx context.Context, roleID string) error {
+	role, err := r.roleService.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	r.invalidateRoles(ctx, *role)
+	return nil
+}
+
+func (r RolerUseCase) invalidatePermissionRoles(ctx context.Context, permissionID string) error {
+	roles, err := r.roleService.GetPermissionRoles(ctx, permissionID)
+	if err != nil {
+		return err
+	}
+
+	r.invalidateRoles(ctx, roles...)
+	return nil
+}
+
+// invalidateRoles elimina los permisos en caché de los roles, si falla la caché expira por sí sola
+func (r RolerUseCase) invalidateRoles(ctx context.Context, roles ...entities.Role) {
+	for _, role := range roles {
+		if err := r.permissionResolver.InvalidateRolePermissions(ctx, role.Name); err != nil {
+			logs.Warn("Failed to invalidate role permissions", map[string]interface{}{
+				"role":  role.Name,
+				"error": err.Error(),
+			})
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package system_event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+const maxSystemEventPageSize = 100
+
+// SystemEventUseCase consulta los eventos del sistema, las rutas solo están disponibles para administradores
+type SystemEventUseCase struct {
+	systemEventService interfaces.SystemEventer
+}
+
+func NewSystemEventUseCase(systemEventService interfaces.SystemEventer) ports.SystemEventUseCase {
+	return &SystemEventUseCase{
+		systemEventService: systemEventService,
+	}
+}
+
+// GetSystemEvents obtiene los eventos y sus logs según los filtros de la petición
+func (uc *SystemEventUseCase) GetSystemEvents(ctx context.Context, request *http.Request) ([]entities.SystemEvent, *entities.SystemEventQueryParams, int64, error) {
+	// 1. Parsear los filtros, un filtro inválido no se ignora para no ampliar la consulta
+	params, err := parseSystemEventQueryParams(request, "GetSystemEvents")
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// 2. Obtener los eventos
+	events, total, err := uc.systemEventService.GetSystemEvents(ctx, params)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return events, params, total, nil
+}
+
+// AggregateSystemEvents cuenta los eventos por tipo y severidad con los mismos filtros de la búsqueda
+func (uc *SystemEventUseCase) AggregateSystemEvents(ctx context.Context, request *http.Request) ([]entities.SystemEventSummary, error) {
+	params, err := parseSystemEventQueryParams(request, "AggregateSystemEvents")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.systemEventService.AggregateSystemEvents(ctx, params)
+}
+
+func parseSystemEventQueryParams(r *http.Request, operation string) (*entities.SystemEventQueryParams, error) {
+	query := r.URL.Query()
+	params := &entities.SystemEventQueryParams{
+		EventType: strings.ToUpper(query.Get("event_type")),
+		Source:    strings.ToUpper(query.Get("source")),
+		SourceID:  query.Get("source_id"),
+		Severity:  strings.ToUpper(query.Get("severity")),
+	}
+
+	if params.Severity != "" && !constants.ValidSystemEventSeverities[params.Severity] {
+		return nil, errPackage.NewGeneralServiceError("SystemEventUseCase", operation, errPackage.ErrInvalidSystemEventFilter)
+	}
+
+	// Fechas
+	var err error
+	if params.StartDate, err = parseSystemEventDate(query.Get("start_date"), operation); err != nil {
+		return nil, err
+	}
+	if params.EndDate, err = parseSystemEventDate(query.Get("end_date"), operation); err != nil {
+		return nil, err
+	}
+	if params.StartDate != nil && params.EndDate != nil && params.StartDate.After(*params.EndDate) {
+		return nil, errPackage.NewGeneralServiceError("SystemEventUseCase", operation, errPackage.ErrInvalidSystemEventFilter)
+	}
+
+	// Paginación
+	params.Page = 1
+	if page, err := strconv.Atoi(query.Get("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+	params.PageSize = 20
+	if pageSize, err := strconv.Atoi(query.Get("page_size")); err == nil && pageSize > 0 {
+		params.PageSize = min(pageSize, maxSystemEventPageSize)
+	}
+
+	// Ordenamiento, siempre por fecha
+	params.SortBy = "occurred_at"
+	params.SortDirection = query.Get("sort_direction")
+	if params.SortDirection != "asc" {
+		params.SortDirection = "desc"
+	}
+
+	return params, nil
+}
+
+func parseSystemEventDate(value, operation string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("SystemEventUseCase", operation, errPackage.ErrInvalidSystemEventFilter)
+	}
+
+	return &date, nil
+}
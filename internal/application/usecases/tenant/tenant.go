@@ -0,0 +1,47 @@
+// Package tenant reúne las reglas compartidas por los casos de uso para identificar al usuario autenticado
+// y limitar sus operaciones a su empresa.
+package tenant
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// Claims obtiene los claims del usuario autenticado del contexto
+func Claims(ctx context.Context, useCase, operation string) (*auth.AuthClaims, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		logs.Error("Failed to get claims from context", map[string]interface{}{
+			"use_case":  useCase,
+			"operation": operation,
+		})
+		return nil, errPackage.NewGeneralServiceError(useCase, operation, errPackage.ErrClaimsNotFound)
+	}
+
+	return claims, nil
+}
+
+// ResolveCompany retorna la empresa sobre la que opera el usuario: la suya, o la solicitada si es administrador.
+// Falla si no queda ninguna empresa, por ejemplo un administrador sin empresa propia que no indica company_id
+func ResolveCompany(claims *auth.AuthClaims, requested, useCase, operation string) (string, error) {
+	companyID := claims.CompanyID
+	if claims.Role == constants.AdminRole && requested != "" {
+		companyID = requested
+	}
+
+	if companyID == "" {
+		return "", errPackage.NewGeneralServiceError(useCase, operation, errPackage.ErrCompanyRequired)
+	}
+
+	return companyID, nil
+}
+
+// CanAccess indica si el usuario puede operar sobre un recurso de la empresa, los administradores sobre cualquiera.
+// Los recursos de otras empresas se deben reportar como inexistentes para no revelar que existen
+func CanAccess(claims *auth.AuthClaims, companyID string) bool {
+	return claims.Role == constants.AdminRole || claims.CompanyID == companyID
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/tenant"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// WebhookUseCase gestiona los webhooks de la empresa del usuario autenticado
+type WebhookUseCase struct {
+	webhookService ports.WebhookManager
+}
+
+func NewWebhookUseCase(webhookService ports.WebhookManager) ports.WebhookUseCase {
+	return &WebhookUseCase{
+		webhookService: webhookService,
+	}
+}
+
+func (uc *WebhookUseCase) CreateSubscription(ctx context.Context, input *entities.WebhookSubscriptionInput) (*entities.IssuedWebhookSubscription, error) {
+	claims, err := tenant.Claims(ctx, "WebhookUseCase", "CreateSubscription")
+	if err != nil {
+		return nil, err
+	}
+
+	if input.CompanyID, err = tenant.ResolveCompany(claims, input.CompanyID, "WebhookUseCase", "CreateSubscription"); err != nil {
+		return nil, err
+	}
+	input.CreatedBy = claims.UserID
+
+	return uc.webhookService.CreateSubscription(ctx, input)
+}
+
+func (uc *WebhookUseCase) ListSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error) {
+	claims, err := tenant.Claims(ctx, "WebhookUseCase", "ListSubscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	companyID, err = tenant.ResolveCompany(claims, companyID, "WebhookUseCase", "ListSubscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.webhookService.ListSubscriptions(ctx, companyID)
+}
+
+func (uc *WebhookUseCase) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	if err := uc.checkSubscription(ctx, subscriptionID, "DeleteSubscription"); err != nil {
+		return err
+	}
+
+	return uc.webhookService.DeleteSubscription(ctx, subscriptionID)
+}
+
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, subscriptionID string) ([]entities.WebhookDelivery, error) {
+	if err := uc.checkSubscription(ctx, subscriptionID, "ListDeliveries"); err != nil {
+		return nil, err
+	}
+
+	return uc.webhookService.ListDeliveries(ctx, subscriptionID)
+}
+
+func (uc *WebhookUseCase) GetDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	return uc.checkDelivery(ctx, deliveryID, "GetDelivery")
+}
+
+func (uc *WebhookUseCase) ReplayDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	if _, err := uc.checkDelivery(ctx, deliveryID, "ReplayDelivery"); err != nil {
+		return nil, err
+	}
+
+	return uc.webhookService.ReplayDelivery(ctx, deliveryID)
+}
+
+// checkSubscription verifica que la suscripción pertenezca a la empresa del usuario
+func (uc *WebhookUseCase) checkSubscription(ctx context.Context, subscriptionID, operation string) error {
+	// 1. Obtener los claims
+	claims, err := tenant.Claims(ctx, "WebhookUseCase", operation)
+	if err != nil {
+		return err
+	}
+
+	// 2. Obtener la suscripción
+	subscription, err := uc.webhookService.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	// 3. Comparar la empresa
+	if !tenant.CanAccess(claims, subscription.CompanyID) {
+		return errPackage.NewGeneralServiceError("WebhookUseCase", operation, errPackage.ErrWebhookNotFound)
+	}
+
+	return nil
+}
+
+// checkDelivery obtiene la entrega si pertenece a la empresa del usuario
+func (uc *WebhookUseCase) checkDelivery(ctx context.Context, deliveryID, operation string) (*entities.WebhookDelivery, error) {
+	// 1. Obtener los claims
+	claims, err := tenant.Claims(ctx, "WebhookUseCase", operation)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener la entrega
+	delivery, err := uc.webhookService.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Comparar la empresa
+	if !tenant.CanAccess(claims, delivery.CompanyID) {
+		return nil, errPackage.NewGeneralServiceError("WebhookUseCase", operation, errPackage.ErrWebhookDeliveryNotFound)
+	}
+
+	return delivery, nil
+}
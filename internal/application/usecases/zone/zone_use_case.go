@@ -0,0 +1,40 @@
+package zone
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// ZoneUseCase gestiona las zonas de cobertura, son compartidas por todas las empresas
+type ZoneUseCase struct {
+	zoneService interfaces.ZoneManager
+}
+
+func NewZoneUseCase(zoneService interfaces.ZoneManager) ports.ZoneUseCase {
+	return &ZoneUseCase{
+		zoneService: zoneService,
+	}
+}
+
+func (uc *ZoneUseCase) CreateZone(ctx context.Context, input *entities.ZoneInput) (*entities.Zone, error) {
+	return uc.zoneService.CreateZone(ctx, input)
+}
+
+func (uc *ZoneUseCase) UpdateZone(ctx context.Context, zoneID string, input *entities.ZoneInput) (*entities.Zone, error) {
+	return uc.zoneService.UpdateZone(ctx, zoneID, input)
+}
+
+func (uc *ZoneUseCase) DeleteZone(ctx context.Context, zoneID string) error {
+	return uc.zoneService.DeleteZone(ctx, zoneID)
+}
+
+func (uc *ZoneUseCase) GetZone(ctx context.Context, zoneID string) (*entities.Zone, error) {
+	return uc.zoneService.GetZone(ctx, zoneID)
+}
+
+func (uc *ZoneUseCase) GetZones(ctx context.Context, includeInactive bool) ([]entities.Zone, error) {
+	return uc.zoneService.GetZones(ctx, includeInactive)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/websocket"
 	"gorm.io/gorm"
 	"sync"
+	"time"
 )
 
 type ContainerDependency interface {
@@ -50,6 +51,11 @@ func (c *Container) Initialize() error {
 	if err := c.services.Initialize(); err != nil {
 		return err
 	}
+	c.wsHub.SetEventRecorder(
+		c.services.GetSystemEventService(),
+		c.config.WebSocket.DisconnectStormThreshold,
+		time.Duration(c.config.WebSocket.DisconnectStormWindowSeconds)*time.Second,
+	)
 
 	c.useCases = NewUseCaseContainer(c.services, c.wsHub)
 	if err := c.useCases.Initialize(); err != nil {
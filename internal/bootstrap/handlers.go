@@ -6,13 +6,26 @@ type HandlerContainer struct {
 	usesCases *UseCaseContainer
 	services  *ServiceContainer
 
-	authHandler    *handlers.AuthHandler
-	userHandler    *handlers.UserHandler
-	orderHandler   *handlers.OrderHandler
-	roleHandler    *handlers.RoleHandler
-	companyHandler *handlers.CompanyHandler
-	branchHandler  *handlers.BranchHandler
-	trackerHandler *handlers.TrackerHandler
+	authHandler         *handlers.AuthHandler
+	userHandler         *handlers.UserHandler
+	orderHandler        *handlers.OrderHandler
+	roleHandler         *handlers.RoleHandler
+	companyHandler      *handlers.CompanyHandler
+	branchHandler       *handlers.BranchHandler
+	trackerHandler      *handlers.TrackerHandler
+	driverHandler       *handlers.DriverHandler
+	dispatchHandler     *handlers.DispatchHandler
+	accountHandler      *handlers.AccountHandler
+	mfaHandler          *handlers.MFAHandler
+	apiKeyHandler       *handlers.APIKeyHandler
+	jwksHandler         *handlers.JWKSHandler
+	webhookHandler      *handlers.WebhookHandler
+	auditHandler        *handlers.AuditHandler
+	systemEventHandler  *handlers.SystemEventHandler
+	notificationHandler *handlers.NotificationHandler
+	deviceHandler       *handlers.NotificationDeviceHandler
+	preferenceHandler   *handlers.NotificationPreferenceHandler
+	zoneHandler         *handlers.ZoneHandler
 }
 
 func NewHandlerContainer(userCases *UseCaseContainer, services *ServiceContainer) *HandlerContainer {
@@ -30,6 +43,19 @@ func (c *HandlerContainer) Initialize() error {
 	c.companyHandler = handlers.NewCompanyHandler(c.usesCases.GetCompanyUseCase())
 	c.branchHandler = handlers.NewBranchHandler(c.usesCases.GetBranchUseCase())
 	c.trackerHandler = handlers.NewTrackerHandler(c.usesCases.GetTrackerUseCase())
+	c.driverHandler = handlers.NewDriverHandler(c.usesCases.GetDriverUseCase())
+	c.dispatchHandler = handlers.NewDispatchHandler(c.usesCases.GetDispatchUseCase())
+	c.accountHandler = handlers.NewAccountHandler(c.usesCases.GetAccountUseCase())
+	c.mfaHandler = handlers.NewMFAHandler(c.usesCases.GetMFAUseCase())
+	c.apiKeyHandler = handlers.NewAPIKeyHandler(c.usesCases.GetAPIKeyUseCase())
+	c.jwksHandler = handlers.NewJWKSHandler(c.services.GetKeyPublisher())
+	c.webhookHandler = handlers.NewWebhookHandler(c.usesCases.GetWebhookUseCase())
+	c.auditHandler = handlers.NewAuditHandler(c.usesCases.GetAuditUseCase())
+	c.systemEventHandler = handlers.NewSystemEventHandler(c.usesCases.GetSystemEventUseCase())
+	c.notificationHandler = handlers.NewNotificationHandler(c.usesCases.GetNotificationUseCase())
+	c.deviceHandler = handlers.NewNotificationDeviceHandler(c.usesCases.GetNotificationDeviceUseCase())
+	c.preferenceHandler = handlers.NewNotificationPreferenceHandler(c.usesCases.GetNotificationPreferenceUseCase())
+	c.zoneHandler = handlers.NewZoneHandler(c.usesCases.GetZoneUseCase())
 
 	return nil
 }
@@ -61,3 +87,55 @@ func (c *HandlerContainer) GetRoleHandler() *handlers.RoleHandler {
 func (c *HandlerContainer) GetTrackerHandler() *handlers.TrackerHandler {
 	return c.trackerHandler
 }
+
+func (c *HandlerContainer) GetDriverHandler() *handlers.DriverHandler {
+	return c.driverHandler
+}
+
+func (c *HandlerContainer) GetDispatchHandler() *handlers.DispatchHandler {
+	return c.dispatchHandler
+}
+
+func (c *HandlerContainer) GetAccountHandler() *handlers.AccountHandler {
+	return c.accountHandler
+}
+
+func (c *HandlerContainer) GetMFAHandler() *handlers.MFAHandler {
+	return c.mfaHandler
+}
+
+func (c *HandlerContainer) GetAPIKeyHandler() *handlers.APIKeyHandler {
+	return c.apiKeyHandler
+}
+
+func (c *HandlerContainer) GetJWKSHandler() *handlers.JWKSHandler {
+	return c.jwksHandler
+}
+
+func (c *HandlerContainer) GetWebhookHandler() *handlers.WebhookHandler {
+	return c.webhookHandler
+}
+
+func (c *HandlerContainer) GetAuditHandler() *handlers.AuditHandler {
+	return c.auditHandler
+}
+
+func (c *HandlerContainer) GetSystemEventHandler() *handlers.SystemEventHandler {
+	return c.systemEventHandler
+}
+
+func (c *HandlerContainer) GetNotificationHandler() *handlers.NotificationHandler {
+	return c.notificationHandler
+}
+
+func (c *HandlerContainer) GetNotificationDeviceHandler() *handlers.NotificationDeviceHandler {
+	return c.deviceHandler
+}
+
+func (c *HandlerContainer) GetNotificationPreferenceHandler() *handlers.NotificationPreferenceHandler {
+	return c.preferenceHandler
+}
+
+func (c *HandlerContainer) GetZoneHandler() *handlers.ZoneHandler {
+	return c.zoneHandler
+}
@@ -5,10 +5,12 @@ import "github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middlew
 type MiddlewareContainer struct {
 	services *ServiceContainer
 
-	errMiddleware  *middleware.ErrorMiddleware
-	authMiddleware *middleware.AuthMiddleware
-	tokenExtractor *middleware.TokenExtractor
-	corsMiddleware *middleware.CorsMiddleware
+	errMiddleware   *middleware.ErrorMiddleware
+	authMiddleware  *middleware.AuthMiddleware
+	tokenExtractor  *middleware.TokenExtractor
+	corsMiddleware  *middleware.CorsMiddleware
+	authzMiddleware *middleware.AuthorizationMiddleware
+	auditMiddleware *middleware.AuditMiddleware
 }
 
 func NewMiddlewareContainer(services *ServiceContainer) *MiddlewareContainer {
@@ -19,8 +21,10 @@ func NewMiddlewareContainer(services *ServiceContainer) *MiddlewareContainer {
 
 func (c *MiddlewareContainer) Initialize() error {
 	c.errMiddleware = middleware.NewErrorMiddleware()
-	c.authMiddleware = middleware.NewAuthMiddleware(c.services.GetTokenService())
+	c.authMiddleware = middleware.NewAuthMiddleware(c.services.GetTokenService(), c.services.GetAuthService(), c.services.GetAPIKeyService())
 	c.tokenExtractor = middleware.NewTokenExtractor()
+	c.authzMiddleware = middleware.NewAuthorizationMiddleware(c.services.GetPermissionResolver())
+	c.auditMiddleware = middleware.NewAuditMiddleware(c.services.GetAuditService())
 	c.corsMiddleware = middleware.NewCorsMiddleware(
 		[]string{"*"},
 		nil,
@@ -45,3 +49,11 @@ func (c *MiddlewareContainer) GetTokenExtractor() *middleware.TokenExtractor {
 func (c *MiddlewareContainer) GetCorsMiddleware() *middleware.CorsMiddleware {
 	return c.corsMiddleware
 }
+
+func (c *MiddlewareContainer) GetAuthorizationMiddleware() *middleware.AuthorizationMiddleware {
+	return c.authzMiddleware
+}
+
+func (c *MiddlewareContainer) GetAuditMiddleware() *middleware.AuditMiddleware {
+	return c.auditMiddleware
+}
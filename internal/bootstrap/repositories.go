@@ -11,12 +11,22 @@ type RepositoryContainer struct {
 	db *gorm.DB
 	ws *websocket.Hub
 
-	roleRepo    ports.RolerRepository
-	userRepo    ports.UserRepository
-	orderRepo   ports.OrdererRepository
-	trackerRepo ports.TrackerRepository
-	companyRepo ports.CompanyRepository
-	metricsRepo ports.MetricsRepository
+	roleRepo             ports.RolerRepository
+	userRepo             ports.UserRepository
+	orderRepo            ports.OrdererRepository
+	trackerRepo          ports.TrackerRepository
+	companyRepo          ports.CompanyRepository
+	metricsRepo          ports.MetricsRepository
+	driverRepo           ports.DriverRepository
+	eventRepo            ports.SystemEventRepository
+	apiKeyRepo           ports.APIKeyRepository
+	webhookRepo          ports.WebhookRepository
+	outboxRepo           ports.OutboxRepository
+	auditRepo            ports.AuditLogRepository
+	notificationRepo     ports.NotificationRepository
+	liveNotificationRepo ports.LiveNotificationRepository
+	deviceRepo           ports.NotificationDeviceRepository
+	zoneRepo             ports.ZoneRepository
 }
 
 func NewRepositoryContainer(db *gorm.DB, ws *websocket.Hub) *RepositoryContainer {
@@ -33,8 +43,18 @@ func (c *RepositoryContainer) Initialize() error {
 	c.companyRepo = repositories.NewCompanyRepository(c.db)
 	c.metricsRepo = repositories.NewMetricsRepository(c.db)
 	c.trackerRepo = repositories.NewTrackerRepository(c.ws)
+	c.driverRepo = repositories.NewDriverRepository(c.db)
+	c.eventRepo = repositories.NewSystemEventRepository(c.db)
+	c.apiKeyRepo = repositories.NewAPIKeyRepository(c.db)
+	c.webhookRepo = repositories.NewWebhookRepository(c.db)
+	c.outboxRepo = repositories.NewOutboxRepository(c.db)
+	c.auditRepo = repositories.NewAuditLogRepository(c.db)
+	c.notificationRepo = repositories.NewNotificationRepository(c.db)
+	c.liveNotificationRepo = repositories.NewLiveNotificationRepository(c.ws)
+	c.deviceRepo = repositories.NewNotificationDeviceRepository(c.db)
+	c.zoneRepo = repositories.NewZoneRepository(c.db)
 
-	return nil
+	return repositories.RegisterAuditCallbacks(c.db)
 }
 
 func (c *RepositoryContainer) GetMetricsRepository() ports.MetricsRepository {
@@ -60,3 +80,43 @@ func (c *RepositoryContainer) GetCompanyRepository() ports.CompanyRepository {
 func (c *RepositoryContainer) GetTrackerRepository() ports.TrackerRepository {
 	return c.trackerRepo
 }
+
+func (c *RepositoryContainer) GetDriverRepository() ports.DriverRepository {
+	return c.driverRepo
+}
+
+func (c *RepositoryContainer) GetSystemEventRepository() ports.SystemEventRepository {
+	return c.eventRepo
+}
+
+func (c *RepositoryContainer) GetAPIKeyRepository() ports.APIKeyRepository {
+	return c.apiKeyRepo
+}
+
+func (c *RepositoryContainer) GetWebhookRepository() ports.WebhookRepository {
+	return c.webhookRepo
+}
+
+func (c *RepositoryContainer) GetOutboxRepository() ports.OutboxRepository {
+	return c.outboxRepo
+}
+
+func (c *RepositoryContainer) GetAuditLogRepository() ports.AuditLogRepository {
+	return c.auditRepo
+}
+
+func (c *RepositoryContainer) GetNotificationRepository() ports.NotificationRepository {
+	return c.notificationRepo
+}
+
+func (c *RepositoryContainer) GetLiveNotificationRepository() ports.LiveNotificationRepository {
+	return c.liveNotificationRepo
+}
+
+func (c *RepositoryContainer) GetNotificationDeviceRepository() ports.NotificationDeviceRepository {
+	return c.deviceRepo
+}
+
+func (c *RepositoryContainer) GetZoneRepository() ports.ZoneRepository {
+	return c.zoneRepo
+}
@@ -1,13 +1,21 @@
 package bootstrap
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/MarlonG1/delivery-backend/configs"
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
 	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/auth"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/cache"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/mail"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/mfa"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/notification"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/token"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/webhook"
 )
 
 type ServiceContainer struct {
@@ -15,6 +23,7 @@ type ServiceContainer struct {
 	config       *config.EnvConfig
 
 	jwtService     ports.TokenProvider
+	keyPublisher   ports.KeyPublisher
 	cacheService   ports.Cacher
 	authService    ports.Authenticator
 	userService    domainPorts.Userer
@@ -23,6 +32,27 @@ type ServiceContainer struct {
 	metricsService domainPorts.MetricsService
 	trackerService domainPorts.OrderTracker
 	roleService    domainPorts.Roler
+	pricingService domainPorts.Pricer
+	driverService  domainPorts.Driverer
+	dispatcher     domainPorts.Dispatcher
+	orderAccess    domainPorts.OrderAccessAuthorizer
+
+	permissionResolver ports.PermissionResolver
+	mailSender         ports.MailSender
+	oneTimeTokens      ports.OneTimeTokenProvider
+	accountService     ports.AccountRecoverer
+	mfaService         ports.MFAManager
+	loginThrottler     ports.LoginThrottler
+	apiKeyService      ports.APIKeyManager
+	webhookService     webhook.WebhookService
+	outboxRelay        domainPorts.OutboxRelayer
+	auditService       domainPorts.AuditLogger
+	systemEventService domainPorts.SystemEventer
+	notifier           domainPorts.Notifier
+	deviceService      domainPorts.NotificationDeviceManager
+	preferenceService  domainPorts.NotificationPreferenceManager
+	zoneService        domainPorts.ZoneManager
+	zoneResolver       domainPorts.ZoneResolver
 }
 
 func NewServiceContainer(repositories *RepositoryContainer, config *config.EnvConfig) *ServiceContainer {
@@ -35,19 +65,109 @@ func NewServiceContainer(repositories *RepositoryContainer, config *config.EnvCo
 func (c *ServiceContainer) Initialize() error {
 	var err error
 
-	c.cacheService, err = cache.NewRedisTokenCache(config.NewRedisConfig(c.config))
+	c.systemEventService = services.NewSystemEventService(c.repositories.GetSystemEventRepository())
+
+	redisCache, err := cache.NewRedisTokenCache(config.NewRedisConfig(c.config))
 	if err != nil {
 		return err
 	}
+	redisCache.SetEventRecorder(c.systemEventService)
+	c.cacheService = redisCache
 
-	c.jwtService = token.NewJWTService(c.config.Server.JWTSecret, c.cacheService)
-	c.authService = auth.NewAuthService(c.repositories.GetUserRepository(), c.jwtService)
+	signingKeys, err := token.LoadKeySet(strings.Split(c.config.Server.JWTSigningKeys, ","), c.config.Server.JWTSecret)
+	if err != nil {
+		return err
+	}
+	c.jwtService = token.NewJWTServiceWithKeys(
+		signingKeys,
+		time.Duration(c.config.Server.AccessTokenTTLMinutes)*time.Minute,
+		c.cacheService,
+	)
+	c.keyPublisher = signingKeys
+	c.authService = auth.NewAuthService(
+		c.repositories.GetUserRepository(),
+		c.jwtService,
+		time.Duration(c.config.Server.RefreshTokenTTLHours)*time.Hour,
+	)
+	c.mailSender = c.newMailSender()
+	c.oneTimeTokens = token.NewOneTimeTokenService(c.cacheService)
+	c.accountService = auth.NewAccountService(
+		c.repositories.GetUserRepository(),
+		c.authService,
+		c.oneTimeTokens,
+		c.mailSender,
+		c.config.Mail.LinkBaseURL,
+	)
+	c.loginThrottler = auth.NewLoginThrottler(
+		c.cacheService,
+		c.repositories.GetUserRepository(),
+		c.systemEventService,
+		c.config.LoginThrottle.MaxAttemptsPerEmail,
+		c.config.LoginThrottle.MaxAttemptsPerIP,
+		time.Duration(c.config.LoginThrottle.LockoutMinutes)*time.Minute,
+	)
+	c.mfaService, err = c.newMFAService()
+	if err != nil {
+		return err
+	}
+	c.apiKeyService = auth.NewAPIKeyService(c.repositories.GetAPIKeyRepository(), c.repositories.GetCompanyRepository())
+	c.permissionResolver = auth.NewPermissionResolver(c.repositories.GetRoleRepository(), c.cacheService)
 	c.userService = services.NewUserService(c.repositories.GetUserRepository())
 	c.trackerService = services.NewTrackerService(c.repositories.GetTrackerRepository())
+	c.webhookService = webhook.NewWebhookService(
+		c.repositories.GetWebhookRepository(),
+		&http.Client{Timeout: time.Duration(c.config.Webhook.TimeoutSeconds) * time.Second},
+		c.config.Webhook.MaxAttempts,
+		time.Duration(c.config.Webhook.RetryBaseSeconds)*time.Second,
+		c.config.Webhook.AllowPrivateNetworks,
+	)
 	c.orderService = services.NewOrderService(c.repositories.GetOrderRepository(), c.trackerService)
+	c.notifier = services.NewNotificationService(
+		c.repositories.GetNotificationRepository(),
+		c.repositories.GetUserRepository(),
+		c.repositories.GetLiveNotificationRepository(),
+		notification.NewEmailSender(c.mailSender),
+		notification.NewPushSender(
+			c.repositories.GetNotificationDeviceRepository(),
+			c.config.Push.MaxDeviceFailures,
+			notification.NewLogPushProvider(),
+		),
+	)
+	c.deviceService = services.NewNotificationDeviceService(c.repositories.GetNotificationDeviceRepository())
+	c.preferenceService = services.NewNotificationPreferenceService(c.repositories.GetNotificationRepository())
+	c.outboxRelay = services.NewOutboxRelayService(
+		c.repositories.GetOutboxRepository(),
+		c.config.Outbox.MaxAttempts,
+		services.NewOrderUpdateNotifier(c.repositories.GetOrderRepository(), c.trackerService),
+		c.webhookService,
+		services.NewOrderNotificationPublisher(c.repositories.GetOrderRepository(), c.notifier),
+	)
+	c.orderAccess = services.NewOrderAccessService(c.repositories.GetOrderRepository())
 	c.metricsService = services.NewCompanyMetricsService(c.repositories.GetCompanyRepository(), c.repositories.GetMetricsRepository())
 	c.companyService = services.NewCompanyService(c.repositories.GetCompanyRepository(), c.metricsService)
 	c.roleService = services.NewRoleService(c.repositories.GetRoleRepository())
+	c.pricingService = services.NewPricingService(c.repositories.GetCompanyRepository())
+	c.zoneResolver = services.NewZoneLookupService(
+		c.repositories.GetZoneRepository(),
+		time.Duration(c.config.Zones.IndexRefreshSeconds)*time.Second,
+	)
+	c.zoneService = services.NewZoneService(c.repositories.GetZoneRepository(), c.zoneResolver)
+	c.driverService = services.NewDriverService(c.repositories.GetDriverRepository(), c.repositories.GetUserRepository(), c.repositories.GetCompanyRepository())
+	c.auditService = services.NewAuditService(c.repositories.GetAuditLogRepository())
+
+	dispatchStrategy, err := services.NewDispatchStrategy(c.config.Dispatch.Strategy)
+	if err != nil {
+		return err
+	}
+	c.dispatcher = services.NewDispatchService(
+		c.repositories.GetOrderRepository(),
+		c.repositories.GetDriverRepository(),
+		c.repositories.GetCompanyRepository(),
+		c.orderService,
+		dispatchStrategy,
+		c.systemEventService,
+		c.config.Dispatch.MaxActiveOrders,
+	)
 
 	return nil
 }
@@ -60,6 +180,10 @@ func (c *ServiceContainer) GetTokenService() ports.TokenProvider {
 	return c.jwtService
 }
 
+func (c *ServiceContainer) GetKeyPublisher() ports.KeyPublisher {
+	return c.keyPublisher
+}
+
 func (c *ServiceContainer) GetCacheService() ports.Cacher {
 	return c.cacheService
 }
@@ -87,3 +211,110 @@ func (c *ServiceContainer) GetRoleService() domainPorts.Roler {
 func (c *ServiceContainer) GetTrackerService() domainPorts.OrderTracker {
 	return c.trackerService
 }
+
+func (c *ServiceContainer) GetPricingService() domainPorts.Pricer {
+	return c.pricingService
+}
+
+func (c *ServiceContainer) GetDriverService() domainPorts.Driverer {
+	return c.driverService
+}
+
+func (c *ServiceContainer) GetDispatcher() domainPorts.Dispatcher {
+	return c.dispatcher
+}
+
+func (c *ServiceContainer) GetOrderAccessService() domainPorts.OrderAccessAuthorizer {
+	return c.orderAccess
+}
+
+func (c *ServiceContainer) GetPermissionResolver() ports.PermissionResolver {
+	return c.permissionResolver
+}
+
+func (c *ServiceContainer) GetMailSender() ports.MailSender {
+	return c.mailSender
+}
+
+func (c *ServiceContainer) GetAccountService() ports.AccountRecoverer {
+	return c.accountService
+}
+
+func (c *ServiceContainer) GetMFAService() ports.MFAManager {
+	return c.mfaService
+}
+
+func (c *ServiceContainer) GetLoginThrottler() ports.LoginThrottler {
+	return c.loginThrottler
+}
+
+func (c *ServiceContainer) GetAPIKeyService() ports.APIKeyManager {
+	return c.apiKeyService
+}
+
+// newMFAService cifra los secretos TOTP con MFA_ENCRYPTION_KEY, o con JWT_SECRET si no está configurada
+func (c *ServiceContainer) newMFAService() (ports.MFAManager, error) {
+	encryptionKey := c.config.MFA.EncryptionKey
+	if encryptionKey == "" {
+		encryptionKey = c.config.Server.JWTSecret
+	}
+
+	return mfa.NewMFAService(
+		c.repositories.GetUserRepository(),
+		c.cacheService,
+		encryptionKey,
+		c.config.MFA.Issuer,
+		strings.Split(c.config.MFA.RequiredRoles, ","),
+	)
+}
+
+// newMailSender usa SMTP si está configurado, en caso contrario los correos solo se registran en el log
+func (c *ServiceContainer) newMailSender() ports.MailSender {
+	if c.config.Mail.Host == "" {
+		return mail.NewLogSender()
+	}
+
+	return mail.NewSMTPSender(
+		c.config.Mail.Host,
+		c.config.Mail.Port,
+		c.config.Mail.Username,
+		c.config.Mail.Password,
+		c.config.Mail.From,
+	)
+}
+
+func (c *ServiceContainer) GetWebhookService() ports.WebhookManager {
+	return c.webhookService
+}
+
+func (c *ServiceContainer) GetOutboxRelay() domainPorts.OutboxRelayer {
+	return c.outboxRelay
+}
+
+func (c *ServiceContainer) GetAuditService() domainPorts.AuditLogger {
+	return c.auditService
+}
+
+func (c *ServiceContainer) GetSystemEventService() domainPorts.SystemEventer {
+	return c.systemEventService
+}
+
+func (c *ServiceContainer) GetNotifier() domainPorts.Notifier {
+	return c.notifier
+}
+
+func (c *ServiceContainer) GetNotificationDeviceService() domainPorts.NotificationDeviceManager {
+	return c.deviceService
+}
+
+func (c *ServiceContainer) GetNotificationPreferenceService() domainPorts.NotificationPreferenceManager {
+	return c.preferenceService
+}
+
+func (c *ServiceContainer) GetZoneService() domainPorts.ZoneManager {
+	return c.zoneService
+}
+
+func (c *ServiceContainer) GetZoneResolver() domainPorts.ZoneResolver {
+	return c.zoneResolver
+}
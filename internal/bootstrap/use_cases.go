@@ -2,24 +2,42 @@ package bootstrap
 
 import (
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/audit"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/auth"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/company"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/driver"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/notification"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/role"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/system_event"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/user"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/webhook"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/zone"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/websocket"
 )
 
 type UseCaseContainer struct {
 	services *ServiceContainer
 
-	authUseCase    ports.AuthenticatorUseCase
-	userUseCase    ports.UserUseCase
-	orderUseCase   ports.OrdererUseCase
-	roleUseCase    ports.RolerUseCase
-	companyUseCase ports.CompanyUseCase
-	branchUseCase  ports.BranchUseCase
-	trackerUseCase ports.TrackerUseCase
+	authUseCase         ports.AuthenticatorUseCase
+	userUseCase         ports.UserUseCase
+	orderUseCase        ports.OrdererUseCase
+	roleUseCase         ports.RolerUseCase
+	companyUseCase      ports.CompanyUseCase
+	branchUseCase       ports.BranchUseCase
+	trackerUseCase      ports.TrackerUseCase
+	driverUseCase       ports.DriverUseCase
+	dispatchUseCase     ports.DispatchUseCase
+	accountUseCase      ports.AccountUseCase
+	mfaUseCase          ports.MFAUseCase
+	apiKeyUseCase       ports.APIKeyUseCase
+	webhookUseCase      ports.WebhookUseCase
+	auditUseCase        ports.AuditUseCase
+	systemEventUseCase  ports.SystemEventUseCase
+	notificationUseCase ports.NotificationUseCase
+	deviceUseCase       ports.NotificationDeviceUseCase
+	preferenceUseCase   ports.NotificationPreferenceUseCase
+	zoneUseCase         ports.ZoneUseCase
 
 	wsHub *websocket.Hub
 }
@@ -32,17 +50,33 @@ func NewUseCaseContainer(services *ServiceContainer, wsHub *websocket.Hub) *UseC
 }
 
 func (c *UseCaseContainer) Initialize() error {
-	c.authUseCase = auth.NewAuthUseCase(c.services.GetAuthService())
+	c.authUseCase = auth.NewAuthUseCase(c.services.GetAuthService(), c.services.GetMFAService(), c.services.GetLoginThrottler())
+	c.accountUseCase = auth.NewAccountUseCase(c.services.GetAccountService())
+	c.mfaUseCase = auth.NewMFAUseCase(c.services.GetMFAService(), c.services.GetLoginThrottler())
+	c.apiKeyUseCase = auth.NewAPIKeyUseCase(c.services.GetAPIKeyService())
 	c.userUseCase = user.NewUserProfileUseCase(c.services.GetUserService(),
 		c.services.GetRoleService(),
 		c.services.GetCompanyService(),
 		c.services.GetTokenService(),
 	)
-	c.orderUseCase = order.NewOrderUseCase(c.services.GetOrderService(), c.services.GetCompanyService())
-	c.roleUseCase = role.NewRolerUseCase(c.services.GetRoleService())
+	c.orderUseCase = order.NewOrderUseCase(c.services.GetOrderService(), c.services.GetCompanyService(), c.services.GetPricingService(), c.services.GetZoneResolver(), c.services.GetOrderAccessService())
+	c.roleUseCase = role.NewRolerUseCase(c.services.GetRoleService(), c.services.GetPermissionResolver())
 	c.companyUseCase = company.NewCompanyUseCase(c.services.GetCompanyService())
 	c.branchUseCase = company.NewBranchUseCase(c.services.GetCompanyService())
-	c.trackerUseCase = order.NewTrackerUseCase(c.services.GetTrackerService(), c.services.GetOrderService(), c.wsHub)
+	c.trackerUseCase = order.NewTrackerUseCase(c.services.GetTrackerService(), c.services.GetOrderService(), c.services.GetOrderAccessService(), c.wsHub)
+	c.wsHub.SetSubscriptionAuthorizer(c.services.GetOrderAccessService())
+	if err := c.wsHub.UseBroker(websocket.NewRedisBroker(c.services.GetCacheService().GetRedisClient())); err != nil {
+		return err
+	}
+	c.driverUseCase = driver.NewDriverUseCase(c.services.GetDriverService())
+	c.dispatchUseCase = order.NewDispatchUseCase(c.services.GetDispatcher())
+	c.webhookUseCase = webhook.NewWebhookUseCase(c.services.GetWebhookService())
+	c.auditUseCase = audit.NewAuditUseCase(c.services.GetAuditService())
+	c.systemEventUseCase = system_event.NewSystemEventUseCase(c.services.GetSystemEventService())
+	c.notificationUseCase = notification.NewNotificationUseCase(c.services.GetNotifier())
+	c.deviceUseCase = notification.NewNotificationDeviceUseCase(c.services.GetNotificationDeviceService())
+	c.preferenceUseCase = notification.NewNotificationPreferenceUseCase(c.services.GetNotificationPreferenceService())
+	c.zoneUseCase = zone.NewZoneUseCase(c.services.GetZoneService())
 
 	return nil
 }
@@ -74,3 +108,51 @@ func (c *UseCaseContainer) GetRoleUseCase() ports.RolerUseCase {
 func (c *UseCaseContainer) GetTrackerUseCase() ports.TrackerUseCase {
 	return c.trackerUseCase
 }
+
+func (c *UseCaseContainer) GetDriverUseCase() ports.DriverUseCase {
+	return c.driverUseCase
+}
+
+func (c *UseCaseContainer) GetDispatchUseCase() ports.DispatchUseCase {
+	return c.dispatchUseCase
+}
+
+func (c *UseCaseContainer) GetAccountUseCase() ports.AccountUseCase {
+	return c.accountUseCase
+}
+
+func (c *UseCaseContainer) GetMFAUseCase() ports.MFAUseCase {
+	return c.mfaUseCase
+}
+
+func (c *UseCaseContainer) GetAPIKeyUseCase() ports.APIKeyUseCase {
+	return c.apiKeyUseCase
+}
+
+func (c *UseCaseContainer) GetWebhookUseCase() ports.WebhookUseCase {
+	return c.webhookUseCase
+}
+
+func (c *UseCaseContainer) GetAuditUseCase() ports.AuditUseCase {
+	return c.auditUseCase
+}
+
+func (c *UseCaseContainer) GetSystemEventUseCase() ports.SystemEventUseCase {
+	return c.systemEventUseCase
+}
+
+func (c *UseCaseContainer) GetNotificationUseCase() ports.NotificationUseCase {
+	return c.notificationUseCase
+}
+
+func (c *UseCaseContainer) GetNotificationDeviceUseCase() ports.NotificationDeviceUseCase {
+	return c.deviceUseCase
+}
+
+func (c *UseCaseContainer) GetNotificationPreferenceUseCase() ports.NotificationPreferenceUseCase {
+	return c.preferenceUseCase
+}
+
+func (c *UseCaseContainer) GetZoneUseCase() ports.ZoneUseCase {
+	return c.zoneUseCase
+}
@@ -0,0 +1,25 @@
+package constants
+
+// Acciones registradas en la auditoría
+var (
+	AuditActionCreate     = "create"
+	AuditActionUpdate     = "update"
+	AuditActionDelete     = "delete"
+	AuditActionRestore    = "restore"
+	AuditActionActivate   = "activate"
+	AuditActionDeactivate = "deactivate"
+	AuditActionAssign     = "assign"
+	AuditActionUnassign   = "unassign"
+)
+
+// Tipos de entidad auditados, cada uno se corresponde con una tabla
+var (
+	AuditEntityUser           = "user"
+	AuditEntityCompany        = "company"
+	AuditEntityCompanyAddress = "company_address"
+	AuditEntityBranch         = "branch"
+	AuditEntityOrder          = "order"
+	AuditEntityRole           = "role"
+	AuditEntityPermission     = "permission"
+	AuditEntityZone           = "zone"
+)
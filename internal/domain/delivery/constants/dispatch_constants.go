@@ -0,0 +1,39 @@
+package constants
+
+// Estados de disponibilidad de un repartidor
+var (
+	DriverStatusAvailable = "AVAILABLE"
+	DriverStatusBusy      = "BUSY"
+	DriverStatusOffline   = "OFFLINE"
+)
+
+// Estrategias de asignación automática de repartidores
+var (
+	DispatchStrategyNearest      = "NEAREST"
+	DispatchStrategyRoundRobin   = "ROUND_ROBIN"
+	DispatchStrategyLoadBalanced = "LOAD_BALANCED"
+)
+
+// Parámetros por defecto del despacho automático
+var (
+	DefaultDispatchStrategy        = DispatchStrategyNearest
+	DefaultDispatchMaxActiveOrders = 3  // Pedidos simultáneos permitidos por repartidor
+	DefaultDispatchBatchSize       = 50 // Pedidos procesados por cada ejecución del despacho
+)
+
+// DispatchableOrderStatuses estados en los que un pedido puede recibir un repartidor automáticamente
+var DispatchableOrderStatuses = map[string]bool{
+	OrderStatusPending:  true,
+	OrderStatusAccepted: true,
+}
+
+// DriverReleasingOrderStatuses estados en los que el pedido deja de ocupar al repartidor asignado
+var DriverReleasingOrderStatuses = map[string]bool{
+	OrderStatusDelivered: true,
+	OrderStatusCancelled: true,
+	OrderStatusReturned:  true,
+	OrderStatusCompleted: true,
+	OrderStatusLost:      true,
+	OrderStatusDeleted:   true,
+	OrderStatusRestored:  true,
+}
@@ -0,0 +1,17 @@
+package constants
+
+var (
+	VehicleTypeBicycle    = "BICYCLE"
+	VehicleTypeMotorcycle = "MOTORCYCLE"
+	VehicleTypeCar        = "CAR"
+	VehicleTypeVan        = "VAN"
+	VehicleTypeTruck      = "TRUCK"
+)
+
+var ValidVehicleTypes = map[string]bool{
+	VehicleTypeBicycle:    true,
+	VehicleTypeMotorcycle: true,
+	VehicleTypeCar:        true,
+	VehicleTypeVan:        true,
+	VehicleTypeTruck:      true,
+}
@@ -0,0 +1,69 @@
+package constants
+
+// Canales por los que se envía una notificación, cada uno se habilita en las preferencias del usuario
+var (
+	NotificationChannelEmail = "EMAIL"
+	NotificationChannelPush  = "PUSH"
+	NotificationChannelSMS   = "SMS"
+)
+
+// Tipos de notificación, cada tipo tiene su plantilla y su preferencia por usuario
+var (
+	NotificationOrderCreated          = "ORDER_CREATED"
+	NotificationOrderStatusChanged    = "ORDER_STATUS_CHANGED"
+	NotificationOrderDriverAssigned   = "ORDER_DRIVER_ASSIGNED"
+	NotificationOrderAssignedToDriver = "ORDER_ASSIGNED_TO_DRIVER"
+	NotificationOrderDelivered        = "ORDER_DELIVERED"
+	NotificationOrderDeleted          = "ORDER_DELETED"
+)
+
+// NotificationTypes lista los tipos de notificación en el orden en que se muestran las preferencias
+var NotificationTypes = []string{
+	NotificationOrderCreated,
+	NotificationOrderStatusChanged,
+	NotificationOrderDriverAssigned,
+	NotificationOrderAssignedToDriver,
+	NotificationOrderDelivered,
+	NotificationOrderDeleted,
+}
+
+// ValidNotificationTypes contiene los tipos de notificación que el usuario puede configurar
+var ValidNotificationTypes = map[string]bool{
+	NotificationOrderCreated:          true,
+	NotificationOrderStatusChanged:    true,
+	NotificationOrderDriverAssigned:   true,
+	NotificationOrderAssignedToDriver: true,
+	NotificationOrderDelivered:        true,
+	NotificationOrderDeleted:          true,
+}
+
+// Canales habilitados cuando el usuario no configuró el tipo de notificación, iguales a los valores por defecto de la tabla
+const (
+	DefaultNotificationEmailEnabled = true
+	DefaultNotificationPushEnabled  = true
+	DefaultNotificationSMSEnabled   = false
+)
+
+// Origen de los canales habilitados de un tipo de notificación, de mayor a menor prioridad
+var (
+	NotificationPreferenceSourceUser    = "USER"
+	NotificationPreferenceSourceCompany = "COMPANY"
+	NotificationPreferenceSourceDefault = "DEFAULT"
+)
+
+// Tipos de dispositivo que se registran para recibir notificaciones push
+var (
+	DeviceTypeAndroid = "ANDROID"
+	DeviceTypeIOS     = "IOS"
+	DeviceTypeWeb     = "WEB"
+)
+
+// ValidDeviceTypes contiene los tipos de dispositivo aceptados al registrar un dispositivo
+var ValidDeviceTypes = map[string]bool{
+	DeviceTypeAndroid: true,
+	DeviceTypeIOS:     true,
+	DeviceTypeWeb:     true,
+}
+
+// DefaultMaxDeviceFailures es la cantidad de envíos push fallidos seguidos tras la cual se desactiva un dispositivo
+const DefaultMaxDeviceFailures = 3
@@ -0,0 +1,40 @@
+package constants
+
+// Eventos del ciclo de vida de un pedido que se publican hacia los webhooks de las empresas
+var (
+	OrderEventCreated         = "order.created"
+	OrderEventStatusChanged   = "order.status_changed"
+	OrderEventDriverAssigned  = "order.driver_assigned"
+	OrderEventLocationUpdated = "order.location_updated"
+	OrderEventDelivered       = "order.delivered"
+	OrderEventDeleted         = "order.deleted"
+	OrderEventRestored        = "order.restored"
+)
+
+// ValidOrderEvents eventos a los que se puede suscribir un webhook
+var ValidOrderEvents = map[string]bool{
+	OrderEventCreated:         true,
+	OrderEventStatusChanged:   true,
+	OrderEventDriverAssigned:  true,
+	OrderEventLocationUpdated: true,
+	OrderEventDelivered:       true,
+	OrderEventDeleted:         true,
+	OrderEventRestored:        true,
+}
+
+// Estados de una entrega de webhook
+var (
+	WebhookDeliveryPending   = "PENDING"
+	WebhookDeliverySucceeded = "SUCCEEDED"
+	WebhookDeliveryFailed    = "FAILED"
+)
+
+// Estados de un evento del outbox
+var (
+	OutboxEventPending   = "PENDING"
+	OutboxEventPublished = "PUBLISHED"
+	OutboxEventFailed    = "FAILED"
+)
+
+// OutboxAggregateOrder tipo de agregado de los eventos de pedidos
+const OutboxAggregateOrder = "order"
@@ -0,0 +1,53 @@
+package constants
+
+// Permisos expresados como "recurso:acción", se corresponden con las columnas resource y action de la tabla permissions
+var (
+	PermissionCompaniesCreate = "companies:create"
+	PermissionCompaniesList   = "companies:list"
+	PermissionCompaniesRead   = "companies:read"
+	PermissionCompaniesUpdate = "companies:update"
+
+	PermissionBranchesRead   = "branches:read"
+	PermissionBranchesManage = "branches:manage"
+
+	PermissionOrdersCreate   = "orders:create"
+	PermissionOrdersRead     = "orders:read"
+	PermissionOrdersUpdate   = "orders:update"
+	PermissionOrdersDelete   = "orders:delete"
+	PermissionOrdersDispatch = "orders:dispatch"
+
+	PermissionDriversRead   = "drivers:read"
+	PermissionDriversManage = "drivers:manage"
+
+	PermissionTrackingRead   = "tracking:read"
+	PermissionTrackingUpdate = "tracking:update"
+
+	PermissionUsersCreate = "users:create"
+	PermissionUsersRead   = "users:read"
+	PermissionUsersUpdate = "users:update"
+	PermissionUsersDelete = "users:delete"
+	PermissionUsersRoles  = "users:roles"
+
+	PermissionRolesRead   = "roles:read"
+	PermissionRolesManage = "roles:manage"
+
+	PermissionZonesRead   = "zones:read"
+	PermissionZonesManage = "zones:manage"
+
+	PermissionAPIKeysManage       = "api_keys:manage"
+	PermissionWebhooksManage      = "webhooks:manage"
+	PermissionAuditLogsRead       = "audit_logs:read"
+	PermissionNotificationsManage = "notifications:manage"
+)
+
+// APIKeyScopes son los permisos que se pueden conceder a una API key de integración
+var APIKeyScopes = map[string]bool{
+	PermissionOrdersCreate: true,
+	PermissionOrdersRead:   true,
+	PermissionTrackingRead: true,
+}
+
+// PermissionKey construye la clave de un permiso a partir de su recurso y acción
+func PermissionKey(resource, action string) string {
+	return resource + ":" + action
+}
@@ -0,0 +1,21 @@
+package constants
+
+// Parámetros de la tarifa de envío
+var (
+	PricingCurrency             = "USD"
+	PricingFreeWeightKg         = 5.0    // Peso incluido en la tarifa base (kg)
+	PricingOverweightRatePerKg  = 0.50   // Cargo por cada kg adicional
+	PricingVolumetricDivisor    = 5000.0 // cm³ por kg volumétrico
+	PricingFragileSurchargeRate = 0.15   // Recargo sobre el subtotal por paquete frágil
+	PricingUrgentSurchargeRate  = 0.25   // Recargo sobre el subtotal por entrega urgente
+)
+
+// Conceptos del desglose de precio
+var (
+	PriceConceptBaseRate = "BASE_RATE"
+	PriceConceptDistance = "DISTANCE"
+	PriceConceptWeight   = "OVERWEIGHT"
+	PriceConceptFragile  = "FRAGILE"
+	PriceConceptUrgent   = "URGENT"
+	PriceConceptSurge    = "SURGE"
+)
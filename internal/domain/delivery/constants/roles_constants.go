@@ -7,6 +7,9 @@ var (
 	WarehouseStaff = "WAREHOUSE_STAFF"
 	Collector      = "COLLECTOR"
 	FinalUser      = "FINAL_USER"
+
+	// IntegrationRole es la identidad sintética de las peticiones autenticadas con API key, no se asigna a usuarios
+	IntegrationRole = "INTEGRATION"
 )
 
 var ValidRoles = map[string]bool{
@@ -0,0 +1,35 @@
+package constants
+
+// Severidades de los eventos del sistema, de menor a mayor
+var (
+	SystemEventSeverityInfo     = "INFO"
+	SystemEventSeverityWarning  = "WARNING"
+	SystemEventSeverityError    = "ERROR"
+	SystemEventSeverityCritical = "CRITICAL"
+)
+
+// ValidSystemEventSeverities contiene las severidades aceptadas al registrar o filtrar eventos
+var ValidSystemEventSeverities = map[string]bool{
+	SystemEventSeverityInfo:     true,
+	SystemEventSeverityWarning:  true,
+	SystemEventSeverityError:    true,
+	SystemEventSeverityCritical: true,
+}
+
+// Componentes que emiten eventos del sistema
+var (
+	SystemEventSourceAuth      = "AUTH"
+	SystemEventSourceDispatch  = "DISPATCH"
+	SystemEventSourceWebSocket = "WEBSOCKET"
+	SystemEventSourceCache     = "CACHE"
+)
+
+// Tipos de eventos del sistema
+var (
+	SystemEventLoginFailed              = "LOGIN_FAILED"
+	SystemEventLoginLockout             = "LOGIN_LOCKOUT"
+	SystemEventDispatchFailed           = "DISPATCH_FAILED"
+	SystemEventWebSocketDisconnectStorm = "WEBSOCKET_DISCONNECT_STORM"
+	SystemEventCacheUnavailable         = "CACHE_UNAVAILABLE"
+	SystemEventCacheRecovered           = "CACHE_RECOVERED"
+)
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// AuditLogger define los métodos para registrar y consultar la auditoría de los cambios
+type AuditLogger interface {
+	// Record guarda un registro de auditoría
+	Record(ctx context.Context, log *entities.AuditLog) error
+
+	// Snapshot obtiene el estado actual de la entidad en JSON, nil si no existe o no se pudo leer
+	Snapshot(ctx context.Context, entityType, entityID string) *string
+
+	// GetAuditLogs obtiene los registros de auditoría que cumplen los filtros
+	GetAuditLogs(ctx context.Context, params *entities.AuditLogQueryParams) ([]entities.AuditLog, int64, error)
+}
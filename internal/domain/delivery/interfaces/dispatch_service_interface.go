@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// DispatchStrategy define la política para elegir un repartidor entre los candidatos de un pedido
+type DispatchStrategy interface {
+	// Name retorna el identificador de la estrategia
+	Name() string
+
+	// SelectDriver elige el mejor candidato, la lista recibida nunca está vacía
+	SelectDriver(order *entities.Order, candidates []entities.DispatchCandidate) *entities.DispatchCandidate
+}
+
+// Dispatcher define la asignación automática de repartidores a pedidos
+type Dispatcher interface {
+	// DispatchOrder asigna el mejor repartidor disponible a un pedido
+	DispatchOrder(ctx context.Context, orderID string) (*entities.DispatchResult, error)
+
+	// DispatchPendingOrders intenta asignar repartidor a todos los pedidos pendientes sin asignar
+	DispatchPendingOrders(ctx context.Context) ([]entities.DispatchResult, error)
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type Driverer interface {
+	// CRUD de Driver
+	CreateDriver(ctx context.Context, driver *entities.Driver) error
+	GetDriverByID(ctx context.Context, driverID string) (*entities.Driver, error)
+	GetDrivers(ctx context.Context, params *entities.DriverQueryParams) ([]entities.Driver, int64, error)
+	UpdateDriver(ctx context.Context, driver *entities.Driver) error
+	ActivateOrDeactivateDriver(ctx context.Context, driverID string, active bool) error
+
+	// Gestión de zonas
+	AssignZones(ctx context.Context, driverID, primaryZoneID string, secondaryZoneIDs []string) error
+	GetDriverZones(ctx context.Context, driverID string) ([]entities.DriverZone, error)
+}
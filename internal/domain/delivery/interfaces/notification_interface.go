@@ -0,0 +1,72 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// NotificationSender entrega las notificaciones por un canal (correo, push, SMS)
+type NotificationSender interface {
+	// Channel retorna el canal que atiende el sender
+	Channel() string
+
+	Send(ctx context.Context, notification *entities.Notification, recipient *entities.User) error
+}
+
+// Notifier define los métodos para notificar a los usuarios
+type Notifier interface {
+	// Notify renderiza la plantilla del tipo, guarda la notificación y la entrega por los canales
+	// que el usuario tiene habilitados
+	Notify(ctx context.Context, input *entities.NotificationInput) (*entities.Notification, error)
+
+	// GetUserNotifications obtiene la bandeja del usuario indicado en los filtros
+	GetUserNotifications(ctx context.Context, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error)
+
+	// CountUnread cuenta las notificaciones no leídas del usuario
+	CountUnread(ctx context.Context, userID string) (int64, error)
+
+	// MarkAsRead marca como leída una notificación del usuario, una notificación de otro usuario no se encuentra
+	MarkAsRead(ctx context.Context, userID, notificationID string) (*entities.Notification, error)
+
+	// MarkAllAsRead marca como leídas todas las notificaciones del usuario y retorna cuántas se marcaron
+	MarkAllAsRead(ctx context.Context, userID string) (int64, error)
+}
+
+// PushProvider entrega mensajes push a los dispositivos de una plataforma (FCM, APNs)
+type PushProvider interface {
+	// DeviceTypes retorna los tipos de dispositivo que atiende el proveedor
+	DeviceTypes() []string
+
+	// Push entrega el mensaje al token. Retorna ErrInvalidDeviceToken si el token ya no es válido,
+	// así el dispositivo se desactiva sin esperar más fallos
+	Push(ctx context.Context, token string, message *entities.PushMessage) error
+}
+
+// NotificationDeviceManager define los métodos para registrar los dispositivos push de los usuarios
+type NotificationDeviceManager interface {
+	// RegisterDevice registra el dispositivo del usuario. Un token ya registrado se asigna al usuario y se reactiva
+	RegisterDevice(ctx context.Context, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error)
+
+	// RefreshDevice reemplaza el token de un dispositivo del usuario, por ejemplo cuando el proveedor lo rota
+	RefreshDevice(ctx context.Context, deviceID string, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error)
+
+	// UnregisterDevice elimina un dispositivo del usuario
+	UnregisterDevice(ctx context.Context, userID, deviceID string) error
+}
+
+// NotificationPreferenceManager define los métodos para consultar y configurar los canales de cada tipo de
+// notificación. La preferencia del usuario tiene prioridad sobre el valor por defecto de su empresa
+type NotificationPreferenceManager interface {
+	// GetUserPreferences retorna los canales efectivos del usuario para todos los tipos de notificación
+	GetUserPreferences(ctx context.Context, userID, companyID string) ([]entities.NotificationChannelSettings, error)
+
+	// UpdateUserPreferences guarda las preferencias de los tipos indicados y retorna los canales efectivos
+	UpdateUserPreferences(ctx context.Context, userID, companyID string, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error)
+
+	// GetCompanyPreferences retorna los valores por defecto de la empresa para todos los tipos de notificación
+	GetCompanyPreferences(ctx context.Context, companyID string) ([]entities.NotificationChannelSettings, error)
+
+	// UpdateCompanyPreferences guarda los valores por defecto de los tipos indicados y los retorna todos
+	UpdateCompanyPreferences(ctx context.Context, companyID string, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error)
+}
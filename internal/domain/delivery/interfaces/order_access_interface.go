@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// OrderAccessAuthorizer define quién puede consultar un pedido y observarlo en tiempo real
+type OrderAccessAuthorizer interface {
+	// AuthorizeOrderAccess obtiene el pedido y verifica que el usuario autenticado pueda acceder a él
+	AuthorizeOrderAccess(ctx context.Context, claims *auth.AuthClaims, orderID string) (*entities.Order, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// OrderEventPublisher publica los eventos del ciclo de vida de los pedidos hacia sistemas externos
+type OrderEventPublisher interface {
+	PublishOrderEvent(ctx context.Context, event *entities.OrderEvent) error
+}
+
+// OutboxRelayer publica los eventos pendientes del outbox con semántica at-least-once
+type OutboxRelayer interface {
+	// RelayPending publica los eventos disponibles y retorna cuántos se publicaron
+	RelayPending(ctx context.Context) (int, error)
+}
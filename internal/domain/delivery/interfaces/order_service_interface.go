@@ -15,10 +15,11 @@ type Orderer interface {
 	UpdateOrder(ctx context.Context, orderID string, order *entities.Order) error
 	GetOrderByTrackingNumber(ctx context.Context, trackingNumber string) (*entities.Order, error)
 	GetOrdersByClientID(ctx context.Context, clientID string) ([]entities.Order, error)
-	AssignDriverToOrder(ctx context.Context, orderID, driverID string) error
+	AssignDriverToOrder(ctx context.Context, orderID, driverID string, maxActiveOrders int) error
 	SoftDeleteOrder(ctx context.Context, id string) error
 	OrderIsDeleted(ctx context.Context, orderID string) bool
 	RestoreOrder(ctx context.Context, id string) error
 	IsAvailableForDelete(ctx context.Context, orderID string) error
 	UpdateDriverLocation(ctx context.Context, orderID string, latitude, longitude float64) error
+	GetLocationHistory(ctx context.Context, orderID string) ([]entities.LocationPing, error)
 }
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// Pricer define el cálculo del precio de envío de un pedido
+type Pricer interface {
+	// QuoteOrder calcula el precio de un pedido a partir de sus direcciones, paquete, compañía y sucursal
+	QuoteOrder(ctx context.Context, order *entities.Order) (*entities.PriceQuote, error)
+}
@@ -11,4 +11,20 @@ type Roler interface {
 	GetRoleByIDOrName(ctx context.Context, param string) (*entities.Role, error)
 	IsRoleActive(ctx context.Context, id string) (bool, error)
 	IsRoleExist(ctx context.Context, param string) (bool, error)
+
+	GetRoleByID(ctx context.Context, id string) (*entities.Role, error)
+	CreateRole(ctx context.Context, role *entities.Role) error
+	UpdateRole(ctx context.Context, role *entities.Role) error
+	DeleteRole(ctx context.Context, id string) error
+
+	GetPermissions(ctx context.Context) ([]entities.Permission, error)
+	GetPermissionByID(ctx context.Context, id string) (*entities.Permission, error)
+	CreatePermission(ctx context.Context, permission *entities.Permission) error
+	UpdatePermission(ctx context.Context, permission *entities.Permission) error
+	DeletePermission(ctx context.Context, id string) error
+
+	GetRolePermissions(ctx context.Context, roleID string) ([]entities.Permission, error)
+	GetPermissionRoles(ctx context.Context, permissionID string) ([]entities.Role, error)
+	AssignPermissionsToRole(ctx context.Context, roleID string, permissionIDs []string) error
+	RemovePermissionFromRole(ctx context.Context, roleID, permissionID string) error
 }
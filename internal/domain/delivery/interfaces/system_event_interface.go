@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// EventRecorder define el método con el que los componentes registran eventos del sistema
+type EventRecorder interface {
+	// Record guarda el evento y retorna su ID, vacío si no se pudo guardar. Nunca falla al llamador:
+	// un evento que no se registra no debe interrumpir la operación que lo emitió
+	Record(ctx context.Context, input *entities.SystemEventInput) string
+}
+
+// SystemEventer define los métodos para registrar y consultar los eventos del sistema
+type SystemEventer interface {
+	EventRecorder
+
+	// GetSystemEvents obtiene los eventos que cumplen los filtros
+	GetSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEvent, int64, error)
+
+	// AggregateSystemEvents cuenta los eventos que cumplen los filtros por tipo y severidad
+	AggregateSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEventSummary, error)
+}
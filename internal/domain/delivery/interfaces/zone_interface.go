@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// ZoneManager define los métodos para gestionar las zonas de cobertura
+type ZoneManager interface {
+	// CreateZone valida el polígono de la zona y calcula su centro antes de guardarla
+	CreateZone(ctx context.Context, input *entities.ZoneInput) (*entities.Zone, error)
+
+	// UpdateZone reemplaza los datos y los límites de la zona, sin estado se conserva el actual
+	UpdateZone(ctx context.Context, zoneID string, input *entities.ZoneInput) (*entities.Zone, error)
+
+	// DeleteZone desactiva la zona, las sucursales y repartidores pueden seguir referenciándola
+	DeleteZone(ctx context.Context, zoneID string) error
+
+	GetZone(ctx context.Context, zoneID string) (*entities.Zone, error)
+	GetZones(ctx context.Context, includeInactive bool) ([]entities.Zone, error)
+}
+
+// ZoneResolver define los métodos para ubicar un punto en las zonas de cobertura
+type ZoneResolver interface {
+	// ResolveZone retorna el ID de la zona activa que cubre el punto, ErrAddressOutOfCoverage si ninguna lo cubre
+	ResolveZone(ctx context.Context, latitude, longitude float64) (string, error)
+
+	// InvalidateZones descarta el índice actual, la siguiente búsqueda lo reconstruye con las zonas guardadas
+	InvalidateZones()
+}
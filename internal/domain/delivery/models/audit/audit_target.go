@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"context"
+	"sync"
+)
+
+type targetKey struct{}
+
+// Target identifica la entidad afectada por la petición auditada. En las creaciones el ID
+// no se conoce hasta que se inserta el registro, por eso se completa desde la base de datos.
+type Target struct {
+	mu         sync.Mutex
+	entityType string
+	entityID   string
+}
+
+func NewTarget(entityType, entityID string) *Target {
+	return &Target{
+		entityType: entityType,
+		entityID:   entityID,
+	}
+}
+
+func (t *Target) EntityType() string {
+	return t.entityType
+}
+
+func (t *Target) EntityID() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.entityID
+}
+
+// CaptureID guarda el ID solo si aún no se conoce, así se conserva el primer registro insertado
+func (t *Target) CaptureID(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.entityID == "" {
+		t.entityID = id
+	}
+}
+
+// WithTarget agrega el objetivo de la auditoría al contexto
+func WithTarget(ctx context.Context, target *Target) context.Context {
+	return context.WithValue(ctx, targetKey{}, target)
+}
+
+// TargetFromContext obtiene el objetivo de la auditoría, nil si la petición no se audita
+func TargetFromContext(ctx context.Context) *Target {
+	if ctx == nil {
+		return nil
+	}
+	target, _ := ctx.Value(targetKey{}).(*Target)
+	return target
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// APIKeyInput contiene los datos para crear una API key de integración
+type APIKeyInput struct {
+	CompanyID string
+	BranchID  string
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+	CreatedBy string
+}
+
+// IssuedAPIKey contiene la key en claro, solo se entrega al crearla o rotarla
+type IssuedAPIKey struct {
+	Key      *entities.CompanyAPIKey
+	PlainKey string
+}
@@ -7,6 +7,17 @@ type AuthClaims struct {
 	UserID    string    `json:"user_id"`
 	CompanyID string    `json:"company_id"`
 	Role      string    `json:"auth"`
+	SessionID string    `json:"session_id,omitempty"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiresAt time.Time `json:"expires_at"`
+
+	// Campos de las peticiones autenticadas con API key, UserID toma el ID de la key
+	APIKeyID string   `json:"api_key_id,omitempty"`
+	BranchID string   `json:"branch_id,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
+// IsAPIKey indica si los claims provienen de una API key de integración y no de un usuario
+func (c *AuthClaims) IsAPIKey() bool {
+	return c.APIKeyID != ""
 }
@@ -0,0 +1,23 @@
+package auth
+
+// JSONWebKey es la representación pública de una clave de firma (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// Parámetros de claves RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// Parámetros de claves EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet es el documento publicado en /.well-known/jwks.json
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// LoginResult representa el resultado de un paso del login.
+// Si el usuario tiene MFA, el primer paso devuelve un Challenge en lugar de los tokens.
+type LoginResult struct {
+	Tokens        *TokenPair
+	Challenge     *MFAChallenge
+	RecoveryCodes []string
+}
+
+// MFAChallenge representa el reto emitido tras validar las credenciales de un usuario con MFA
+type MFAChallenge struct {
+	Token              string
+	ExpiresAt          time.Time
+	EnrollmentRequired bool
+}
+
+// MFAChallengeResult contiene los datos de login guardados en el reto una vez superado
+type MFAChallengeResult struct {
+	User          *entities.User
+	DeviceInfo    map[string]interface{}
+	IPAddress     string
+	RecoveryCodes []string
+}
+
+// MFAEnrollment contiene el secreto TOTP generado para que el usuario lo registre en su aplicación
+type MFAEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// MFAStatus describe el estado del MFA de un usuario
+type MFAStatus struct {
+	Enabled                bool
+	Required               bool
+	RecoveryCodesRemaining int64
+}
@@ -0,0 +1,11 @@
+package auth
+
+import "time"
+
+// TokenPair representa el access token de corta duración y el refresh token rotativo emitidos para una sesión
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
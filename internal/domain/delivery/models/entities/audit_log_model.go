@@ -6,15 +6,17 @@ import (
 
 type AuditLog struct {
 	ID         string    `gorm:"column:id;type:char(36);primaryKey"`
-	UserID     string    `gorm:"column:user_id;type:char(36);not null"`
+	UserID     *string   `gorm:"column:user_id;type:char(36);index"`
+	APIKeyID   *string   `gorm:"column:api_key_id;type:char(36)"`
+	CompanyID  string    `gorm:"column:company_id;type:char(36);index"`
 	Action     string    `gorm:"column:action;type:varchar(50);not null"`
-	EntityType string    `gorm:"column:entity_type;type:varchar(50);not null"`
-	EntityID   string    `gorm:"column:entity_id;type:char(36);not null"`
-	OldValues  string    `gorm:"column:old_values;type:json"`
-	NewValues  string    `gorm:"column:new_values;type:json"`
+	EntityType string    `gorm:"column:entity_type;type:varchar(50);not null;index:idx_audit_logs_entity,priority:1"`
+	EntityID   string    `gorm:"column:entity_id;type:char(36);not null;index:idx_audit_logs_entity,priority:2"`
+	OldValues  *string   `gorm:"column:old_values;type:json"`
+	NewValues  *string   `gorm:"column:new_values;type:json"`
 	IPAddress  string    `gorm:"column:ip_address;type:varchar(45)"`
 	UserAgent  string    `gorm:"column:user_agent;type:text"`
-	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;index"`
 
 	// Relación
 	User *User `gorm:"foreignKey:UserID;references:ID"`
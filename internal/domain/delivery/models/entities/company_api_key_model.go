@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// CompanyAPIKey representa una API key de integración de una empresa.
+// Solo se guarda el hash de la key, el prefijo permite identificarla en los listados.
+type CompanyAPIKey struct {
+	ID         string     `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	CompanyID  string     `gorm:"column:company_id;type:char(36);not null;index" json:"company_id"`
+	BranchID   string     `gorm:"column:branch_id;type:char(36);not null" json:"branch_id"`
+	Name       string     `gorm:"column:name;type:varchar(100);not null" json:"name"`
+	Prefix     string     `gorm:"column:prefix;type:varchar(20);not null" json:"prefix"`
+	KeyHash    string     `gorm:"column:key_hash;type:char(64);not null;uniqueIndex" json:"-"`
+	Scopes     string     `gorm:"column:scopes;type:varchar(255);not null" json:"scopes"`
+	CreatedBy  string     `gorm:"column:created_by;type:char(36);not null" json:"created_by"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at;type:timestamp null" json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at;type:timestamp null" json:"expires_at,omitempty"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at;type:timestamp null" json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID" json:"-"`
+	Branch  *Branch  `gorm:"foreignKey:BranchID;references:ID" json:"-"`
+}
+
+func (CompanyAPIKey) TableName() string {
+	return "company_api_keys"
+}
+
+// ScopeList retorna los scopes de la key, se guardan separados por comas
+func (k *CompanyAPIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+// IsUsable indica si la key no está revocada ni expirada
+func (k *CompanyAPIKey) IsUsable(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
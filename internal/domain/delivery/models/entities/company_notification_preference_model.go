@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"time"
+)
+
+// CompanyNotificationPreference son los canales por defecto de un tipo de notificación para los usuarios
+// de la empresa, se aplican mientras el usuario no configure su propia preferencia
+type CompanyNotificationPreference struct {
+	CompanyID        string    `gorm:"column:company_id;type:char(36);primaryKey"`
+	NotificationType string    `gorm:"column:notification_type;type:varchar(50);primaryKey"`
+	EmailEnabled     bool      `gorm:"column:email_enabled;type:boolean;default:true"`
+	PushEnabled      bool      `gorm:"column:push_enabled;type:boolean;default:true"`
+	SMSEnabled       bool      `gorm:"column:sms_enabled;type:boolean;default:false"`
+	CreatedAt        time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt        time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+}
+
+func (CompanyNotificationPreference) TableName() string {
+	return "company_notification_preferences"
+}
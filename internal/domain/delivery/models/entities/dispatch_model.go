@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+// DispatchCandidate representa un repartidor que puede recibir un pedido en la zona de despacho
+type DispatchCandidate struct {
+	DriverID         string
+	ZoneID           string // Zona de despacho del pedido
+	CurrentZoneID    string
+	Latitude         float64
+	Longitude        float64
+	ActiveOrders     int
+	IsPrimaryZone    bool
+	EfficiencyRating float64
+	Rating           float64
+
+	// Calculados durante el despacho
+	DistanceKm float64
+	Score      float64
+}
+
+// DispatchResult representa el resultado de asignar automáticamente un repartidor a un pedido
+type DispatchResult struct {
+	OrderID    string
+	DriverID   string
+	ZoneID     string
+	Strategy   string
+	DistanceKm float64
+	Score      float64
+	Candidates int
+	AssignedAt time.Time
+}
@@ -6,7 +6,7 @@ import (
 
 type EventLog struct {
 	ID          string    `gorm:"column:id;type:char(36);primaryKey"`
-	EventID     string    `gorm:"column:event_id;type:char(36);not null"`
+	EventID     string    `gorm:"column:event_id;type:char(36);not null;index"`
 	LogLevel    string    `gorm:"column:log_level;type:varchar(20);not null"`
 	Description string    `gorm:"column:description;type:text;not null"`
 	Metadata    string    `gorm:"column:metadata;type:json"`
@@ -0,0 +1,33 @@
+package entities
+
+// NotificationInput contiene los datos para notificar a un usuario
+type NotificationInput struct {
+	UserID string
+	Type   string
+
+	// Variables con las que se renderiza la plantilla del tipo
+	Variables map[string]interface{}
+
+	// Metadata se guarda con la notificación, por ejemplo el pedido relacionado
+	Metadata map[string]interface{}
+
+	// DedupKey identifica el origen de la notificación, la misma clave no notifica dos veces al usuario
+	DedupKey string
+}
+
+// NotificationDeviceInput contiene los datos para registrar o refrescar un dispositivo del usuario
+type NotificationDeviceInput struct {
+	UserID      string
+	DeviceToken string
+	DeviceType  string
+}
+
+// NotificationChannelSettings son los canales habilitados para un tipo de notificación y el origen de la
+// configuración: la preferencia del usuario, el valor por defecto de la empresa o el del sistema
+type NotificationChannelSettings struct {
+	NotificationType string
+	EmailEnabled     bool
+	PushEnabled      bool
+	SMSEnabled       bool
+	Source           string
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// OrderEvent representa un cambio en el ciclo de vida de un pedido que se notifica fuera del sistema
+type OrderEvent struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	CompanyID  string         `json:"company_id"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       OrderEventData `json:"data"`
+}
+
+// OrderEventData contiene los datos del pedido incluidos en el evento
+type OrderEventData struct {
+	OrderID        string   `json:"order_id"`
+	TrackingNumber string   `json:"tracking_number,omitempty"`
+	Status         string   `json:"status,omitempty"`
+	PreviousStatus string   `json:"previous_status,omitempty"`
+	DriverID       string   `json:"driver_id,omitempty"`
+	Latitude       *float64 `json:"latitude,omitempty"`
+	Longitude      *float64 `json:"longitude,omitempty"`
+}
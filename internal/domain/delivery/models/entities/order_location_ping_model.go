@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"time"
+)
+
+type LocationPing struct {
+	ID         string    `gorm:"column:id;type:char(36);primaryKey"`
+	OrderID    string    `gorm:"column:order_id;type:char(36);not null;index:idx_location_pings_order_time,priority:1"`
+	DriverID   string    `gorm:"column:driver_id;type:char(36);not null;index"`
+	Location   []byte    `gorm:"column:location;type:point;not null"`
+	RecordedAt time.Time `gorm:"column:recorded_at;type:timestamp;not null;index:idx_location_pings_order_time,priority:2"`
+
+	Latitude  float64 `gorm:"-"`
+	Longitude float64 `gorm:"-"`
+
+	// Inverse Relationships
+	Order  *Order  `gorm:"foreignKey:OrderID;references:ID"`
+	Driver *Driver `gorm:"foreignKey:DriverID;references:UserID"`
+}
+
+func (LocationPing) TableName() string {
+	return "order_location_pings"
+}
@@ -11,6 +11,7 @@ type Order struct {
 	BranchID       string     `gorm:"column:branch_id;type:char(36);not null"`
 	ClientID       string     `gorm:"column:client_id;type:char(36);not null"`
 	DriverID       *string    `gorm:"column:driver_id;type:char(36)"`
+	ZoneID         *string    `gorm:"column:zone_id;type:char(36);index"`
 	TrackingNumber string     `gorm:"column:tracking_number;type:varchar(50);not null"`
 	Status         string     `gorm:"column:status;type:varchar(20);not null"`
 	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// OutboxEvent es un evento de dominio guardado en la misma transacción que el cambio que lo origina.
+// El relay lo publica después, así un cambio confirmado nunca se queda sin notificar.
+type OutboxEvent struct {
+	ID            string     `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	AggregateType string     `gorm:"column:aggregate_type;type:varchar(50);not null" json:"aggregate_type"`
+	AggregateID   string     `gorm:"column:aggregate_id;type:char(36);not null;index" json:"aggregate_id"`
+	EventType     string     `gorm:"column:event_type;type:varchar(50);not null" json:"event_type"`
+	Payload       string     `gorm:"column:payload;type:text;not null" json:"payload"`
+	Status        string     `gorm:"column:status;type:varchar(20);not null;index:idx_outbox_events_due,priority:1" json:"status"`
+	Attempts      int        `gorm:"column:attempts;type:int;not null;default:0" json:"attempts"`
+	LastError     string     `gorm:"column:last_error;type:varchar(500)" json:"last_error,omitempty"`
+	AvailableAt   time.Time  `gorm:"column:available_at;type:timestamp;not null;index:idx_outbox_events_due,priority:2" json:"available_at"`
+	PublishedAt   *time.Time `gorm:"column:published_at;type:timestamp null" json:"published_at,omitempty"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
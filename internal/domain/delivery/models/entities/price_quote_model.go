@@ -0,0 +1,45 @@
+package entities
+
+import "math"
+
+// PriceQuote contiene el precio calculado para un pedido y su desglose
+type PriceQuote struct {
+	// Zona utilizada para la tarifa base
+	ZoneID string `json:"zone_id"`
+
+	// Distancia entre recogida y entrega (en kilómetros)
+	DistanceKm float64 `json:"distance_km"`
+
+	// Peso facturable: el mayor entre el peso real y el volumétrico (en kg)
+	BillableWeight float64 `json:"billable_weight"`
+
+	// Multiplicador de demanda aplicado por la cobertura de la zona
+	SurgeMultiplier float64 `json:"surge_multiplier"`
+
+	// Moneda del precio
+	Currency string `json:"currency"`
+
+	// Conceptos que componen el precio
+	Items []PriceQuoteItem `json:"items"`
+
+	// Precio total
+	Total float64 `json:"total"`
+}
+
+// PriceQuoteItem representa un concepto dentro del desglose de precio
+type PriceQuoteItem struct {
+	Concept     string  `json:"concept"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+// AddItem agrega un concepto al desglose redondeado a centavos y lo suma al total
+func (q *PriceQuote) AddItem(concept, description string, amount float64) {
+	rounded := math.Round(amount*100) / 100
+	q.Items = append(q.Items, PriceQuoteItem{
+		Concept:     concept,
+		Description: description,
+		Amount:      rounded,
+	})
+	q.Total = math.Round((q.Total+rounded)*100) / 100
+}
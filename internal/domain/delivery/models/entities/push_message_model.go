@@ -0,0 +1,10 @@
+package entities
+
+// PushMessage es el mensaje que un proveedor push entrega a un dispositivo
+type PushMessage struct {
+	Title string
+	Body  string
+
+	// Data viaja con el mensaje para que la app abra la pantalla correspondiente, por ejemplo el pedido
+	Data map[string]string
+}
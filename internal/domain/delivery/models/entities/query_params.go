@@ -25,3 +25,49 @@ type UserQueryParams struct {
 
 	PaginationQueryParams
 }
+
+type DriverQueryParams struct {
+	// Filtros
+	Name          string `json:"name,omitempty"`
+	LicenseNumber string `json:"license_number,omitempty"`
+	VehiclePlate  string `json:"vehicle_plate,omitempty"`
+	VehicleType   string `json:"vehicle_type,omitempty"`
+	ZoneID        string `json:"zone_id,omitempty"`
+	IsActive      *bool  `json:"is_active,omitempty"`
+
+	PaginationQueryParams
+}
+
+type AuditLogQueryParams struct {
+	// Filtros
+	CompanyID  string     `json:"company_id,omitempty"`
+	UserID     string     `json:"user_id,omitempty"`
+	EntityType string     `json:"entity_type,omitempty"`
+	EntityID   string     `json:"entity_id,omitempty"`
+	Action     string     `json:"action,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+
+	PaginationQueryParams
+}
+
+type SystemEventQueryParams struct {
+	// Filtros
+	EventType string     `json:"event_type,omitempty"`
+	Source    string     `json:"source,omitempty"`
+	SourceID  string     `json:"source_id,omitempty"`
+	Severity  string     `json:"severity,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+
+	PaginationQueryParams
+}
+
+type NotificationQueryParams struct {
+	// Filtros
+	UserID     string `json:"user_id,omitempty"`
+	Type       string `json:"type,omitempty"`
+	UnreadOnly bool   `json:"unread_only,omitempty"`
+
+	PaginationQueryParams
+}
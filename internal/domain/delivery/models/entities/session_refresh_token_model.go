@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// SessionRefreshToken representa un refresh token emitido para una sesión.
+// Los tokens de una misma sesión forman una familia: cada uso rota el token y marca el anterior como usado.
+type SessionRefreshToken struct {
+	ID        string     `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	SessionID string     `gorm:"column:session_id;type:char(36);not null;index" json:"session_id"`
+	UserID    string     `gorm:"column:user_id;type:char(36);not null;index" json:"-"`
+	TokenHash string     `gorm:"column:token_hash;type:char(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"column:expires_at;type:timestamp;not null" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"column:used_at;type:timestamp;null" json:"used_at,omitempty"`
+	CreatedAt time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+
+	// Inverse Relationships
+	Session *UserSession `gorm:"foreignKey:SessionID;references:ID" json:"-"`
+}
+
+func (SessionRefreshToken) TableName() string {
+	return "session_refresh_tokens"
+}
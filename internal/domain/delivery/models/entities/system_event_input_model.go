@@ -0,0 +1,26 @@
+package entities
+
+// SystemEventInput contiene los datos de un evento que registra un componente del sistema.
+// Los logs adjuntos se guardan de forma asíncrona después del evento
+type SystemEventInput struct {
+	EventType string
+	Source    string
+	SourceID  string
+	Severity  string
+	Data      map[string]interface{}
+	Logs      []EventLogInput
+}
+
+// EventLogInput es una entrada de log adjunta a un evento del sistema
+type EventLogInput struct {
+	LogLevel    string
+	Description string
+	Metadata    map[string]interface{}
+}
+
+// SystemEventSummary es la cantidad de eventos de un tipo y severidad
+type SystemEventSummary struct {
+	EventType string `gorm:"column:event_type"`
+	Severity  string `gorm:"column:severity"`
+	Count     int64  `gorm:"column:count"`
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// UserMFA guarda el secreto TOTP (RFC 6238) de un usuario.
+// El MFA solo está activo cuando EnabledAt tiene valor, antes de eso la inscripción está pendiente de confirmar.
+type UserMFA struct {
+	UserID       string     `gorm:"column:user_id;type:char(36);primary_key" json:"user_id"`
+	Secret       string     `gorm:"column:secret;type:varchar(255);not null" json:"-"`
+	LastUsedStep int64      `gorm:"column:last_used_step;not null;default:0" json:"-"`
+	EnabledAt    *time.Time `gorm:"column:enabled_at;type:timestamp null" json:"enabled_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Inverse Relationships
+	User *User `gorm:"foreignKey:UserID;references:ID" json:"-"`
+}
+
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+// MFARecoveryCode representa un código de recuperación de un solo uso, solo se guarda su hash
+type MFARecoveryCode struct {
+	ID        string     `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	UserID    string     `gorm:"column:user_id;type:char(36);not null;index" json:"-"`
+	CodeHash  string     `gorm:"column:code_hash;type:char(64);not null" json:"-"`
+	UsedAt    *time.Time `gorm:"column:used_at;type:timestamp null" json:"used_at,omitempty"`
+	CreatedAt time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+func (MFARecoveryCode) TableName() string {
+	return "user_mfa_recovery_codes"
+}
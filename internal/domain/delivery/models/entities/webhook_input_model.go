@@ -0,0 +1,15 @@
+package entities
+
+// WebhookSubscriptionInput contiene los datos para registrar un webhook de una empresa
+type WebhookSubscriptionInput struct {
+	CompanyID string
+	URL       string
+	Events    []string
+	CreatedBy string
+}
+
+// IssuedWebhookSubscription contiene el secreto de firma, solo se entrega al crear la suscripción
+type IssuedWebhookSubscription struct {
+	Subscription *WebhookSubscription
+	Secret       string
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// WebhookSubscription representa un endpoint de una empresa que recibe eventos de sus pedidos
+type WebhookSubscription struct {
+	ID        string    `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	CompanyID string    `gorm:"column:company_id;type:char(36);not null;index" json:"company_id"`
+	URL       string    `gorm:"column:url;type:varchar(500);not null" json:"url"`
+	Secret    string    `gorm:"column:secret;type:varchar(100);not null" json:"-"`
+	Events    string    `gorm:"column:events;type:varchar(255);not null" json:"events"`
+	IsActive  bool      `gorm:"column:is_active;type:boolean;default:true" json:"is_active"`
+	CreatedBy string    `gorm:"column:created_by;type:char(36);not null" json:"created_by"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID" json:"-"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// EventList retorna los eventos de la suscripción, se guardan separados por comas
+func (s *WebhookSubscription) EventList() []string {
+	if s.Events == "" {
+		return nil
+	}
+	return strings.Split(s.Events, ",")
+}
+
+// Subscribes indica si la suscripción recibe el tipo de evento indicado
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	for _, event := range s.EventList() {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery es el envío de un evento a una suscripción, con su estado de reintentos
+type WebhookDelivery struct {
+	ID             string     `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	SubscriptionID string     `gorm:"column:subscription_id;type:char(36);not null;index" json:"subscription_id"`
+	CompanyID      string     `gorm:"column:company_id;type:char(36);not null;index" json:"company_id"`
+	EventID        string     `gorm:"column:event_id;type:char(36);not null;index" json:"event_id"`
+	EventType      string     `gorm:"column:event_type;type:varchar(50);not null" json:"event_type"`
+	Payload        string     `gorm:"column:payload;type:text;not null" json:"payload"`
+	Status         string     `gorm:"column:status;type:varchar(20);not null;index:idx_webhook_deliveries_due,priority:1" json:"status"`
+	Attempts       int        `gorm:"column:attempts;type:int;not null;default:0" json:"attempts"`
+	LastStatusCode int        `gorm:"column:last_status_code;type:int" json:"last_status_code,omitempty"`
+	LastError      string     `gorm:"column:last_error;type:varchar(500)" json:"last_error,omitempty"`
+	NextAttemptAt  time.Time  `gorm:"column:next_attempt_at;type:timestamp;not null;index:idx_webhook_deliveries_due,priority:2" json:"next_attempt_at"`
+	DeliveredAt    *time.Time `gorm:"column:delivered_at;type:timestamp null" json:"delivered_at,omitempty"`
+	ReplayOf       *string    `gorm:"column:replay_of;type:char(36)" json:"replay_of,omitempty"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Subscription *WebhookSubscription     `gorm:"foreignKey:SubscriptionID;references:ID" json:"-"`
+	AttemptLog   []WebhookDeliveryAttempt `gorm:"foreignKey:DeliveryID" json:"attempt_log,omitempty"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookDeliveryAttempt registra cada intento de envío de una entrega
+type WebhookDeliveryAttempt struct {
+	ID            string    `gorm:"column:id;type:char(36);primary_key" json:"id"`
+	DeliveryID    string    `gorm:"column:delivery_id;type:char(36);not null;index" json:"delivery_id"`
+	AttemptNumber int       `gorm:"column:attempt_number;type:int;not null" json:"attempt_number"`
+	StatusCode    int       `gorm:"column:status_code;type:int" json:"status_code,omitempty"`
+	Error         string    `gorm:"column:error;type:varchar(500)" json:"error,omitempty"`
+	DurationMs    int64     `gorm:"column:duration_ms;type:bigint" json:"duration_ms"`
+	AttemptedAt   time.Time `gorm:"column:attempted_at;type:timestamp;not null" json:"attempted_at"`
+}
+
+func (WebhookDeliveryAttempt) TableName() string {
+	return "webhook_delivery_attempts"
+}
@@ -15,3 +15,12 @@ type Coverage struct {
 func (Coverage) TableName() string {
 	return "zone_coverage"
 }
+
+// ZoneCoverageArea son los polígonos en WKT de una zona activa. CoverageArea está vacío si la zona no tiene
+// cobertura configurada, en ese caso la zona atiende sus límites completos
+type ZoneCoverageArea struct {
+	ZoneID        string
+	PriorityLevel int
+	Boundaries    string
+	CoverageArea  string
+}
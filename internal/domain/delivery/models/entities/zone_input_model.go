@@ -0,0 +1,12 @@
+package entities
+
+// ZoneInput son los datos para crear o actualizar una zona. Boundaries acepta un polígono GeoJSON o WKT
+type ZoneInput struct {
+	Name            string
+	Code            string
+	Boundaries      string
+	BaseRate        float64
+	MaxDeliveryTime int
+	PriorityLevel   int
+	IsActive        *bool
+}
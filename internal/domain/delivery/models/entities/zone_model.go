@@ -2,6 +2,8 @@ package entities
 
 import "time"
 
+// Zone es un área de cobertura. Boundaries y CenterPoint se manejan en formato WKT, el repositorio
+// los convierte desde y hacia las columnas espaciales
 type Zone struct {
 	ID              string    `json:"id" gorm:"column:id;type:char(36);primary_key"`
 	Name            string    `json:"name" gorm:"column:name;type:varchar(100);not null"`
@@ -1,6 +1,8 @@
 package websocket
 
 import (
+	"encoding/json"
+
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"time"
 )
@@ -14,9 +16,16 @@ const (
 	ClientUnsubscribe MessageType = "UNSUBSCRIBE" // Cliente solicita cancelar la suscripción
 
 	// Tipos de mensajes del servidor al cliente
-	ServerOrderUpdate MessageType = "ORDER_UPDATE" // Actualización del estado del pedido
-	ServerLocation    MessageType = "LOCATION"     // Actualización de la ubicación del repartidor
-	ServerError       MessageType = "ERROR"        // Mensaje de error
+	ServerOrderUpdate  MessageType = "ORDER_UPDATE" // Actualización del estado del pedido
+	ServerLocation     MessageType = "LOCATION"     // Actualización de la ubicación del repartidor
+	ServerNotification MessageType = "NOTIFICATION" // Nueva notificación en la bandeja del usuario
+	ServerError        MessageType = "ERROR"        // Mensaje de error
+)
+
+// Códigos de error enviados en mensajes de tipo ServerError
+const (
+	ErrorCodeInvalidMessage     = "INVALID_MESSAGE"     // El mensaje del cliente no es válido
+	ErrorCodeSubscriptionDenied = "SUBSCRIPTION_DENIED" // El usuario no puede observar el pedido solicitado
 )
 
 // Message representa un mensaje genérico de WebSocket
@@ -43,6 +52,17 @@ type LocationUpdateData struct {
 	Address   string    `json:"address,omitempty"` // Dirección aproximada (opcional)
 }
 
+// NotificationData contiene la notificación nueva y el total de no leídas para actualizar el contador del usuario
+type NotificationData struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Title       string          `json:"title"`
+	Content     string          `json:"content"`
+	Metadata    json.RawMessage `json:"metadata,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UnreadCount int64           `json:"unread_count"`
+}
+
 // ErrorData contiene información sobre un error
 type ErrorData struct {
 	Code    string `json:"code"`              // Código de error
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// APIKeyRepository define las operaciones para la persistencia de las API keys de empresas
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entities.CompanyAPIKey) error
+	GetByID(ctx context.Context, id string) (*entities.CompanyAPIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*entities.CompanyAPIKey, error)
+	ListByCompany(ctx context.Context, companyID string) ([]entities.CompanyAPIKey, error)
+	Rotate(ctx context.Context, id, prefix, keyHash string) error
+	Revoke(ctx context.Context, id string, at time.Time) error
+	TouchLastUsed(ctx context.Context, id string, at time.Time, minInterval time.Duration) error
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// AuditLogRepository define las operaciones para la persistencia de la auditoría
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *entities.AuditLog) error
+	GetAuditLogs(ctx context.Context, params *entities.AuditLogQueryParams) ([]entities.AuditLog, int64, error)
+	// GetEntitySnapshot devuelve el estado actual de la entidad en JSON, nil si no existe
+	GetEntitySnapshot(ctx context.Context, entityType, entityID string) (*string, error)
+}
@@ -39,4 +39,5 @@ type CompanyRepository interface {
 	GetZoneByID(ctx context.Context, zoneID string) (*entities.Zone, error)
 	GetAllActiveZones(ctx context.Context) ([]entities.Zone, error)
 	GetBranchesByZone(ctx context.Context, zoneID string) ([]entities.Branch, error)
+	GetZoneCoverage(ctx context.Context, zoneID string) (*entities.Coverage, error)
 }
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// DriverRepository define las operaciones de persistencia de repartidores y sus zonas
+type DriverRepository interface {
+	// Operaciones de Repartidor
+	CreateDriver(ctx context.Context, driver *entities.Driver) error
+	GetDriverByID(ctx context.Context, driverID string) (*entities.Driver, error)
+	GetDrivers(ctx context.Context, params *entities.DriverQueryParams) ([]entities.Driver, int64, error)
+	UpdateDriver(ctx context.Context, driver *entities.Driver) error
+	ActivateOrDeactivate(ctx context.Context, driverID string, active bool) error
+
+	// Operaciones de verificación
+	ExistsDriver(ctx context.Context, driverID string) (bool, error)
+	ExistsLicenseNumber(ctx context.Context, licenseNumber string, excludeID string) (bool, error)
+	ExistsVehiclePlate(ctx context.Context, vehiclePlate string, excludeID string) (bool, error)
+
+	// Operaciones de Zonas
+	GetDriverZones(ctx context.Context, driverID string) ([]entities.DriverZone, error)
+	ReplaceDriverZones(ctx context.Context, driverID string, zones []entities.DriverZone) error
+
+	// Operaciones de despacho
+	GetDispatchCandidates(ctx context.Context, zoneID string, maxActiveOrders int, at time.Time) ([]entities.DispatchCandidate, error)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+)
+
+// LiveNotificationRepository define el envío en vivo de notificaciones a las conexiones WebSocket del usuario
+type LiveNotificationRepository interface {
+	// SendNotification envía la notificación a todas las conexiones abiertas del usuario
+	SendNotification(userID string, data *websocket.NotificationData) error
+}
@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// NotificationDeviceRepository define las operaciones para la persistencia de los dispositivos push
+type NotificationDeviceRepository interface {
+	Create(ctx context.Context, device *entities.NotificationDevice) error
+	Update(ctx context.Context, device *entities.NotificationDevice) error
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*entities.NotificationDevice, error)
+
+	// GetByToken obtiene el dispositivo registrado con el token, sin importar el usuario
+	GetByToken(ctx context.Context, token string) (*entities.NotificationDevice, error)
+
+	// GetActiveByUser obtiene los dispositivos activos del usuario
+	GetActiveByUser(ctx context.Context, userID string) ([]entities.NotificationDevice, error)
+
+	// MarkUsed guarda un envío exitoso y reinicia el conteo de fallos del dispositivo
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+
+	// IncrementFailures suma un envío fallido y retorna el total de fallos seguidos del dispositivo
+	IncrementFailures(ctx context.Context, id string) (int, error)
+
+	// Deactivate deja de enviar notificaciones al dispositivo hasta que se vuelva a registrar
+	Deactivate(ctx context.Context, id string) error
+}
@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// NotificationRepository define las operaciones para la persistencia de notificaciones, plantillas y preferencias
+type NotificationRepository interface {
+	// GetActiveTemplateByType obtiene la plantilla activa de un tipo de notificación
+	GetActiveTemplateByType(ctx context.Context, notificationType string) (*entities.NotificationTemplate, error)
+
+	// GetPreference obtiene la preferencia del usuario para un tipo, nil si no la configuró
+	GetPreference(ctx context.Context, userID, notificationType string) (*entities.NotificationPreference, error)
+
+	// GetCompanyPreference obtiene el valor por defecto de la empresa para un tipo, nil si no lo configuró
+	GetCompanyPreference(ctx context.Context, companyID, notificationType string) (*entities.CompanyNotificationPreference, error)
+
+	GetUserPreferences(ctx context.Context, userID string) ([]entities.NotificationPreference, error)
+	GetCompanyPreferences(ctx context.Context, companyID string) ([]entities.CompanyNotificationPreference, error)
+
+	// SaveUserPreferences crea o reemplaza las preferencias del usuario de cada tipo indicado
+	SaveUserPreferences(ctx context.Context, preferences []entities.NotificationPreference) error
+
+	// SaveCompanyPreferences crea o reemplaza los valores por defecto de la empresa de cada tipo indicado
+	SaveCompanyPreferences(ctx context.Context, preferences []entities.CompanyNotificationPreference) error
+
+	Create(ctx context.Context, notification *entities.Notification) error
+	GetByID(ctx context.Context, id string) (*entities.Notification, error)
+
+	// MarkSent guarda la fecha en que la notificación se entregó por algún canal
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+
+	// GetUserNotifications obtiene la bandeja del usuario de los filtros, de la más reciente a la más antigua
+	GetUserNotifications(ctx context.Context, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error)
+
+	// CountUnread cuenta las notificaciones no leídas del usuario
+	CountUnread(ctx context.Context, userID string) (int64, error)
+
+	// MarkRead marca como leída una notificación del usuario
+	MarkRead(ctx context.Context, userID, id string, readAt time.Time) error
+
+	// MarkAllRead marca como leídas todas las notificaciones pendientes del usuario y retorna cuántas se marcaron
+	MarkAllRead(ctx context.Context, userID string, readAt time.Time) (int64, error)
+}
@@ -5,8 +5,10 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
 
+// OrdererRepository define la persistencia de pedidos. Los eventos recibidos en las operaciones
+// de cambio se guardan en el outbox dentro de la misma transacción.
 type OrdererRepository interface {
-	CreateOrder(ctx context.Context, order *entities.Order) error
+	CreateOrder(ctx context.Context, order *entities.Order, events ...*entities.OutboxEvent) error
 	CreateQRData(ctx context.Context, qr *entities.QRCode) error
 	GetOrderByID(ctx context.Context, id string) (*entities.Order, error)
 	GetOrderByQR(ctx context.Context, qr *entities.QRCode) (*entities.Order, error)
@@ -17,8 +19,11 @@ type OrdererRepository interface {
 	GetLocationCoordinates(ctx context.Context, orderID string, addressType string) (float64, float64, error)
 	UpdateOrder(ctx context.Context, orderID string, order *entities.Order) error
 	DeleteOrder(ctx context.Context, id string) error
-	ChangeStatus(ctx context.Context, id string, status string) error
-	AssignDriverToOrder(ctx context.Context, orderID, driverID string) error
-	SoftDeleteOrder(ctx context.Context, id string) error
-	RestoreOrder(ctx context.Context, id string) error
+	ChangeStatus(ctx context.Context, id string, status string, events ...*entities.OutboxEvent) error
+	AssignDriverToOrder(ctx context.Context, orderID, driverID string, maxActiveOrders int, events ...*entities.OutboxEvent) error
+	GetPendingDispatchOrderIDs(ctx context.Context, limit int) ([]string, error)
+	SaveLocationPing(ctx context.Context, ping *entities.LocationPing, orderStatus string, events ...*entities.OutboxEvent) error
+	GetLocationHistory(ctx context.Context, orderID string) ([]entities.LocationPing, error)
+	SoftDeleteOrder(ctx context.Context, id string, events ...*entities.OutboxEvent) error
+	RestoreOrder(ctx context.Context, id string, events ...*entities.OutboxEvent) error
 }
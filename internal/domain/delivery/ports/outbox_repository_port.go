@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// OutboxRepository define las operaciones del relay sobre los eventos del outbox.
+// Los eventos se guardan desde los repositorios de cada agregado, dentro de su propia transacción.
+type OutboxRepository interface {
+	GetDueEventIDs(ctx context.Context, now time.Time, limit int) ([]string, error)
+	ClaimEvent(ctx context.Context, id string, now, leaseUntil time.Time) (*entities.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+	RecordFailure(ctx context.Context, event *entities.OutboxEvent) error
+}
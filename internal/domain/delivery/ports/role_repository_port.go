@@ -8,6 +8,7 @@ import (
 // RolerRepository define las operaciones disponibles para la gestión de roles y permisos
 type RolerRepository interface {
 	// Operaciones de Roles
+	CreateRole(ctx context.Context, role *entities.Role) error
 	GetRoleByID(ctx context.Context, id string) (*entities.Role, error)
 	GetRoleByName(ctx context.Context, name string) (*entities.Role, error)
 	UpdateRole(ctx context.Context, role *entities.Role) error
@@ -21,6 +22,7 @@ type RolerRepository interface {
 	// Operaciones de Permisos
 	CreatePermission(ctx context.Context, permission *entities.Permission) error
 	GetPermissionByID(ctx context.Context, id string) (*entities.Permission, error)
+	GetPermissionByResourceAction(ctx context.Context, resource, action string) (*entities.Permission, error)
 	UpdatePermission(ctx context.Context, permission *entities.Permission) error
 	DeletePermission(ctx context.Context, id string) error
 	ListPermissions(ctx context.Context) ([]entities.Permission, error)
@@ -29,4 +31,5 @@ type RolerRepository interface {
 	AssignPermissionToRole(ctx context.Context, roleID string, permissionID string) error
 	RemovePermissionFromRole(ctx context.Context, roleID string, permissionID string) error
 	GetRolePermissions(ctx context.Context, roleID string) ([]entities.Permission, error)
+	GetPermissionRoles(ctx context.Context, permissionID string) ([]entities.Role, error)
 }
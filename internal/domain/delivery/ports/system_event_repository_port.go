@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// SystemEventRepository define las operaciones para la persistencia de eventos del sistema
+type SystemEventRepository interface {
+	Create(ctx context.Context, event *entities.SystemEvent) error
+
+	// CreateLogs guarda los logs adjuntos a un evento
+	CreateLogs(ctx context.Context, eventLogs []entities.EventLog) error
+
+	// GetSystemEvents obtiene los eventos que cumplen los filtros junto con sus logs
+	GetSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEvent, int64, error)
+
+	// AggregateSystemEvents cuenta los eventos que cumplen los filtros agrupados por tipo y severidad
+	AggregateSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEventSummary, error)
+}
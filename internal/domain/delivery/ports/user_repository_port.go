@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
 
@@ -29,10 +31,27 @@ type UserRepository interface {
 	// Operaciones de Sesión
 	CreateSession(ctx context.Context, session *entities.UserSession) error
 	GetSessionByToken(ctx context.Context, token string) (*entities.UserSession, error)
+	GetSessionByID(ctx context.Context, sessionID string) (*entities.UserSession, error)
 	GetActiveSessionsByUserID(ctx context.Context, userID string) ([]entities.UserSession, error)
+	TouchSession(ctx context.Context, sessionID string, at time.Time, minInterval time.Duration) error
 	DeleteSession(ctx context.Context, sessionID string) error
 	CleanExpiredSessions(ctx context.Context, id string) error
 
+	// Operaciones de Refresh Tokens
+	CreateSessionWithRefreshToken(ctx context.Context, session *entities.UserSession, refreshToken *entities.SessionRefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entities.SessionRefreshToken, error)
+	RotateRefreshToken(ctx context.Context, usedTokenID string, session *entities.UserSession, newToken *entities.SessionRefreshToken) error
+
+	// Operaciones de MFA
+	GetMFA(ctx context.Context, userID string) (*entities.UserMFA, error)
+	SaveMFA(ctx context.Context, mfa *entities.UserMFA) error
+	EnableMFA(ctx context.Context, userID string, enabledAt time.Time, codes []entities.MFARecoveryCode) error
+	AdvanceMFAStep(ctx context.Context, userID string, step int64) error
+	DeleteMFA(ctx context.Context, userID string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codes []entities.MFARecoveryCode) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+	CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error)
+
 	// Operaciones de Roles y Permisos
 	AssignRoleToUser(ctx context.Context, userID string, roleID string, assignedBy string) error
 	RemoveRoleFromUser(ctx context.Context, userID string, roleID string) error
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// WebhookRepository define las operaciones para la persistencia de los webhooks y sus entregas
+type WebhookRepository interface {
+	// Operaciones de suscripciones
+	CreateSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error
+	GetSubscriptionByID(ctx context.Context, id string) (*entities.WebhookSubscription, error)
+	ListSubscriptionsByCompany(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error)
+	GetActiveSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// Operaciones de entregas
+	CreateDeliveries(ctx context.Context, deliveries []entities.WebhookDelivery) error
+	GetSubscriptionIDsForEvent(ctx context.Context, eventID string) ([]string, error)
+	GetDeliveryByID(ctx context.Context, id string) (*entities.WebhookDelivery, error)
+	ListDeliveriesBySubscription(ctx context.Context, subscriptionID string, limit int) ([]entities.WebhookDelivery, error)
+	GetDueDeliveryIDs(ctx context.Context, now time.Time, limit int) ([]string, error)
+	ClaimDelivery(ctx context.Context, id string, now, leaseUntil time.Time) (*entities.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, delivery *entities.WebhookDelivery, attempt *entities.WebhookDeliveryAttempt) error
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// ZoneRepository define las operaciones para la persistencia de las zonas de cobertura. Los límites y el
+// centro de la zona se reciben y se devuelven en formato WKT
+type ZoneRepository interface {
+	Create(ctx context.Context, zone *entities.Zone) error
+	Update(ctx context.Context, zone *entities.Zone) error
+	Deactivate(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*entities.Zone, error)
+
+	// GetZones obtiene las zonas de mayor a menor prioridad, las inactivas solo si se indica
+	GetZones(ctx context.Context, includeInactive bool) ([]entities.Zone, error)
+
+	// GetCoverageAreas obtiene los límites y la cobertura de las zonas activas
+	GetCoverageAreas(ctx context.Context) ([]entities.ZoneCoverageArea, error)
+
+	// ExistsCode indica si otra zona usa el código, excludeID permite ignorar la zona que se actualiza
+	ExistsCode(ctx context.Context, code, excludeID string) (bool, error)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type AuditService struct {
+	repo ports.AuditLogRepository
+}
+
+func NewAuditService(repo ports.AuditLogRepository) interfaces.AuditLogger {
+	return &AuditService{
+		repo: repo,
+	}
+}
+
+func (s *AuditService) Record(ctx context.Context, log *entities.AuditLog) error {
+	// 1. Validar que el registro identifique la entidad y la acción
+	if log.Action == "" || log.EntityType == "" || log.EntityID == "" {
+		return errPackage.NewDomainError("AuditService", "Record", "action, entity type and entity id are required")
+	}
+
+	// 2. Guardar el registro
+	if log.ID == "" {
+		log.ID = uuid.NewString()
+	}
+	if err := s.repo.Create(ctx, log); err != nil {
+		return errPackage.NewDomainErrorWithCause("AuditService", "Record", "failed to save audit log", err)
+	}
+
+	return nil
+}
+
+// Snapshot no retorna error para que una lectura fallida no impida registrar el cambio
+func (s *AuditService) Snapshot(ctx context.Context, entityType, entityID string) *string {
+	if entityID == "" {
+		return nil
+	}
+
+	snapshot, err := s.repo.GetEntitySnapshot(ctx, entityType, entityID)
+	if err != nil {
+		logs.Warn("Failed to take audit snapshot", map[string]interface{}{
+			"entityType": entityType,
+			"entityID":   entityID,
+			"error":      err.Error(),
+		})
+		return nil
+	}
+
+	return snapshot
+}
+
+func (s *AuditService) GetAuditLogs(ctx context.Context, params *entities.AuditLogQueryParams) ([]entities.AuditLog, int64, error) {
+	auditLogs, total, err := s.repo.GetAuditLogs(ctx, params)
+	if err != nil {
+		return nil, 0, errPackage.NewDomainErrorWithCause("AuditService", "GetAuditLogs", "failed to get audit logs", err)
+	}
+
+	return auditLogs, total, nil
+}
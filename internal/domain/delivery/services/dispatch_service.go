@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type DispatchService struct {
+	orderRepo       ports.OrdererRepository
+	driverRepo      ports.DriverRepository
+	companyRepo     ports.CompanyRepository
+	orderService    interfaces.Orderer
+	strategy        interfaces.DispatchStrategy
+	events          interfaces.EventRecorder
+	maxActiveOrders int
+}
+
+func NewDispatchService(
+	orderRepo ports.OrdererRepository,
+	driverRepo ports.DriverRepository,
+	companyRepo ports.CompanyRepository,
+	orderService interfaces.Orderer,
+	strategy interfaces.DispatchStrategy,
+	events interfaces.EventRecorder,
+	maxActiveOrders int,
+) interfaces.Dispatcher {
+	if maxActiveOrders <= 0 {
+		maxActiveOrders = constants.DefaultDispatchMaxActiveOrders
+	}
+
+	return &DispatchService{
+		orderRepo:       orderRepo,
+		driverRepo:      driverRepo,
+		companyRepo:     companyRepo,
+		orderService:    orderService,
+		strategy:        strategy,
+		events:          events,
+		maxActiveOrders: maxActiveOrders,
+	}
+}
+
+// DispatchOrder asigna el mejor repartidor disponible a un pedido según la estrategia configurada
+func (s *DispatchService) DispatchOrder(ctx context.Context, orderID string) (*entities.DispatchResult, error) {
+	// 1. Obtener el pedido y verificar que pueda despacharse
+	order, err := s.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !constants.DispatchableOrderStatuses[order.Status] {
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "DispatchOrder", "order cannot be dispatched in status "+order.Status, errPackage.ErrOrderNotDispatchable)
+	}
+
+	if order.DriverID != nil && *order.DriverID != "" {
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "DispatchOrder", "order already has a driver", errPackage.ErrOrderAlreadyAssigned)
+	}
+
+	pickup := value_objects.NewGeoPoint(order.PickupAddress.Latitude, order.PickupAddress.Longitude)
+	if !isLocatedPoint(pickup) {
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "DispatchOrder", "invalid pickup location", errPackage.ErrInvalidPickupLocation)
+	}
+
+	// 2. Obtener la zona de despacho a partir de la sucursal
+	branch, err := s.companyRepo.GetBranchByID(ctx, order.BranchID)
+	if err != nil {
+		logs.Error("Failed to get branch for dispatch", map[string]interface{}{
+			"orderID":  orderID,
+			"branchID": order.BranchID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "DispatchOrder", "failed to get branch", errPackage.ErrBranchNotFound)
+	}
+
+	// 3. Obtener los candidatos de la zona y calcular su distancia al punto de recogida
+	candidates, err := s.findCandidates(ctx, branch.ZoneID, pickup)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "DispatchOrder", "no drivers available", errPackage.ErrNoDriversAvailable)
+	}
+
+	// 4. Elegir al repartidor según la estrategia y asignarlo
+	selected := s.strategy.SelectDriver(order, candidates)
+	if err = s.orderService.AssignDriverToOrder(ctx, order.ID, selected.DriverID, s.maxActiveOrders); err != nil {
+		return nil, err
+	}
+
+	logs.Info("Driver dispatched to order", map[string]interface{}{
+		"orderID":  order.ID,
+		"driverID": selected.DriverID,
+		"strategy": s.strategy.Name(),
+		"distance": selected.DistanceKm,
+	})
+
+	return &entities.DispatchResult{
+		OrderID:    order.ID,
+		DriverID:   selected.DriverID,
+		ZoneID:     branch.ZoneID,
+		Strategy:   s.strategy.Name(),
+		DistanceKm: selected.DistanceKm,
+		Score:      selected.Score,
+		Candidates: len(candidates),
+		AssignedAt: time.Now(),
+	}, nil
+}
+
+// DispatchPendingOrders recorre los pedidos pendientes sin repartidor y los despacha uno a uno.
+// Los pedidos sin repartidores disponibles se omiten para reintentarse en la siguiente ejecución, igual que
+// los que otra réplica asignó primero
+func (s *DispatchService) DispatchPendingOrders(ctx context.Context) ([]entities.DispatchResult, error) {
+	orderIDs, err := s.orderRepo.GetPendingDispatchOrderIDs(ctx, constants.DefaultDispatchBatchSize)
+	if err != nil {
+		logs.Error("Failed to get pending orders for dispatch", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "DispatchPendingOrders", "failed to get pending orders", err)
+	}
+
+	results := make([]entities.DispatchResult, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result, err := s.DispatchOrder(ctx, orderID)
+		if err != nil {
+			if !errors.Is(err, errPackage.ErrNoDriversAvailable) && !errors.Is(err, errPackage.ErrOrderAlreadyAssigned) {
+				logs.Warn("Failed to dispatch order", map[string]interface{}{
+					"orderID": orderID,
+					"error":   err.Error(),
+				})
+				s.recordDispatchFailure(ctx, orderID, err)
+			}
+			continue
+		}
+
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// recordDispatchFailure registra como evento del sistema un pedido que no se pudo despachar por un error,
+// la falta de repartidores no se registra porque se reintenta en la siguiente ejecución
+func (s *DispatchService) recordDispatchFailure(ctx context.Context, orderID string, err error) {
+	s.events.Record(ctx, &entities.SystemEventInput{
+		EventType: constants.SystemEventDispatchFailed,
+		Source:    constants.SystemEventSourceDispatch,
+		SourceID:  orderID,
+		Severity:  constants.SystemEventSeverityError,
+		Data: map[string]interface{}{
+			"order_id": orderID,
+			"strategy": s.strategy.Name(),
+		},
+		Logs: []entities.EventLogInput{{
+			LogLevel:    constants.SystemEventSeverityError,
+			Description: err.Error(),
+		}},
+	})
+}
+
+// findCandidates obtiene los repartidores disponibles en la zona con su distancia al punto de recogida,
+// descartando a los que no reportan una ubicación válida
+func (s *DispatchService) findCandidates(ctx context.Context, zoneID string, pickup *value_objects.GeoPoint) ([]entities.DispatchCandidate, error) {
+	candidates, err := s.driverRepo.GetDispatchCandidates(ctx, zoneID, s.maxActiveOrders, time.Now())
+	if err != nil {
+		logs.Error("Failed to get dispatch candidates", map[string]interface{}{
+			"zoneID": zoneID,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("DispatchService", "findCandidates", "failed to get dispatch candidates", err)
+	}
+
+	located := make([]entities.DispatchCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		position := value_objects.NewGeoPoint(candidate.Latitude, candidate.Longitude)
+		if !isLocatedPoint(position) {
+			continue
+		}
+
+		candidate.DistanceKm = math.Round(pickup.DistanceTo(position)*100) / 100
+		located = append(located, candidate)
+	}
+
+	return located, nil
+}
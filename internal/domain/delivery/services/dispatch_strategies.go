@@ -0,0 +1,132 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// NewDispatchStrategy crea la estrategia de despacho a partir de su nombre, vacío usa la estrategia por defecto
+func NewDispatchStrategy(name string) (interfaces.DispatchStrategy, error) {
+	if name == "" {
+		name = constants.DefaultDispatchStrategy
+	}
+
+	switch strings.ToUpper(name) {
+	case constants.DispatchStrategyNearest:
+		return NewNearestDriverStrategy(), nil
+	case constants.DispatchStrategyRoundRobin:
+		return NewRoundRobinStrategy(), nil
+	case constants.DispatchStrategyLoadBalanced:
+		return NewLoadBalancedStrategy(), nil
+	default:
+		return nil, errPackage.NewDomainErrorWithCause("DispatchStrategy", "NewDispatchStrategy", "unknown dispatch strategy "+name, errPackage.ErrInvalidDispatchStrategy)
+	}
+}
+
+// NearestDriverStrategy elige al repartidor más cercano al punto de recogida.
+// En empate prefiere a quien tiene la zona como primaria y luego a quien tiene mejor eficiencia en ella
+type NearestDriverStrategy struct{}
+
+func NewNearestDriverStrategy() interfaces.DispatchStrategy {
+	return &NearestDriverStrategy{}
+}
+
+func (s *NearestDriverStrategy) Name() string {
+	return constants.DispatchStrategyNearest
+}
+
+func (s *NearestDriverStrategy) SelectDriver(_ *entities.Order, candidates []entities.DispatchCandidate) *entities.DispatchCandidate {
+	for i := range candidates {
+		candidates[i].Score = candidates[i].DistanceKm
+	}
+
+	sortCandidates(candidates)
+	return &candidates[0]
+}
+
+// RoundRobinStrategy reparte los pedidos de cada zona por turnos entre los repartidores disponibles,
+// sin importar la distancia. El turno se mantiene en memoria por zona
+type RoundRobinStrategy struct {
+	mu         sync.Mutex
+	lastByZone map[string]string
+}
+
+func NewRoundRobinStrategy() interfaces.DispatchStrategy {
+	return &RoundRobinStrategy{
+		lastByZone: make(map[string]string),
+	}
+}
+
+func (s *RoundRobinStrategy) Name() string {
+	return constants.DispatchStrategyRoundRobin
+}
+
+func (s *RoundRobinStrategy) SelectDriver(_ *entities.Order, candidates []entities.DispatchCandidate) *entities.DispatchCandidate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 1. Orden estable por ID para que el turno sea predecible
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].DriverID < candidates[j].DriverID
+	})
+
+	// 2. Elegir al siguiente después del último asignado en la zona
+	zoneID := candidates[0].ZoneID
+	selected := &candidates[0]
+	if last, ok := s.lastByZone[zoneID]; ok {
+		for i := range candidates {
+			if candidates[i].DriverID > last {
+				selected = &candidates[i]
+				break
+			}
+		}
+	}
+
+	selected.Score = float64(selected.ActiveOrders)
+	s.lastByZone[zoneID] = selected.DriverID
+
+	return selected
+}
+
+// LoadBalancedStrategy elige al repartidor con menos pedidos activos, usando la distancia como desempate
+type LoadBalancedStrategy struct{}
+
+func NewLoadBalancedStrategy() interfaces.DispatchStrategy {
+	return &LoadBalancedStrategy{}
+}
+
+func (s *LoadBalancedStrategy) Name() string {
+	return constants.DispatchStrategyLoadBalanced
+}
+
+func (s *LoadBalancedStrategy) SelectDriver(_ *entities.Order, candidates []entities.DispatchCandidate) *entities.DispatchCandidate {
+	for i := range candidates {
+		candidates[i].Score = float64(candidates[i].ActiveOrders)
+	}
+
+	sortCandidates(candidates)
+	return &candidates[0]
+}
+
+// sortCandidates ordena por puntaje ascendente y desempata por distancia, zona primaria y eficiencia
+func sortCandidates(candidates []entities.DispatchCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Score != b.Score {
+			return a.Score < b.Score
+		}
+		if a.DistanceKm != b.DistanceKm {
+			return a.DistanceKm < b.DistanceKm
+		}
+		if a.IsPrimaryZone != b.IsPrimaryZone {
+			return a.IsPrimaryZone
+		}
+		return a.EfficiencyRating > b.EfficiencyRating
+	})
+}
@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+)
+
+type DriverService struct {
+	driverRepo  ports.DriverRepository
+	userRepo    ports.UserRepository
+	companyRepo ports.CompanyRepository
+}
+
+func NewDriverService(driverRepo ports.DriverRepository, userRepo ports.UserRepository, companyRepo ports.CompanyRepository) interfaces.Driverer {
+	return &DriverService{
+		driverRepo:  driverRepo,
+		userRepo:    userRepo,
+		companyRepo: companyRepo,
+	}
+}
+
+// CreateDriver crea el perfil de repartidor de un usuario con rol DRIVER
+func (s *DriverService) CreateDriver(ctx context.Context, driver *entities.Driver) error {
+	// 1. Verificar que el usuario exista y tenga el rol de repartidor
+	if err := s.validateDriverUser(ctx, driver.UserID); err != nil {
+		return err
+	}
+
+	// 2. Verificar que el usuario no tenga ya un perfil de repartidor
+	exists, err := s.driverRepo.ExistsDriver(ctx, driver.UserID)
+	if err != nil {
+		logs.Error("Failed to check if driver exists", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": driver.UserID,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "CreateDriver", "Error checking driver existence", err)
+	}
+
+	if exists {
+		return errPackage.NewDomainErrorWithCause("DriverService", "CreateDriver", "Driver already exists", errPackage.ErrDriverAlreadyExists)
+	}
+
+	// 3. Validar los datos de licencia y vehículo
+	if err = s.validateDriverData(ctx, driver, ""); err != nil {
+		return err
+	}
+
+	// 4. Crear el repartidor
+	err = s.driverRepo.CreateDriver(ctx, driver)
+	if err != nil {
+		logs.Error("Failed to create driver", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": driver.UserID,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "CreateDriver", "Error creating driver", err)
+	}
+
+	logs.Info("Driver created successfully", map[string]interface{}{
+		"user_id": driver.UserID,
+	})
+
+	return nil
+}
+
+// GetDriverByID obtiene un repartidor por su ID de usuario
+func (s *DriverService) GetDriverByID(ctx context.Context, driverID string) (*entities.Driver, error) {
+	driver, err := s.driverRepo.GetDriverByID(ctx, driverID)
+	if err != nil {
+		logs.Error("Failed to get driver by ID", map[string]interface{}{
+			"error":     err.Error(),
+			"driver_id": driverID,
+		})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("DriverService", "GetDriverByID", "Driver not found", errPackage.ErrDriverNotFound)
+		}
+
+		return nil, errPackage.NewDomainErrorWithCause("DriverService", "GetDriverByID", "Error getting driver by ID", err)
+	}
+
+	return driver, nil
+}
+
+// GetDrivers obtiene los repartidores según los filtros
+func (s *DriverService) GetDrivers(ctx context.Context, params *entities.DriverQueryParams) ([]entities.Driver, int64, error) {
+	drivers, total, err := s.driverRepo.GetDrivers(ctx, params)
+	if err != nil {
+		logs.Error("Failed to list drivers", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, 0, errPackage.NewDomainErrorWithCause("DriverService", "GetDrivers", "Error listing drivers", err)
+	}
+
+	return drivers, total, nil
+}
+
+// UpdateDriver actualiza los datos de licencia y vehículo de un repartidor
+func (s *DriverService) UpdateDriver(ctx context.Context, driver *entities.Driver) error {
+	// 1. Verificar que el repartidor exista
+	existing, err := s.GetDriverByID(ctx, driver.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Validar los campos a actualizar combinados con los existentes
+	merged := *existing
+	mergeDriverUpdate(&merged, driver)
+	if err = s.validateDriverData(ctx, &merged, driver.UserID); err != nil {
+		return err
+	}
+
+	// 3. Actualizar el repartidor
+	driver.UpdatedAt = time.Now()
+	err = s.driverRepo.UpdateDriver(ctx, driver)
+	if err != nil {
+		logs.Error("Failed to update driver", map[string]interface{}{
+			"error":     err.Error(),
+			"driver_id": driver.UserID,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "UpdateDriver", "Error updating driver", err)
+	}
+
+	return nil
+}
+
+// ActivateOrDeactivateDriver activa o desactiva un repartidor
+func (s *DriverService) ActivateOrDeactivateDriver(ctx context.Context, driverID string, active bool) error {
+	// 1. Verificar que el repartidor exista
+	driver, err := s.GetDriverByID(ctx, driverID)
+	if err != nil {
+		return err
+	}
+
+	// 2. No hacer nada si ya se encuentra en el estado solicitado
+	if driver.IsActive == active {
+		logs.Warn("Driver is already in the requested state", map[string]interface{}{
+			"driver_id": driverID,
+			"active":    active,
+		})
+		return nil
+	}
+
+	// 3. Para activar, la licencia debe estar vigente
+	if active && driver.LicenseExpiry.Before(time.Now()) {
+		return errPackage.NewDomainErrorWithCause("DriverService", "ActivateOrDeactivateDriver", "Driver license is expired", errPackage.ErrLicenseExpired)
+	}
+
+	// 4. Actualizar el estado
+	err = s.driverRepo.ActivateOrDeactivate(ctx, driverID, active)
+	if err != nil {
+		logs.Error("Failed to change driver status", map[string]interface{}{
+			"error":     err.Error(),
+			"driver_id": driverID,
+			"active":    active,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "ActivateOrDeactivateDriver", "Error changing driver status", err)
+	}
+
+	return nil
+}
+
+// AssignZones asigna la zona primaria y las secundarias de un repartidor, reemplazando las anteriores
+func (s *DriverService) AssignZones(ctx context.Context, driverID, primaryZoneID string, secondaryZoneIDs []string) error {
+	// 1. Verificar que el repartidor exista
+	if _, err := s.GetDriverByID(ctx, driverID); err != nil {
+		return err
+	}
+
+	if primaryZoneID == "" {
+		return errPackage.NewDomainErrorWithCause("DriverService", "AssignZones", "Primary zone is required", errPackage.ErrPrimaryZoneRequired)
+	}
+
+	// 2. Construir la lista de zonas verificando duplicados
+	zoneIDs := append([]string{primaryZoneID}, secondaryZoneIDs...)
+	seen := make(map[string]bool, len(zoneIDs))
+	zones := make([]entities.DriverZone, 0, len(zoneIDs))
+	for i, zoneID := range zoneIDs {
+		if seen[zoneID] {
+			return errPackage.NewDomainErrorWithCause("DriverService", "AssignZones", "Duplicated zone", errPackage.ErrDuplicateDriverZone)
+		}
+		seen[zoneID] = true
+
+		// 3. Verificar que la zona exista y esté activa
+		zone, err := s.companyRepo.GetZoneByID(ctx, zoneID)
+		if err != nil {
+			logs.Error("Failed to get zone", map[string]interface{}{
+				"error":   err.Error(),
+				"zone_id": zoneID,
+			})
+			return errPackage.NewDomainErrorWithCause("DriverService", "AssignZones", "Zone not found", errPackage.ErrZoneNotFound)
+		}
+
+		if !zone.IsActive {
+			return errPackage.NewDomainErrorWithCause("DriverService", "AssignZones", "Zone is inactive", errPackage.ErrZoneInactive)
+		}
+
+		zones = append(zones, entities.DriverZone{
+			DriverID:  driverID,
+			ZoneID:    zoneID,
+			IsPrimary: i == 0,
+			IsActive:  true,
+			CreatedAt: time.Now(),
+		})
+	}
+
+	// 4. Reemplazar las zonas del repartidor
+	err := s.driverRepo.ReplaceDriverZones(ctx, driverID, zones)
+	if err != nil {
+		logs.Error("Failed to assign driver zones", map[string]interface{}{
+			"error":     err.Error(),
+			"driver_id": driverID,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "AssignZones", "Error assigning driver zones", err)
+	}
+
+	return nil
+}
+
+// GetDriverZones obtiene las zonas activas de un repartidor
+func (s *DriverService) GetDriverZones(ctx context.Context, driverID string) ([]entities.DriverZone, error) {
+	zones, err := s.driverRepo.GetDriverZones(ctx, driverID)
+	if err != nil {
+		logs.Error("Failed to get driver zones", map[string]interface{}{
+			"error":     err.Error(),
+			"driver_id": driverID,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("DriverService", "GetDriverZones", "Error getting driver zones", err)
+	}
+
+	return zones, nil
+}
+
+// validateDriverUser verifica que el usuario exista, esté activo y tenga el rol DRIVER
+func (s *DriverService) validateDriverUser(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get user for driver", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverUser", "User not found", errPackage.ErrUserNotFoundOrUnauthorized)
+	}
+
+	if !user.IsActive {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverUser", "User is deactivated", errPackage.ErrUserDeactivated)
+	}
+
+	roles, err := s.userRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get user roles", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverUser", "Error getting user roles", err)
+	}
+
+	for _, role := range roles {
+		if role.Name == constants.Driver {
+			return nil
+		}
+	}
+
+	return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverUser", "User is not a driver", errPackage.ErrUserIsNotDriver)
+}
+
+// validateDriverData valida los datos obligatorios y la unicidad de licencia y placa
+func (s *DriverService) validateDriverData(ctx context.Context, driver *entities.Driver, excludeID string) error {
+	// 1. Validar campos obligatorios
+	if driver.LicenseNumber == "" || driver.VehiclePlate == "" || driver.VehicleModel == "" ||
+		driver.VehicleColor == "" || driver.LicenseExpiry.IsZero() {
+		return errPackage.NewDomainError("DriverService", "validateDriverData", errPackage.ErrInvalidDriverData.Error())
+	}
+
+	// 2. Validar valores específicos
+	if !constants.ValidVehicleTypes[driver.VehicleType] {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverData", "Invalid vehicle type", errPackage.ErrInvalidVehicleType)
+	}
+
+	if driver.LicenseExpiry.Before(time.Now()) {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverData", "Driver license is expired", errPackage.ErrLicenseExpired)
+	}
+
+	// 3. Validar unicidad
+	exists, err := s.driverRepo.ExistsLicenseNumber(ctx, driver.LicenseNumber, excludeID)
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverData", "Error checking license number uniqueness", err)
+	}
+	if exists {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverData", "License number already exists", errPackage.ErrDuplicateLicenseNumber)
+	}
+
+	exists, err = s.driverRepo.ExistsVehiclePlate(ctx, driver.VehiclePlate, excludeID)
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverData", "Error checking vehicle plate uniqueness", err)
+	}
+	if exists {
+		return errPackage.NewDomainErrorWithCause("DriverService", "validateDriverData", "Vehicle plate already exists", errPackage.ErrDuplicateVehiclePlate)
+	}
+
+	return nil
+}
+
+// mergeDriverUpdate aplica sobre el repartidor existente los campos informados en la actualización
+func mergeDriverUpdate(existing *entities.Driver, update *entities.Driver) {
+	if update.LicenseNumber != "" {
+		existing.LicenseNumber = update.LicenseNumber
+	}
+	if !update.LicenseExpiry.IsZero() {
+		existing.LicenseExpiry = update.LicenseExpiry
+	}
+	if update.VehicleType != "" {
+		existing.VehicleType = update.VehicleType
+	}
+	if update.VehiclePlate != "" {
+		existing.VehiclePlate = update.VehiclePlate
+	}
+	if update.VehicleModel != "" {
+		existing.VehicleModel = update.VehicleModel
+	}
+	if update.VehicleColor != "" {
+		existing.VehicleColor = update.VehicleColor
+	}
+}
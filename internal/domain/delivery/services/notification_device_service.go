@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// NotificationDeviceService registra los dispositivos push. Un token pertenece a un solo dispositivo,
+// registrarlo otra vez actualiza el dispositivo existente en lugar de duplicarlo
+type NotificationDeviceService struct {
+	repo ports.NotificationDeviceRepository
+}
+
+func NewNotificationDeviceService(repo ports.NotificationDeviceRepository) interfaces.NotificationDeviceManager {
+	return &NotificationDeviceService{
+		repo: repo,
+	}
+}
+
+func (s *NotificationDeviceService) RegisterDevice(ctx context.Context, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error) {
+	// 1. Validar el token y el tipo de dispositivo
+	deviceType := strings.ToUpper(input.DeviceType)
+	if err := validateDeviceInput(input.DeviceToken, deviceType, "RegisterDevice"); err != nil {
+		return nil, err
+	}
+
+	// 2. Si el token ya está registrado se reactiva para el usuario actual, el teléfono pudo cambiar de sesión
+	now := time.Now()
+	device, err := s.repo.GetByToken(ctx, input.DeviceToken)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", "RegisterDevice", "failed to get device by token", err)
+	}
+	if device != nil {
+		device.UserID = input.UserID
+		device.DeviceType = deviceType
+		device.IsActive = true
+		device.FailureCount = 0
+		device.LastUsedAt = &now
+		device.UpdatedAt = now
+		if err = s.repo.Update(ctx, device); err != nil {
+			return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", "RegisterDevice", "failed to update device", err)
+		}
+		return device, nil
+	}
+
+	// 3. Registrar el dispositivo nuevo
+	device = &entities.NotificationDevice{
+		ID:          uuid.NewString(),
+		UserID:      input.UserID,
+		DeviceToken: input.DeviceToken,
+		DeviceType:  deviceType,
+		IsActive:    true,
+		LastUsedAt:  &now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err = s.repo.Create(ctx, device); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", "RegisterDevice", "failed to create device", err)
+	}
+
+	return device, nil
+}
+
+func (s *NotificationDeviceService) RefreshDevice(ctx context.Context, deviceID string, input *entities.NotificationDeviceInput) (*entities.NotificationDevice, error) {
+	// 1. Obtener el dispositivo del usuario, sin tipo se conserva el que tenía
+	device, err := s.getUserDevice(ctx, input.UserID, deviceID, "RefreshDevice")
+	if err != nil {
+		return nil, err
+	}
+
+	deviceType := strings.ToUpper(input.DeviceType)
+	if deviceType == "" {
+		deviceType = device.DeviceType
+	}
+	if err = validateDeviceInput(input.DeviceToken, deviceType, "RefreshDevice"); err != nil {
+		return nil, err
+	}
+
+	// 2. Si el token nuevo ya estaba registrado en otro dispositivo, ese registro queda reemplazado
+	if input.DeviceToken != device.DeviceToken {
+		previous, err := s.repo.GetByToken(ctx, input.DeviceToken)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", "RefreshDevice", "failed to get device by token", err)
+		}
+		if previous != nil {
+			if err = s.repo.Delete(ctx, previous.ID); err != nil {
+				return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", "RefreshDevice", "failed to delete replaced device", err)
+			}
+		}
+	}
+
+	// 3. Guardar el token y reactivar el dispositivo
+	now := time.Now()
+	device.DeviceToken = input.DeviceToken
+	device.DeviceType = deviceType
+	device.IsActive = true
+	device.FailureCount = 0
+	device.LastUsedAt = &now
+	device.UpdatedAt = now
+	if err = s.repo.Update(ctx, device); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", "RefreshDevice", "failed to update device", err)
+	}
+
+	return device, nil
+}
+
+func (s *NotificationDeviceService) UnregisterDevice(ctx context.Context, userID, deviceID string) error {
+	if _, err := s.getUserDevice(ctx, userID, deviceID, "UnregisterDevice"); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, deviceID); err != nil {
+		return errPackage.NewDomainErrorWithCause("NotificationDeviceService", "UnregisterDevice", "failed to delete device", err)
+	}
+
+	return nil
+}
+
+// getUserDevice obtiene el dispositivo, uno de otro usuario se trata como inexistente para no revelar cuáles existen
+func (s *NotificationDeviceService) getUserDevice(ctx context.Context, userID, deviceID, operation string) (*entities.NotificationDevice, error) {
+	device, err := s.repo.GetByID(ctx, deviceID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", operation, "failed to get device", err)
+	}
+	if device == nil || device.UserID != userID {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationDeviceService", operation, errPackage.ErrNotificationDeviceNotFound.Error(), errPackage.ErrNotificationDeviceNotFound)
+	}
+
+	return device, nil
+}
+
+func validateDeviceInput(token, deviceType, operation string) error {
+	if strings.TrimSpace(token) == "" {
+		return errPackage.NewDomainErrorWithCause("NotificationDeviceService", operation, errPackage.ErrDeviceTokenRequired.Error(), errPackage.ErrDeviceTokenRequired)
+	}
+	if !constants.ValidDeviceTypes[deviceType] {
+		return errPackage.NewDomainErrorWithCause("NotificationDeviceService", operation, errPackage.ErrInvalidDeviceType.Error(), errPackage.ErrInvalidDeviceType)
+	}
+
+	return nil
+}
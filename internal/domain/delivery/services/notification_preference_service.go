@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// NotificationPreferenceService consulta y guarda las preferencias de notificación. Los canales de un tipo se
+// resuelven con la preferencia del usuario, luego con el valor por defecto de su empresa y por último con el del sistema
+type NotificationPreferenceService struct {
+	repo ports.NotificationRepository
+}
+
+func NewNotificationPreferenceService(repo ports.NotificationRepository) interfaces.NotificationPreferenceManager {
+	return &NotificationPreferenceService{
+		repo: repo,
+	}
+}
+
+func (s *NotificationPreferenceService) GetUserPreferences(ctx context.Context, userID, companyID string) ([]entities.NotificationChannelSettings, error) {
+	// 1. Obtener las preferencias del usuario y los valores por defecto de su empresa
+	userPreferences, err := s.repo.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "GetUserPreferences", "failed to get user notification preferences", err)
+	}
+	companyPreferences, err := s.repo.GetCompanyPreferences(ctx, companyID)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "GetUserPreferences", "failed to get company notification preferences", err)
+	}
+
+	byUser := make(map[string]*entities.NotificationPreference, len(userPreferences))
+	for i := range userPreferences {
+		byUser[userPreferences[i].NotificationType] = &userPreferences[i]
+	}
+	byCompany := make(map[string]*entities.CompanyNotificationPreference, len(companyPreferences))
+	for i := range companyPreferences {
+		byCompany[companyPreferences[i].NotificationType] = &companyPreferences[i]
+	}
+
+	// 2. Resolver los canales de cada tipo conocido
+	settings := make([]entities.NotificationChannelSettings, 0, len(constants.NotificationTypes))
+	for _, notificationType := range constants.NotificationTypes {
+		settings = append(settings, resolveChannelSettings(notificationType, byUser[notificationType], byCompany[notificationType]))
+	}
+
+	return settings, nil
+}
+
+func (s *NotificationPreferenceService) UpdateUserPreferences(ctx context.Context, userID, companyID string, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error) {
+	// 1. Validar los tipos indicados
+	if err := normalizePreferenceSettings(settings, "UpdateUserPreferences"); err != nil {
+		return nil, err
+	}
+
+	// 2. Guardar las preferencias
+	now := time.Now()
+	preferences := make([]entities.NotificationPreference, len(settings))
+	for i, setting := range settings {
+		preferences[i] = entities.NotificationPreference{
+			UserID:           userID,
+			NotificationType: setting.NotificationType,
+			EmailEnabled:     setting.EmailEnabled,
+			PushEnabled:      setting.PushEnabled,
+			SMSEnabled:       setting.SMSEnabled,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+	}
+	if err := s.repo.SaveUserPreferences(ctx, preferences); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "UpdateUserPreferences", "failed to save user notification preferences", err)
+	}
+
+	// 3. Retornar los canales efectivos de todos los tipos
+	return s.GetUserPreferences(ctx, userID, companyID)
+}
+
+func (s *NotificationPreferenceService) GetCompanyPreferences(ctx context.Context, companyID string) ([]entities.NotificationChannelSettings, error) {
+	companyPreferences, err := s.repo.GetCompanyPreferences(ctx, companyID)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "GetCompanyPreferences", "failed to get company notification preferences", err)
+	}
+
+	byCompany := make(map[string]*entities.CompanyNotificationPreference, len(companyPreferences))
+	for i := range companyPreferences {
+		byCompany[companyPreferences[i].NotificationType] = &companyPreferences[i]
+	}
+
+	settings := make([]entities.NotificationChannelSettings, 0, len(constants.NotificationTypes))
+	for _, notificationType := range constants.NotificationTypes {
+		settings = append(settings, resolveChannelSettings(notificationType, nil, byCompany[notificationType]))
+	}
+
+	return settings, nil
+}
+
+func (s *NotificationPreferenceService) UpdateCompanyPreferences(ctx context.Context, companyID string, settings []entities.NotificationChannelSettings) ([]entities.NotificationChannelSettings, error) {
+	// 1. Validar los tipos indicados
+	if err := normalizePreferenceSettings(settings, "UpdateCompanyPreferences"); err != nil {
+		return nil, err
+	}
+
+	// 2. Guardar los valores por defecto, aplican a los usuarios que no configuraron el tipo
+	now := time.Now()
+	preferences := make([]entities.CompanyNotificationPreference, len(settings))
+	for i, setting := range settings {
+		preferences[i] = entities.CompanyNotificationPreference{
+			CompanyID:        companyID,
+			NotificationType: setting.NotificationType,
+			EmailEnabled:     setting.EmailEnabled,
+			PushEnabled:      setting.PushEnabled,
+			SMSEnabled:       setting.SMSEnabled,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+	}
+	if err := s.repo.SaveCompanyPreferences(ctx, preferences); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "UpdateCompanyPreferences", "failed to save company notification preferences", err)
+	}
+
+	return s.GetCompanyPreferences(ctx, companyID)
+}
+
+// normalizePreferenceSettings pasa los tipos a mayúsculas y rechaza tipos desconocidos o repetidos
+func normalizePreferenceSettings(settings []entities.NotificationChannelSettings, operation string) error {
+	seen := make(map[string]bool, len(settings))
+	for i := range settings {
+		notificationType := strings.ToUpper(settings[i].NotificationType)
+		if !constants.ValidNotificationTypes[notificationType] || seen[notificationType] {
+			return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", operation, errPackage.ErrInvalidNotificationType.Error(), errPackage.ErrInvalidNotificationType)
+		}
+		seen[notificationType] = true
+		settings[i].NotificationType = notificationType
+	}
+
+	return nil
+}
+
+// resolveChannelSettings aplica la preferencia del usuario, luego la de la empresa y por último los valores del sistema
+func resolveChannelSettings(notificationType string, user *entities.NotificationPreference, company *entities.CompanyNotificationPreference) entities.NotificationChannelSettings {
+	switch {
+	case user != nil:
+		return entities.NotificationChannelSettings{
+			NotificationType: notificationType,
+			EmailEnabled:     user.EmailEnabled,
+			PushEnabled:      user.PushEnabled,
+			SMSEnabled:       user.SMSEnabled,
+			Source:           constants.NotificationPreferenceSourceUser,
+		}
+	case company != nil:
+		return entities.NotificationChannelSettings{
+			NotificationType: notificationType,
+			EmailEnabled:     company.EmailEnabled,
+			PushEnabled:      company.PushEnabled,
+			SMSEnabled:       company.SMSEnabled,
+			Source:           constants.NotificationPreferenceSourceCompany,
+		}
+	default:
+		return entities.NotificationChannelSettings{
+			NotificationType: notificationType,
+			EmailEnabled:     constants.DefaultNotificationEmailEnabled,
+			PushEnabled:      constants.DefaultNotificationPushEnabled,
+			SMSEnabled:       constants.DefaultNotificationSMSEnabled,
+			Source:           constants.NotificationPreferenceSourceDefault,
+		}
+	}
+}
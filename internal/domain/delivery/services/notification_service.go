@@ -0,0 +1,316 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	wsModels "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// NotificationService renderiza las plantillas y entrega las notificaciones por los senders registrados.
+// Un canal sin sender registrado se omite aunque el usuario lo tenga habilitado. Las notificaciones nuevas
+// se envían además en vivo a las conexiones WebSocket del usuario
+type NotificationService struct {
+	repo     ports.NotificationRepository
+	userRepo ports.UserRepository
+	live     ports.LiveNotificationRepository
+	senders  map[string]interfaces.NotificationSender
+}
+
+func NewNotificationService(repo ports.NotificationRepository, userRepo ports.UserRepository, live ports.LiveNotificationRepository, senders ...interfaces.NotificationSender) interfaces.Notifier {
+	registered := make(map[string]interfaces.NotificationSender, len(senders))
+	for _, sender := range senders {
+		registered[sender.Channel()] = sender
+	}
+
+	return &NotificationService{
+		repo:     repo,
+		userRepo: userRepo,
+		live:     live,
+		senders:  registered,
+	}
+}
+
+func (s *NotificationService) Notify(ctx context.Context, input *entities.NotificationInput) (*entities.Notification, error) {
+	// 1. Validar que se indique el destinatario y el tipo
+	if input.UserID == "" || input.Type == "" {
+		return nil, errPackage.NewDomainError("NotificationService", "Notify", "user id and notification type are required")
+	}
+
+	// 2. Con una clave de deduplicación los reintentos no repiten la notificación ya entregada
+	id := uuid.NewString()
+	if input.DedupKey != "" {
+		id = notificationID(input)
+		existing, err := s.repo.GetByID(ctx, id)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to get notification", err)
+		}
+		if existing != nil {
+			if existing.SentAt == nil {
+				s.deliver(ctx, existing)
+			}
+			return existing, nil
+		}
+	}
+
+	// 3. Renderizar la plantilla del tipo
+	tmpl, err := s.repo.GetActiveTemplateByType(ctx, input.Type)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "notification template not found for type "+input.Type, errPackage.ErrNotificationTemplateNotFound)
+		}
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to get notification template", err)
+	}
+
+	title, err := renderNotificationTemplate(tmpl.Name+":title", tmpl.TitleTemplate, input.Variables)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", err.Error(), errPackage.ErrInvalidNotificationTemplate)
+	}
+	content, err := renderNotificationTemplate(tmpl.Name+":content", tmpl.ContentTemplate, input.Variables)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", err.Error(), errPackage.ErrInvalidNotificationTemplate)
+	}
+
+	// 4. Guardar la notificación, queda en la bandeja del usuario aunque no tenga canales habilitados
+	metadata := input.Metadata
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to marshal notification metadata", err)
+	}
+
+	notification := &entities.Notification{
+		ID:         id,
+		UserID:     input.UserID,
+		TemplateID: tmpl.ID,
+		Title:      title,
+		Content:    content,
+		Type:       input.Type,
+		Metadata:   string(metadataJSON),
+		CreatedAt:  time.Now(),
+	}
+	if err = s.repo.Create(ctx, notification); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to save notification", err)
+	}
+
+	// 5. Enviar en vivo a la bandeja y entregar por los canales habilitados
+	s.push(ctx, notification)
+	s.deliver(ctx, notification)
+
+	return notification, nil
+}
+
+// push envía la notificación a las conexiones WebSocket del usuario junto con el total de no leídas,
+// un fallo no afecta la entrega porque la notificación ya está en la bandeja
+func (s *NotificationService) push(ctx context.Context, notification *entities.Notification) {
+	if s.live == nil {
+		return
+	}
+
+	unread, err := s.repo.CountUnread(ctx, notification.UserID)
+	if err != nil {
+		logs.Warn("Failed to count unread notifications", map[string]interface{}{
+			"notificationID": notification.ID,
+			"userID":         notification.UserID,
+			"error":          err.Error(),
+		})
+	}
+
+	data := &wsModels.NotificationData{
+		ID:          notification.ID,
+		Type:        notification.Type,
+		Title:       notification.Title,
+		Content:     notification.Content,
+		Metadata:    json.RawMessage(notification.Metadata),
+		CreatedAt:   notification.CreatedAt,
+		UnreadCount: unread,
+	}
+	if err = s.live.SendNotification(notification.UserID, data); err != nil {
+		logs.Warn("Failed to push notification", map[string]interface{}{
+			"notificationID": notification.ID,
+			"userID":         notification.UserID,
+			"error":          err.Error(),
+		})
+	}
+}
+
+// deliver envía la notificación por cada canal habilitado para el usuario. Un canal que falla no impide
+// los demás ni se reintenta, la notificación sigue disponible en la bandeja del usuario
+func (s *NotificationService) deliver(ctx context.Context, notification *entities.Notification) {
+	// 1. Obtener el destinatario y los canales habilitados
+	recipient, err := s.userRepo.GetByID(ctx, notification.UserID)
+	if err != nil {
+		logs.Error("Failed to get notification recipient", map[string]interface{}{
+			"notificationID": notification.ID,
+			"userID":         notification.UserID,
+			"error":          err.Error(),
+		})
+		return
+	}
+
+	channels, err := s.enabledChannels(ctx, recipient, notification.Type)
+	if err != nil {
+		logs.Error("Failed to get notification preferences", map[string]interface{}{
+			"notificationID": notification.ID,
+			"userID":         notification.UserID,
+			"error":          err.Error(),
+		})
+		return
+	}
+	if len(channels) == 0 {
+		return
+	}
+
+	// 2. Enviar por cada canal
+	sent := false
+	for _, channel := range channels {
+		if err = s.senders[channel].Send(ctx, notification, recipient); err != nil {
+			logs.Warn("Failed to send notification", map[string]interface{}{
+				"notificationID": notification.ID,
+				"channel":        channel,
+				"error":          err.Error(),
+			})
+			continue
+		}
+		sent = true
+	}
+
+	// 3. Guardar la fecha de entrega
+	if !sent {
+		return
+	}
+	sentAt := time.Now()
+	if err = s.repo.MarkSent(ctx, notification.ID, sentAt); err != nil {
+		logs.Error("Failed to mark notification as sent", map[string]interface{}{
+			"notificationID": notification.ID,
+			"error":          err.Error(),
+		})
+		return
+	}
+	notification.SentAt = &sentAt
+}
+
+// enabledChannels retorna los canales habilitados para el tipo que tienen un sender registrado. Si el usuario
+// no configuró el tipo se usa el valor por defecto de su empresa y, sin este, el del sistema
+func (s *NotificationService) enabledChannels(ctx context.Context, recipient *entities.User, notificationType string) ([]string, error) {
+	preference, err := s.repo.GetPreference(ctx, recipient.ID, notificationType)
+	if err != nil {
+		return nil, err
+	}
+
+	var companyPreference *entities.CompanyNotificationPreference
+	if preference == nil {
+		companyPreference, err = s.repo.GetCompanyPreference(ctx, recipient.CompanyID, notificationType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	settings := resolveChannelSettings(notificationType, preference, companyPreference)
+	enabled := map[string]bool{
+		constants.NotificationChannelEmail: settings.EmailEnabled,
+		constants.NotificationChannelPush:  settings.PushEnabled,
+		constants.NotificationChannelSMS:   settings.SMSEnabled,
+	}
+
+	var channels []string
+	for _, channel := range []string{constants.NotificationChannelEmail, constants.NotificationChannelPush, constants.NotificationChannelSMS} {
+		if _, ok := s.senders[channel]; ok && enabled[channel] {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels, nil
+}
+
+func (s *NotificationService) GetUserNotifications(ctx context.Context, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error) {
+	notifications, total, err := s.repo.GetUserNotifications(ctx, params)
+	if err != nil {
+		return nil, 0, errPackage.NewDomainErrorWithCause("NotificationService", "GetUserNotifications", "failed to get notifications", err)
+	}
+
+	return notifications, total, nil
+}
+
+func (s *NotificationService) CountUnread(ctx context.Context, userID string) (int64, error) {
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return 0, errPackage.NewDomainErrorWithCause("NotificationService", "CountUnread", "failed to count unread notifications", err)
+	}
+
+	return unread, nil
+}
+
+func (s *NotificationService) MarkAsRead(ctx context.Context, userID, notificationID string) (*entities.Notification, error) {
+	// 1. Obtener la notificación, una de otro usuario se trata como inexistente para no revelar cuáles existen
+	notification, err := s.repo.GetByID(ctx, notificationID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "MarkAsRead", "failed to get notification", err)
+	}
+	if notification == nil || notification.UserID != userID {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "MarkAsRead", errPackage.ErrNotificationNotFound.Error(), errPackage.ErrNotificationNotFound)
+	}
+
+	// 2. Marcarla como leída, si ya lo estaba se conserva la fecha original
+	if notification.IsRead {
+		return notification, nil
+	}
+
+	readAt := time.Now()
+	if err = s.repo.MarkRead(ctx, userID, notificationID, readAt); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "MarkAsRead", "failed to mark notification as read", err)
+	}
+	notification.IsRead = true
+	notification.ReadAt = &readAt
+
+	return notification, nil
+}
+
+func (s *NotificationService) MarkAllAsRead(ctx context.Context, userID string) (int64, error) {
+	updated, err := s.repo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return 0, errPackage.NewDomainErrorWithCause("NotificationService", "MarkAllAsRead", "failed to mark notifications as read", err)
+	}
+
+	return updated, nil
+}
+
+// renderNotificationTemplate ejecuta la plantilla, una variable que falta es un error para no enviar textos incompletos
+func renderNotificationTemplate(name, text string, variables map[string]interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	var rendered bytes.Buffer
+	if err = tmpl.Execute(&rendered, variables); err != nil {
+		return "", err
+	}
+
+	return rendered.String(), nil
+}
+
+// notificationID deriva el ID de la clave de deduplicación, el destinatario y el tipo
+func notificationID(input *entities.NotificationInput) string {
+	name := "delivery-backend/notifications/" + input.DedupKey + "/" + input.UserID + "/" + input.Type
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String()
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type OrderAccessService struct {
+	orderRepo ports.OrdererRepository
+}
+
+func NewOrderAccessService(orderRepo ports.OrdererRepository) interfaces.OrderAccessAuthorizer {
+	return &OrderAccessService{
+		orderRepo: orderRepo,
+	}
+}
+
+// AuthorizeOrderAccess obtiene el pedido y verifica que el usuario pueda acceder a él según su rol
+func (s *OrderAccessService) AuthorizeOrderAccess(ctx context.Context, claims *auth.AuthClaims, orderID string) (*entities.Order, error) {
+	if claims == nil || claims.UserID == "" {
+		return nil, errPackage.NewDomainErrorWithCause("OrderAccessService", "AuthorizeOrderAccess", "missing user claims", errPackage.ErrUserNotFoundOrUnauthorized)
+	}
+
+	// 1. Obtener el pedido
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("OrderAccessService", "AuthorizeOrderAccess", "order not found", errPackage.ErrOrderNotFound)
+		}
+		return nil, errPackage.NewDomainErrorWithCause("OrderAccessService", "AuthorizeOrderAccess", "failed to get order", err)
+	}
+
+	// 2. Verificar el acceso del usuario
+	if !canAccessOrder(claims, order) {
+		logs.Warn("User is not allowed to access order", map[string]interface{}{
+			"user_id":  claims.UserID,
+			"role":     claims.Role,
+			"order_id": orderID,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderAccessService", "AuthorizeOrderAccess", "access to order denied", errPackage.ErrOrderAccessDenied)
+	}
+
+	return order, nil
+}
+
+// canAccessOrder aplica la política de acceso a un pedido:
+// los administradores acceden a todo, los repartidores solo a los pedidos que tienen asignados
+// y el resto de usuarios a los pedidos de su compañía o a los que son clientes
+func canAccessOrder(claims *auth.AuthClaims, order *entities.Order) bool {
+	switch claims.Role {
+	case constants.AdminRole:
+		return true
+	case constants.Driver:
+		return order.DriverID != nil && *order.DriverID == claims.UserID
+	}
+
+	if claims.CompanyID != "" && claims.CompanyID == order.CompanyID {
+		return true
+	}
+
+	return claims.UserID == order.ClientID
+}
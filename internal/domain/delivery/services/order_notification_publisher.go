@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// orderNotificationRecipient indica a quién se notifica un evento de pedido y con qué tipo de notificación
+type orderNotificationRecipient struct {
+	notificationType string
+	userID           func(event *entities.OrderEvent, order *entities.Order) string
+}
+
+func orderClient(_ *entities.OrderEvent, order *entities.Order) string {
+	return order.ClientID
+}
+
+// orderDriver usa el repartidor del evento, el pedido pudo cambiar de repartidor antes de publicarse
+func orderDriver(event *entities.OrderEvent, order *entities.Order) string {
+	if event.Data.DriverID != "" {
+		return event.Data.DriverID
+	}
+	if order.DriverID == nil {
+		return ""
+	}
+	return *order.DriverID
+}
+
+// orderNotificationRecipients define las notificaciones de cada evento de pedido. Las ubicaciones no se notifican
+// y la entrega se notifica con su propio evento, no como cambio de estado
+var orderNotificationRecipients = map[string][]orderNotificationRecipient{
+	constants.OrderEventCreated:       {{constants.NotificationOrderCreated, orderClient}},
+	constants.OrderEventStatusChanged: {{constants.NotificationOrderStatusChanged, orderClient}},
+	constants.OrderEventDriverAssigned: {
+		{constants.NotificationOrderDriverAssigned, orderClient},
+		{constants.NotificationOrderAssignedToDriver, orderDriver},
+	},
+	constants.OrderEventDelivered: {{constants.NotificationOrderDelivered, orderClient}},
+	constants.OrderEventDeleted:   {{constants.NotificationOrderDeleted, orderClient}},
+}
+
+// OrderNotificationPublisher notifica a los usuarios los eventos de pedidos publicados por el relay del outbox
+type OrderNotificationPublisher struct {
+	repo     ports.OrdererRepository
+	notifier interfaces.Notifier
+}
+
+func NewOrderNotificationPublisher(repo ports.OrdererRepository, notifier interfaces.Notifier) interfaces.OrderEventPublisher {
+	return &OrderNotificationPublisher{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
+
+// PublishOrderEvent notifica a cada destinatario del evento. Las notificaciones usan el ID del evento como clave
+// de deduplicación, así los reintentos del relay no repiten las ya entregadas
+func (p *OrderNotificationPublisher) PublishOrderEvent(ctx context.Context, event *entities.OrderEvent) error {
+	// 1. Obtener los destinatarios del evento
+	recipients, ok := orderNotificationRecipients[event.Type]
+	if !ok {
+		return nil
+	}
+	if event.Type == constants.OrderEventStatusChanged && event.Data.Status == constants.OrderStatusDelivered {
+		return nil
+	}
+
+	// 2. Obtener el pedido para conocer al cliente y al repartidor
+	order, err := p.repo.GetOrderByID(ctx, event.Data.OrderID)
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderNotificationPublisher", "PublishOrderEvent", "failed to get order by id", err)
+	}
+
+	variables := map[string]interface{}{
+		"order_id":        order.ID,
+		"tracking_number": event.Data.TrackingNumber,
+		"status":          event.Data.Status,
+		"previous_status": event.Data.PreviousStatus,
+	}
+	metadata := map[string]interface{}{
+		"order_id":        order.ID,
+		"tracking_number": event.Data.TrackingNumber,
+		"event_id":        event.ID,
+		"event_type":      event.Type,
+	}
+
+	// 3. Notificar a cada destinatario, una plantilla faltante no bloquea el evento
+	for _, recipient := range recipients {
+		userID := recipient.userID(event, order)
+		if userID == "" {
+			continue
+		}
+
+		_, err = p.notifier.Notify(ctx, &entities.NotificationInput{
+			UserID:    userID,
+			Type:      recipient.notificationType,
+			Variables: variables,
+			Metadata:  metadata,
+			DedupKey:  event.ID,
+		})
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, errPackage.ErrNotificationTemplateNotFound) || errors.Is(err, errPackage.ErrInvalidNotificationTemplate) {
+			logs.Warn("Order notification skipped", map[string]interface{}{
+				"eventID":          event.ID,
+				"notificationType": recipient.notificationType,
+				"error":            err.Error(),
+			})
+			continue
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 	"math/rand"
 	"time"
 
@@ -17,6 +20,8 @@ import (
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
+// OrderService gestiona los pedidos. Los cambios de ciclo de vida registran su evento en el outbox
+// dentro de la misma transacción, el relay se encarga de notificar a los clientes y webhooks.
 type OrderService struct {
 	repo           ports.OrdererRepository
 	trackerService interfaces.OrderTracker
@@ -55,8 +60,33 @@ func (o OrderService) GetOrdersByClientID(ctx context.Context, clientID string)
 	return getOrders, nil
 }
 
-func (o OrderService) AssignDriverToOrder(ctx context.Context, orderID, driverID string) error {
-	err := o.repo.AssignDriverToOrder(ctx, orderID, driverID)
+// AssignDriverToOrder asigna el repartidor a un pedido sin repartidor. Si otro proceso lo asignó antes o el repartidor
+// alcanzó maxActiveOrders no se cambia nada y se retorna ErrOrderAlreadyAssigned
+func (o OrderService) AssignDriverToOrder(ctx context.Context, orderID, driverID string, maxActiveOrders int) error {
+	// 1. Obtener el pedido para construir el evento
+	order, err := o.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		logs.Error("Failed to get order by id", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "AssignDriverToOrder", "failed to get order by id", err)
+	}
+
+	event, err := newOrderOutboxEvent(constants.OrderEventDriverAssigned, order, entities.OrderEventData{DriverID: driverID})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "AssignDriverToOrder", "failed to build order event", err)
+	}
+
+	// 2. Asignar el repartidor, el evento se guarda en la misma transacción
+	err = o.repo.AssignDriverToOrder(ctx, orderID, driverID, maxActiveOrders, event)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Warn("Driver assignment skipped, order or driver changed concurrently", map[string]interface{}{
+			"orderID":  orderID,
+			"driverID": driverID,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "AssignDriverToOrder", "order already has a driver or the driver reached the max active orders", errPackage.ErrOrderAlreadyAssigned)
+	}
 	if err != nil {
 		logs.Error("Failed to assign driver to order", map[string]interface{}{
 			"orderID":  orderID,
@@ -66,12 +96,6 @@ func (o OrderService) AssignDriverToOrder(ctx context.Context, orderID, driverID
 		return errPackage.NewDomainErrorWithCause("OrderService", "AssignDriverToOrder", "failed to assign driver to order", err)
 	}
 
-	// Notificar a los clientes sobre la asignación del conductor
-	order, err := o.repo.GetOrderByID(ctx, orderID)
-	if err == nil && order != nil {
-		o.notifyOrderUpdate(order, "Se ha asignado un conductor a tu pedido")
-	}
-
 	return nil
 }
 
@@ -97,8 +121,13 @@ func (o OrderService) CreateOrder(ctx context.Context, order *entities.Order) er
 		return err
 	}
 
-	//4. Crear pedido
-	err := o.repo.CreateOrder(ctx, order)
+	//4. Crear pedido junto con su evento
+	event, err := newOrderOutboxEvent(constants.OrderEventCreated, order, entities.OrderEventData{Status: constants.OrderStatusPending})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to build order event", err)
+	}
+
+	err = o.repo.CreateOrder(ctx, order, event)
 	if err != nil {
 		logs.Error("Failed to create order", map[string]interface{}{
 			"orderID":        order.ID,
@@ -119,9 +148,6 @@ func (o OrderService) CreateOrder(ctx context.Context, order *entities.Order) er
 		return errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to create qr code", err)
 	}
 
-	// 6. Notificar la creación del pedido
-	o.notifyOrderUpdate(order, "Pedido creado correctamente")
-
 	return nil
 }
 
@@ -162,8 +188,25 @@ func (o OrderService) ChangeStatus(ctx context.Context, id, status string) error
 		return errPackage.NewDomainError("OrderService", "ChangeStatus", fmt.Sprintf("invalid transition from %s to %s", order.Status, status))
 	}
 
-	// 5. Cambiar estado
-	err = o.repo.ChangeStatus(ctx, id, status)
+	// 5. Construir los eventos, la entrega se publica además como un evento propio
+	eventOrder := *order
+	eventOrder.Status = status
+	statusEvent, err := newOrderOutboxEvent(constants.OrderEventStatusChanged, &eventOrder, entities.OrderEventData{PreviousStatus: order.Status})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeStatus", "failed to build order event", err)
+	}
+	events := []*entities.OutboxEvent{statusEvent}
+
+	if status == constants.OrderStatusDelivered {
+		deliveredEvent, err := newOrderOutboxEvent(constants.OrderEventDelivered, &eventOrder, entities.OrderEventData{})
+		if err != nil {
+			return errPackage.NewDomainErrorWithCause("OrderService", "ChangeStatus", "failed to build order event", err)
+		}
+		events = append(events, deliveredEvent)
+	}
+
+	// 6. Cambiar estado junto con sus eventos
+	err = o.repo.ChangeStatus(ctx, id, status, events...)
 	if err != nil {
 		logs.Error("Failed to change status", map[string]interface{}{
 			"orderID": id,
@@ -173,13 +216,6 @@ func (o OrderService) ChangeStatus(ctx context.Context, id, status string) error
 		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeStatus", "failed to change status", err)
 	}
 
-	// 6. Obtener el pedido actualizado y notificar a los clientes
-	updatedOrder, err := o.repo.GetOrderByID(ctx, id)
-	if err == nil && updatedOrder != nil {
-		description := getStatusChangeDescription(order.Status, status)
-		o.notifyOrderUpdate(updatedOrder, description)
-	}
-
 	return nil
 }
 
@@ -280,8 +316,21 @@ func (o OrderService) SoftDeleteOrder(ctx context.Context, id string) error {
 		return errPackage.NewDomainErrorWithCause("OrderService", "SoftDeleteOrder", "order is already deleted", errPackage.ErrOrderAlreadyDeleted)
 	}
 
-	// 2. Verificar si el pedido esta en un estado que permite eliminacion
-	err := o.repo.SoftDeleteOrder(ctx, id)
+	// 2. Obtener el pedido para construir el evento
+	order, err := o.GetOrderByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	eventOrder := *order
+	eventOrder.Status = constants.OrderStatusDeleted
+	event, err := newOrderOutboxEvent(constants.OrderEventDeleted, &eventOrder, entities.OrderEventData{PreviousStatus: order.Status})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "SoftDeleteOrder", "failed to build order event", err)
+	}
+
+	// 3. Eliminar el pedido junto con su evento
+	err = o.repo.SoftDeleteOrder(ctx, id, event)
 	if err != nil {
 		logs.Error("Failed to soft delete order", map[string]interface{}{
 			"orderID": id,
@@ -290,12 +339,6 @@ func (o OrderService) SoftDeleteOrder(ctx context.Context, id string) error {
 		return errPackage.NewDomainErrorWithCause("OrderService", "SoftDeleteOrder", "failed to soft delete order", err)
 	}
 
-	// 3. Notificar eliminación
-	order, err := o.repo.GetOrderByID(ctx, id)
-	if err == nil && order != nil {
-		o.notifyOrderUpdate(order, "Pedido eliminado")
-	}
-
 	return nil
 }
 
@@ -309,7 +352,21 @@ func (o OrderService) RestoreOrder(ctx context.Context, id string) error {
 		return errPackage.NewDomainErrorWithCause("OrderService", "RestoreOrder", "order is not deleted", errPackage.ErrOrderNotDeleted)
 	}
 
-	err := o.repo.RestoreOrder(ctx, id)
+	// 2. Obtener el pedido para construir el evento
+	order, err := o.GetOrderByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	eventOrder := *order
+	eventOrder.Status = constants.OrderStatusRestored
+	event, err := newOrderOutboxEvent(constants.OrderEventRestored, &eventOrder, entities.OrderEventData{PreviousStatus: order.Status})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "RestoreOrder", "failed to build order event", err)
+	}
+
+	// 3. Restaurar el pedido junto con su evento
+	err = o.repo.RestoreOrder(ctx, id, event)
 	if err != nil {
 		logs.Error("Failed to restore order", map[string]interface{}{
 			"orderID": id,
@@ -318,12 +375,6 @@ func (o OrderService) RestoreOrder(ctx context.Context, id string) error {
 		return errPackage.NewDomainErrorWithCause("OrderService", "RestoreOrder", "failed to restore order", err)
 	}
 
-	// Notificar restauración
-	order, err := o.repo.GetOrderByID(ctx, id)
-	if err == nil && order != nil {
-		o.notifyOrderUpdate(order, "Pedido restaurado")
-	}
-
 	return nil
 }
 
@@ -396,6 +447,44 @@ func (o OrderService) notifyOrderUpdate(order *entities.Order, description strin
 	}
 }
 
+// newOrderOutboxEvent construye el evento del pedido que se guarda en el outbox junto con el cambio
+func newOrderOutboxEvent(eventType string, order *entities.Order, data entities.OrderEventData) (*entities.OutboxEvent, error) {
+	// 1. Completar los datos comunes del pedido
+	data.OrderID = order.ID
+	data.TrackingNumber = order.TrackingNumber
+	if data.Status == "" {
+		data.Status = order.Status
+	}
+	if data.DriverID == "" && order.DriverID != nil {
+		data.DriverID = *order.DriverID
+	}
+
+	event := &entities.OrderEvent{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		CompanyID:  order.CompanyID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+
+	// 2. Guardar el evento completo como cuerpo del registro
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.OutboxEvent{
+		ID:            event.ID,
+		AggregateType: constants.OutboxAggregateOrder,
+		AggregateID:   order.ID,
+		EventType:     eventType,
+		Payload:       string(payload),
+		Status:        constants.OutboxEventPending,
+		AvailableAt:   event.OccurredAt,
+		CreatedAt:     event.OccurredAt,
+	}, nil
+}
+
 // getStatusChangeDescription devuelve una descripción amigable para el cambio de estado
 func getStatusChangeDescription(oldStatus, newStatus string) string {
 	switch newStatus {
@@ -442,10 +531,10 @@ func generateTrackingNumber() string {
 	return fmt.Sprintf("%s-%s-%s", prefix, timestamp, random)
 }
 
-// UpdateDriverLocation actualiza la ubicación del repartidor y notifica a los clientes
+// UpdateDriverLocation guarda la ubicación del repartidor en el recorrido del pedido y notifica a los clientes
 func (o OrderService) UpdateDriverLocation(ctx context.Context, orderID string, latitude, longitude float64) error {
-	// Verificar que el pedido existe
-	_, err := o.repo.GetOrderByID(ctx, orderID)
+	// 1. Verificar que el pedido existe
+	order, err := o.repo.GetOrderByID(ctx, orderID)
 	if err != nil {
 		logs.Error("Failed to get order for location update", map[string]interface{}{
 			"orderID": orderID,
@@ -464,11 +553,49 @@ func (o OrderService) UpdateDriverLocation(ctx context.Context, orderID string,
 	//	return errPackage.NewDomainError("OrderService", "UpdateDriverLocation", "order not in right state for location updates")
 	//}
 
-	// Enviar la actualización de ubicación
+	// 2. Validar el punto y que el pedido tenga un repartidor al cual asociarlo
+	if !value_objects.NewGeoPoint(latitude, longitude).IsValid() {
+		return errPackage.NewDomainErrorWithCause("OrderService", "UpdateDriverLocation", "invalid coordinates", errPackage.ErrInvalidLocation)
+	}
+
+	if order.DriverID == nil || *order.DriverID == "" {
+		return errPackage.NewDomainErrorWithCause("OrderService", "UpdateDriverLocation", "order has no driver", errPackage.ErrOrderHasNoDriver)
+	}
+
+	// 3. Guardar el punto en el historial y la ubicación actual junto con su evento
+	now := time.Now()
+	ping := &entities.LocationPing{
+		ID:         uuid.NewString(),
+		OrderID:    order.ID,
+		DriverID:   *order.DriverID,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		RecordedAt: now,
+	}
+
+	event, err := newOrderOutboxEvent(constants.OrderEventLocationUpdated, order, entities.OrderEventData{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "UpdateDriverLocation", "failed to build order event", err)
+	}
+
+	if err = o.repo.SaveLocationPing(ctx, ping, order.Status, event); err != nil {
+		logs.Error("Failed to save location ping", map[string]interface{}{
+			"orderID":  orderID,
+			"driverID": ping.DriverID,
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "UpdateDriverLocation", "failed to save location", err)
+	}
+
+	// 4. Enviar la actualización de ubicación de inmediato, cada punto reemplaza al anterior
+	// así que no pasa por el relay. El evento del outbox llega a los webhooks.
 	locationData := &websocket.LocationUpdateData{
 		Latitude:  latitude,
 		Longitude: longitude,
-		UpdatedAt: time.Now(),
+		UpdatedAt: now,
 	}
 
 	err = o.trackerService.SendLocationUpdate(orderID, locationData)
@@ -484,3 +611,17 @@ func (o OrderService) UpdateDriverLocation(ctx context.Context, orderID string,
 
 	return nil
 }
+
+// GetLocationHistory obtiene el recorrido del repartidor registrado para un pedido
+func (o OrderService) GetLocationHistory(ctx context.Context, orderID string) ([]entities.LocationPing, error) {
+	pings, err := o.repo.GetLocationHistory(ctx, orderID)
+	if err != nil {
+		logs.Error("Failed to get location history", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "GetLocationHistory", "failed to get location history", err)
+	}
+
+	return pings, nil
+}
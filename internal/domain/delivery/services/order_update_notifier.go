@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// OrderUpdateNotifier envía por WebSocket los eventos de pedidos publicados por el relay del outbox
+type OrderUpdateNotifier struct {
+	repo           ports.OrdererRepository
+	trackerService interfaces.OrderTracker
+}
+
+func NewOrderUpdateNotifier(repo ports.OrdererRepository, trackerService interfaces.OrderTracker) interfaces.OrderEventPublisher {
+	return &OrderUpdateNotifier{
+		repo:           repo,
+		trackerService: trackerService,
+	}
+}
+
+// PublishOrderEvent envía el estado actual del pedido a los clientes suscritos.
+// Las ubicaciones se envían directamente desde OrderService y la entrega ya llega como cambio de estado.
+func (n *OrderUpdateNotifier) PublishOrderEvent(ctx context.Context, event *entities.OrderEvent) error {
+	// 1. Obtener la descripción del evento
+	description, ok := orderEventDescription(event)
+	if !ok {
+		return nil
+	}
+
+	// 2. Obtener el pedido actualizado
+	order, err := n.repo.GetOrderByID(ctx, event.Data.OrderID)
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("OrderUpdateNotifier", "PublishOrderEvent", "failed to get order by id", err)
+	}
+
+	// 3. Enviar la actualización con el estado del evento
+	updateData := &websocket.OrderUpdateData{
+		Status:      event.Data.Status,
+		Description: description,
+		UpdatedAt:   event.OccurredAt,
+		Order:       websocket.OrderInfoFromEntity(order),
+	}
+
+	return n.trackerService.SendOrderUpdate(order.ID, updateData)
+}
+
+// orderEventDescription devuelve el mensaje para el cliente, false si el evento no se envía por WebSocket
+func orderEventDescription(event *entities.OrderEvent) (string, bool) {
+	switch event.Type {
+	case constants.OrderEventCreated:
+		return "Pedido creado correctamente", true
+	case constants.OrderEventStatusChanged:
+		return getStatusChangeDescription(event.Data.PreviousStatus, event.Data.Status), true
+	case constants.OrderEventDriverAssigned:
+		return "Se ha asignado un conductor a tu pedido", true
+	case constants.OrderEventDeleted:
+		return "Pedido eliminado", true
+	case constants.OrderEventRestored:
+		return "Pedido restaurado", true
+	default:
+		return "", false
+	}
+}
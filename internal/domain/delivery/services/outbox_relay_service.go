@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	defaultOutboxMaxAttempts = 10
+	// outboxBatchSize es la cantidad de eventos que se publican en cada ejecución del relay
+	outboxBatchSize = 100
+	// outboxLease es el tiempo que un evento queda reservado mientras se publica
+	outboxLease         = time.Minute
+	outboxRetryBase     = 5 * time.Second
+	outboxMaxRetryDelay = 10 * time.Minute
+	outboxMaxError      = 500
+)
+
+// OutboxRelayService publica los eventos del outbox hacia todos los publicadores registrados.
+// Si algún publicador falla el evento se reintenta completo, por eso los publicadores deben
+// tolerar eventos repetidos: cada evento conserva su ID en todos los intentos.
+type OutboxRelayService struct {
+	repo        ports.OutboxRepository
+	publishers  []interfaces.OrderEventPublisher
+	maxAttempts int
+}
+
+func NewOutboxRelayService(repo ports.OutboxRepository, maxAttempts int, publishers ...interfaces.OrderEventPublisher) interfaces.OutboxRelayer {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultOutboxMaxAttempts
+	}
+
+	return &OutboxRelayService{
+		repo:        repo,
+		publishers:  publishers,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// RelayPending publica los eventos pendientes cuya fecha de disponibilidad ya llegó
+func (s *OutboxRelayService) RelayPending(ctx context.Context) (int, error) {
+	ids, err := s.repo.GetDueEventIDs(ctx, time.Now(), outboxBatchSize)
+	if err != nil {
+		return 0, errPackage.NewDomainErrorWithCause("OutboxRelayService", "RelayPending", "failed to get pending events", err)
+	}
+
+	published := 0
+	for _, id := range ids {
+		if s.relay(ctx, id) {
+			published++
+		}
+	}
+
+	return published, nil
+}
+
+// relay reserva el evento, lo entrega a los publicadores y guarda el resultado. Retorna true si se publicó.
+func (s *OutboxRelayService) relay(ctx context.Context, id string) bool {
+	// 1. Reservar el evento, otra instancia pudo haberlo tomado
+	now := time.Now()
+	event, err := s.repo.ClaimEvent(ctx, id, now, now.Add(outboxLease))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Error("Failed to claim outbox event", map[string]interface{}{
+				"eventID": id,
+				"error":   err.Error(),
+			})
+		}
+		return false
+	}
+
+	// 2. Entregar el evento a todos los publicadores
+	publishErr := s.publish(ctx, event)
+	if publishErr == nil {
+		if err := s.repo.MarkPublished(ctx, event.ID, time.Now()); err != nil {
+			logs.Error("Failed to mark outbox event as published", map[string]interface{}{
+				"eventID": event.ID,
+				"error":   err.Error(),
+			})
+		}
+		return true
+	}
+
+	// 3. Programar el reintento o descartar el evento si se agotaron los intentos
+	event.Attempts++
+	event.LastError = truncateError(publishErr.Error(), outboxMaxError)
+	if event.Attempts >= s.maxAttempts {
+		event.Status = constants.OutboxEventFailed
+	} else {
+		event.AvailableAt = time.Now().Add(outboxRetryDelay(event.Attempts))
+	}
+
+	logs.Warn("Failed to relay outbox event", map[string]interface{}{
+		"eventID":   event.ID,
+		"eventType": event.EventType,
+		"attempt":   event.Attempts,
+		"status":    event.Status,
+		"error":     event.LastError,
+	})
+
+	if err := s.repo.RecordFailure(ctx, event); err != nil {
+		logs.Error("Failed to record outbox event failure", map[string]interface{}{
+			"eventID": event.ID,
+			"error":   err.Error(),
+		})
+	}
+
+	return false
+}
+
+// publish entrega el evento a cada publicador y reúne los errores
+func (s *OutboxRelayService) publish(ctx context.Context, record *entities.OutboxEvent) error {
+	var event entities.OrderEvent
+	if err := json.Unmarshal([]byte(record.Payload), &event); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+
+	var failures []string
+	for _, publisher := range s.publishers {
+		if err := publisher.PublishOrderEvent(ctx, &event); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// outboxRetryDelay duplica la espera con cada intento fallido hasta outboxMaxRetryDelay
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBase
+	for i := 1; i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxRetryDelay {
+		delay = outboxMaxRetryDelay
+	}
+
+	return delay
+}
+
+func truncateError(message string, max int) string {
+	if len(message) <= max {
+		return message
+	}
+	return message[:max]
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+)
+
+type PricingService struct {
+	companyRepo ports.CompanyRepository
+}
+
+func NewPricingService(companyRepo ports.CompanyRepository) interfaces.Pricer {
+	return &PricingService{
+		companyRepo: companyRepo,
+	}
+}
+
+// QuoteOrder calcula el precio de envío de un pedido con su desglose
+func (p *PricingService) QuoteOrder(ctx context.Context, order *entities.Order) (*entities.PriceQuote, error) {
+	if order == nil || order.PickupAddress == nil || order.DeliveryAddress == nil || order.PackageDetail == nil {
+		return nil, errPackage.NewDomainError("PricingService", "QuoteOrder", "order, addresses and package details are required")
+	}
+
+	// 1. Validar coordenadas de recogida y entrega
+	pickup := value_objects.NewGeoPoint(order.PickupAddress.Latitude, order.PickupAddress.Longitude)
+	if !isLocatedPoint(pickup) {
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "invalid pickup location", errPackage.ErrInvalidPickupLocation)
+	}
+
+	delivery := value_objects.NewGeoPoint(order.DeliveryAddress.Latitude, order.DeliveryAddress.Longitude)
+	if !isLocatedPoint(delivery) {
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "invalid delivery location", errPackage.ErrInvalidDeliveryLocation)
+	}
+
+	// 2. Obtener la tarifa por kilómetro de la compañía
+	company, err := p.companyRepo.GetCompanyByID(ctx, order.CompanyID)
+	if err != nil {
+		logs.Error("Failed to get company for pricing", map[string]interface{}{
+			"companyID": order.CompanyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "failed to get company", errPackage.ErrCompanyNotFound)
+	}
+
+	if company.DeliveryRate < 0 {
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "invalid company rate", errPackage.ErrInvalidDeliveryRate)
+	}
+
+	// 3. Obtener la zona para la tarifa base: la de la dirección de entrega si ya se resolvió, si no la de la sucursal
+	zoneID, err := p.pricingZoneID(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := p.companyRepo.GetZoneByID(ctx, zoneID)
+	if err != nil {
+		logs.Error("Failed to get zone for pricing", map[string]interface{}{
+			"zoneID": zoneID,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "failed to get zone", errPackage.ErrZoneNotFound)
+	}
+
+	if !zone.IsActive {
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "zone is not available", errPackage.ErrZoneInactive)
+	}
+
+	// 4. Obtener el multiplicador de demanda de la cobertura (1.0 si la zona no tiene cobertura configurada)
+	surgeMultiplier := 1.0
+	coverage, err := p.companyRepo.GetZoneCoverage(ctx, zone.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error("Failed to get zone coverage for pricing", map[string]interface{}{
+			"zoneID": zone.ID,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "failed to get zone coverage", err)
+	}
+	if coverage != nil && coverage.SurgeMultiplier > 0 {
+		surgeMultiplier = coverage.SurgeMultiplier
+	}
+
+	// 5. Calcular el precio
+	quote := buildPriceQuote(pickup.DistanceTo(delivery), zone.BaseRate, company.DeliveryRate, surgeMultiplier, order.PackageDetail)
+	quote.ZoneID = zone.ID
+
+	return quote, nil
+}
+
+// pricingZoneID obtiene la zona con la que se tarifica el pedido
+func (p *PricingService) pricingZoneID(ctx context.Context, order *entities.Order) (string, error) {
+	if order.ZoneID != nil && *order.ZoneID != "" {
+		return *order.ZoneID, nil
+	}
+
+	branch, err := p.companyRepo.GetBranchByID(ctx, order.BranchID)
+	if err != nil {
+		logs.Error("Failed to get branch for pricing", map[string]interface{}{
+			"branchID": order.BranchID,
+			"error":    err.Error(),
+		})
+		return "", errPackage.NewDomainErrorWithCause("PricingService", "QuoteOrder", "failed to get branch", errPackage.ErrBranchNotFound)
+	}
+
+	return branch.ZoneID, nil
+}
+
+// buildPriceQuote arma el desglose de precio a partir de las tarifas ya resueltas
+func buildPriceQuote(distanceKm, baseRate, ratePerKm, surgeMultiplier float64, pkg *entities.PackageDetail) *entities.PriceQuote {
+	quote := &entities.PriceQuote{
+		DistanceKm:      math.Round(distanceKm*100) / 100,
+		BillableWeight:  billableWeight(pkg),
+		SurgeMultiplier: surgeMultiplier,
+		Currency:        constants.PricingCurrency,
+	}
+
+	// 1. Cargos base: tarifa de zona, distancia y sobrepeso
+	quote.AddItem(constants.PriceConceptBaseRate, "Tarifa base de la zona", baseRate)
+	quote.AddItem(constants.PriceConceptDistance, fmt.Sprintf("%.2f km x %.2f", quote.DistanceKm, ratePerKm), quote.DistanceKm*ratePerKm)
+
+	if overweight := quote.BillableWeight - constants.PricingFreeWeightKg; overweight > 0 {
+		quote.AddItem(constants.PriceConceptWeight, fmt.Sprintf("%.2f kg adicionales", overweight), overweight*constants.PricingOverweightRatePerKg)
+	}
+
+	subtotal := quote.Total
+
+	// 2. Recargos por manejo especial sobre el subtotal
+	if pkg.IsFragile {
+		quote.AddItem(constants.PriceConceptFragile, "Recargo por paquete frágil", subtotal*constants.PricingFragileSurchargeRate)
+	}
+
+	if pkg.IsUrgent {
+		quote.AddItem(constants.PriceConceptUrgent, "Recargo por entrega urgente", subtotal*constants.PricingUrgentSurchargeRate)
+	}
+
+	// 3. Recargo por demanda de la zona sobre todo lo anterior
+	if surgeMultiplier > 1 {
+		quote.AddItem(constants.PriceConceptSurge, fmt.Sprintf("Multiplicador de demanda x%.2f", surgeMultiplier), quote.Total*(surgeMultiplier-1))
+	}
+
+	return quote
+}
+
+// billableWeight obtiene el mayor entre el peso real y el peso volumétrico del paquete
+func billableWeight(pkg *entities.PackageDetail) float64 {
+	weight := pkg.Weight
+
+	if pkg.Dimensions != "" {
+		dimensions, err := value_objects.NewDimensionsFromJSON(pkg.Dimensions)
+		if err == nil && dimensions.IsValid() {
+			weight = math.Max(weight, dimensions.Volume()/constants.PricingVolumetricDivisor)
+		}
+	}
+
+	return math.Round(weight*100) / 100
+}
+
+// isLocatedPoint verifica que el punto tenga coordenadas válidas y no sea el origen (0,0) por defecto
+func isLocatedPoint(point *value_objects.GeoPoint) bool {
+	return point.IsValid() && !(point.Latitude() == 0 && point.Longitude() == 0)
+}
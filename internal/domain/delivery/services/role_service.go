@@ -2,13 +2,25 @@ package services
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
 
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
 	error2 "github.com/MarlonG1/delivery-backend/internal/domain/error"
 )
 
+var (
+	roleNamePattern       = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,49}$`)
+	permissionPartPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+)
+
 type RolerService struct {
 	roleRepo ports.RolerRepository
 }
@@ -32,6 +44,9 @@ func (r RolerService) GetRoleByIDOrName(ctx context.Context, param string) (*ent
 	// 1. Verificar si el rol existe
 	role, err := r.roleRepo.GetRoleByIDOrName(ctx, param)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, error2.NewDomainErrorWithCause("RoleService", "GetRoleByID", "role not found", error2.ErrRoleNotFound)
+		}
 		return nil, error2.NewDomainErrorWithCause("RoleService", "GetRoleByID", "failed to get role", err)
 	}
 
@@ -66,3 +81,265 @@ func (r RolerService) IsRoleActive(ctx context.Context, id string) (bool, error)
 
 	return isActive, nil
 }
+
+func (r RolerService) GetRoleByID(ctx context.Context, id string) (*entities.Role, error) {
+	// 1. Obtener el rol sin importar su estado
+	role, err := r.roleRepo.GetRoleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, error2.NewDomainErrorWithCause("RoleService", "GetRoleByID", "role not found", error2.ErrRoleNotFound)
+		}
+		return nil, error2.NewDomainErrorWithCause("RoleService", "GetRoleByID", "failed to get role", err)
+	}
+
+	return role, nil
+}
+
+func (r RolerService) CreateRole(ctx context.Context, role *entities.Role) error {
+	// 1. Validar el nombre del rol
+	role.Name = strings.ToUpper(strings.TrimSpace(role.Name))
+	if !roleNamePattern.MatchString(role.Name) {
+		return error2.NewDomainErrorWithCause("RoleService", "CreateRole", "invalid role name", error2.ErrInvalidRoleName)
+	}
+
+	// 2. Verificar que no exista otro rol con el mismo nombre
+	_, err := r.roleRepo.GetRoleByName(ctx, role.Name)
+	if err == nil {
+		return error2.NewDomainErrorWithCause("RoleService", "CreateRole", "role already exists", error2.ErrRoleAlreadyExists)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return error2.NewDomainErrorWithCause("RoleService", "CreateRole", "failed to check role name", err)
+	}
+
+	// 3. Crear el rol
+	if err = r.roleRepo.CreateRole(ctx, role); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "CreateRole", "failed to create role", err)
+	}
+
+	return nil
+}
+
+func (r RolerService) UpdateRole(ctx context.Context, role *entities.Role) error {
+	// 1. Obtener el rol actual
+	current, err := r.GetRoleByID(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Los roles del sistema no pueden desactivarse
+	if !role.IsActive && constants.ValidRoles[current.Name] {
+		return error2.NewDomainErrorWithCause("RoleService", "UpdateRole", "system role cannot be deactivated", error2.ErrSystemRoleProtected)
+	}
+
+	// 3. Actualizar solo los campos modificables, el nombre es inmutable porque lo referencian los tokens emitidos
+	current.Description = role.Description
+	current.IsActive = role.IsActive
+	current.UpdatedAt = time.Now()
+	current.Permissions = nil
+	if err = r.roleRepo.UpdateRole(ctx, current); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "UpdateRole", "failed to update role", err)
+	}
+
+	*role = *current
+	return nil
+}
+
+func (r RolerService) DeleteRole(ctx context.Context, id string) error {
+	// 1. Verificar que el rol exista y no sea del sistema
+	role, err := r.GetRoleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if constants.ValidRoles[role.Name] {
+		return error2.NewDomainErrorWithCause("RoleService", "DeleteRole", "system role cannot be deleted", error2.ErrSystemRoleProtected)
+	}
+
+	// 2. Eliminar el rol (soft delete)
+	if err = r.roleRepo.DeleteRole(ctx, id); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "DeleteRole", "failed to delete role", err)
+	}
+
+	return nil
+}
+
+func (r RolerService) GetPermissions(ctx context.Context) ([]entities.Permission, error) {
+	permissions, err := r.roleRepo.ListPermissions(ctx)
+	if err != nil {
+		return nil, error2.NewDomainErrorWithCause("RoleService", "GetPermissions", "failed to get permissions", err)
+	}
+
+	return permissions, nil
+}
+
+func (r RolerService) GetPermissionByID(ctx context.Context, id string) (*entities.Permission, error) {
+	permission, err := r.roleRepo.GetPermissionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, error2.NewDomainErrorWithCause("RoleService", "GetPermissionByID", "permission not found", error2.ErrPermissionNotFound)
+		}
+		return nil, error2.NewDomainErrorWithCause("RoleService", "GetPermissionByID", "failed to get permission", err)
+	}
+
+	return permission, nil
+}
+
+func (r RolerService) CreatePermission(ctx context.Context, permission *entities.Permission) error {
+	// 1. Validar recurso y acción
+	if err := r.validatePermission(ctx, permission, "CreatePermission"); err != nil {
+		return err
+	}
+
+	// 2. Crear el permiso
+	if err := r.roleRepo.CreatePermission(ctx, permission); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "CreatePermission", "failed to create permission", err)
+	}
+
+	return nil
+}
+
+func (r RolerService) UpdatePermission(ctx context.Context, permission *entities.Permission) error {
+	// 1. Obtener el permiso actual
+	current, err := r.GetPermissionByID(ctx, permission.ID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Validar recurso y acción
+	if err = r.validatePermission(ctx, permission, "UpdatePermission"); err != nil {
+		return err
+	}
+
+	// 3. Actualizar el permiso
+	current.Name = permission.Name
+	current.Description = permission.Description
+	current.Resource = permission.Resource
+	current.Action = permission.Action
+	current.UpdatedAt = time.Now()
+	if err = r.roleRepo.UpdatePermission(ctx, current); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "UpdatePermission", "failed to update permission", err)
+	}
+
+	*permission = *current
+	return nil
+}
+
+func (r RolerService) DeletePermission(ctx context.Context, id string) error {
+	// 1. Verificar que el permiso exista
+	if _, err := r.GetPermissionByID(ctx, id); err != nil {
+		return err
+	}
+
+	// 2. Eliminar el permiso y sus asignaciones
+	if err := r.roleRepo.DeletePermission(ctx, id); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "DeletePermission", "failed to delete permission", err)
+	}
+
+	return nil
+}
+
+func (r RolerService) GetRolePermissions(ctx context.Context, roleID string) ([]entities.Permission, error) {
+	// 1. Verificar que el rol exista
+	if _, err := r.GetRoleByID(ctx, roleID); err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener los permisos del rol
+	permissions, err := r.roleRepo.GetRolePermissions(ctx, roleID)
+	if err != nil {
+		return nil, error2.NewDomainErrorWithCause("RoleService", "GetRolePermissions", "failed to get role permissions", err)
+	}
+
+	return permissions, nil
+}
+
+func (r RolerService) GetPermissionRoles(ctx context.Context, permissionID string) ([]entities.Role, error) {
+	roles, err := r.roleRepo.GetPermissionRoles(ctx, permissionID)
+	if err != nil {
+		return nil, error2.NewDomainErrorWithCause("RoleService", "GetPermissionRoles", "failed to get permission roles", err)
+	}
+
+	return roles, nil
+}
+
+func (r RolerService) AssignPermissionsToRole(ctx context.Context, roleID string, permissionIDs []string) error {
+	if len(permissionIDs) == 0 {
+		return error2.NewDomainErrorWithCause("RoleService", "AssignPermissionsToRole", "permission IDs are required", error2.ErrPermissionIDsRequired)
+	}
+
+	// 1. Obtener los permisos que ya tiene el rol
+	current, err := r.GetRolePermissions(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	assigned := make(map[string]bool, len(current))
+	for _, permission := range current {
+		assigned[permission.ID] = true
+	}
+
+	// 2. Validar que todos los permisos existan y no estén asignados
+	for _, permissionID := range permissionIDs {
+		if _, err = r.GetPermissionByID(ctx, permissionID); err != nil {
+			return err
+		}
+		if assigned[permissionID] {
+			return error2.NewDomainErrorWithCause("RoleService", "AssignPermissionsToRole", "permission already assigned", error2.ErrPermissionAlreadyAssigned)
+		}
+		assigned[permissionID] = true
+	}
+
+	// 3. Asignar los permisos
+	for _, permissionID := range permissionIDs {
+		if err = r.roleRepo.AssignPermissionToRole(ctx, roleID, permissionID); err != nil {
+			return error2.NewDomainErrorWithCause("RoleService", "AssignPermissionsToRole", "failed to assign permission", err)
+		}
+	}
+
+	return nil
+}
+
+func (r RolerService) RemovePermissionFromRole(ctx context.Context, roleID, permissionID string) error {
+	// 1. Verificar que el permiso esté asignado al rol
+	current, err := r.GetRolePermissions(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, permission := range current {
+		if permission.ID == permissionID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return error2.NewDomainErrorWithCause("RoleService", "RemovePermissionFromRole", "permission not assigned", error2.ErrPermissionNotAssigned)
+	}
+
+	// 2. Remover el permiso
+	if err = r.roleRepo.RemovePermissionFromRole(ctx, roleID, permissionID); err != nil {
+		return error2.NewDomainErrorWithCause("RoleService", "RemovePermissionFromRole", "failed to remove permission", err)
+	}
+
+	return nil
+}
+
+// validatePermission normaliza y valida el recurso y la acción, verificando que no exista otro permiso igual
+func (r RolerService) validatePermission(ctx context.Context, permission *entities.Permission, operation string) error {
+	permission.Resource = strings.ToLower(strings.TrimSpace(permission.Resource))
+	permission.Action = strings.ToLower(strings.TrimSpace(permission.Action))
+	if !permissionPartPattern.MatchString(permission.Resource) || !permissionPartPattern.MatchString(permission.Action) {
+		return error2.NewDomainErrorWithCause("RoleService", operation, "invalid permission", error2.ErrInvalidPermissionData)
+	}
+	if strings.TrimSpace(permission.Name) == "" {
+		permission.Name = constants.PermissionKey(permission.Resource, permission.Action)
+	}
+
+	existing, err := r.roleRepo.GetPermissionByResourceAction(ctx, permission.Resource, permission.Action)
+	if err == nil && existing.ID != permission.ID {
+		return error2.NewDomainErrorWithCause("RoleService", operation, "permission already exists", error2.ErrPermissionAlreadyExists)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return error2.NewDomainErrorWithCause("RoleService", operation, "failed to check permission", err)
+	}
+
+	return nil
+}
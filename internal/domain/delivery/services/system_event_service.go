@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type SystemEventService struct {
+	repo ports.SystemEventRepository
+}
+
+func NewSystemEventService(repo ports.SystemEventRepository) interfaces.SystemEventer {
+	return &SystemEventService{
+		repo: repo,
+	}
+}
+
+// Record guarda el evento de forma síncrona para que quede registrado aunque el proceso termine,
+// los logs adjuntos se guardan después en segundo plano
+func (s *SystemEventService) Record(ctx context.Context, input *entities.SystemEventInput) string {
+	// 1. Validar el evento, sin tipo u origen no se puede buscar ni agregar
+	if input == nil || input.EventType == "" || input.Source == "" {
+		logs.Warn("Discarded system event without type or source", nil)
+		return ""
+	}
+
+	severity := input.Severity
+	if !constants.ValidSystemEventSeverities[severity] {
+		severity = constants.SystemEventSeverityInfo
+	}
+
+	sourceID := input.SourceID
+	if sourceID == "" {
+		sourceID = uuid.Nil.String()
+	}
+
+	data := input.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	eventData, err := json.Marshal(data)
+	if err != nil {
+		logs.Error("Failed to marshal system event data", map[string]interface{}{
+			"eventType": input.EventType,
+			"error":     err.Error(),
+		})
+		eventData = []byte("{}")
+	}
+
+	// 2. Guardar el evento
+	event := &entities.SystemEvent{
+		ID:         uuid.NewString(),
+		EventType:  input.EventType,
+		Source:     input.Source,
+		SourceID:   sourceID,
+		EventData:  string(eventData),
+		Severity:   severity,
+		OccurredAt: time.Now(),
+	}
+	if err = s.repo.Create(ctx, event); err != nil {
+		logs.Error("Failed to record system event", map[string]interface{}{
+			"eventType": input.EventType,
+			"source":    input.Source,
+			"error":     err.Error(),
+		})
+		return ""
+	}
+
+	// 3. Guardar los logs adjuntos sin bloquear al componente que emitió el evento
+	if len(input.Logs) > 0 {
+		go s.writeLogs(context.WithoutCancel(ctx), event.ID, input.Logs)
+	}
+
+	return event.ID
+}
+
+// writeLogs guarda los logs de un evento, un fallo solo se reporta en el log de la aplicación
+func (s *SystemEventService) writeLogs(ctx context.Context, eventID string, inputs []entities.EventLogInput) {
+	eventLogs := make([]entities.EventLog, 0, len(inputs))
+	for _, input := range inputs {
+		eventLog := entities.EventLog{
+			ID:          uuid.NewString(),
+			EventID:     eventID,
+			LogLevel:    input.LogLevel,
+			Description: input.Description,
+			CreatedAt:   time.Now(),
+		}
+		if eventLog.LogLevel == "" {
+			eventLog.LogLevel = constants.SystemEventSeverityInfo
+		}
+		if input.Metadata != nil {
+			if metadata, err := json.Marshal(input.Metadata); err == nil {
+				eventLog.Metadata = string(metadata)
+			}
+		}
+		if eventLog.Metadata == "" {
+			eventLog.Metadata = "{}"
+		}
+
+		eventLogs = append(eventLogs, eventLog)
+	}
+
+	if err := s.repo.CreateLogs(ctx, eventLogs); err != nil {
+		logs.Error("Failed to write system event logs", map[string]interface{}{
+			"eventID": eventID,
+			"logs":    len(eventLogs),
+			"error":   err.Error(),
+		})
+	}
+}
+
+func (s *SystemEventService) GetSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEvent, int64, error) {
+	events, total, err := s.repo.GetSystemEvents(ctx, params)
+	if err != nil {
+		return nil, 0, errPackage.NewDomainErrorWithCause("SystemEventService", "GetSystemEvents", "failed to get system events", err)
+	}
+
+	return events, total, nil
+}
+
+func (s *SystemEventService) AggregateSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEventSummary, error) {
+	summaries, err := s.repo.AggregateSystemEvents(ctx, params)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("SystemEventService", "AggregateSystemEvents", "failed to aggregate system events", err)
+	}
+
+	return summaries, nil
+}
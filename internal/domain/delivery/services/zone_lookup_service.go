@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/spatial"
+)
+
+// zoneArea es el polígono que atiende una zona dentro del índice
+type zoneArea struct {
+	zoneID   string
+	priority int
+	polygon  *value_objects.GeoPolygon
+}
+
+// preferredOver indica si el área gana sobre otra que también cubre el punto: primero la de mayor prioridad,
+// luego la más pequeña por ser la más específica y por último el ID para que el resultado sea estable
+func (a zoneArea) preferredOver(other *zoneArea) bool {
+	if a.priority != other.priority {
+		return a.priority > other.priority
+	}
+	if area, otherArea := a.polygon.Area(), other.polygon.Area(); area != otherArea {
+		return area < otherArea
+	}
+	return a.zoneID < other.zoneID
+}
+
+// ZoneLookupService ubica puntos en las zonas activas. Mantiene en memoria un árbol R con el rectángulo
+// envolvente de cada zona, solo los polígonos cuyo rectángulo contiene el punto se comprueban con ContainsPoint.
+// El índice se reconstruye cuando las zonas cambian y cada refreshInterval, así toma los cambios hechos
+// por otras instancias o directamente en la base de datos
+type ZoneLookupService struct {
+	repo            ports.ZoneRepository
+	refreshInterval time.Duration
+
+	mu         sync.RWMutex
+	index      *spatial.RTree[zoneArea]
+	builtAt    time.Time
+	generation uint64
+	builtFrom  uint64
+
+	buildMu sync.Mutex
+}
+
+func NewZoneLookupService(repo ports.ZoneRepository, refreshInterval time.Duration) interfaces.ZoneResolver {
+	return &ZoneLookupService{
+		repo:            repo,
+		refreshInterval: refreshInterval,
+	}
+}
+
+func (s *ZoneLookupService) ResolveZone(ctx context.Context, latitude, longitude float64) (string, error) {
+	// 1. Validar el punto
+	point := value_objects.NewGeoPoint(latitude, longitude)
+	if !point.IsValid() {
+		return "", errPackage.NewDomainErrorWithCause("ZoneLookupService", "ResolveZone", errPackage.ErrInvalidLocation.Error(), errPackage.ErrInvalidLocation)
+	}
+
+	// 2. Obtener el índice, reconstruyéndolo si está vencido
+	index, err := s.currentIndex(ctx)
+	if err != nil {
+		return "", errPackage.NewDomainErrorWithCause("ZoneLookupService", "ResolveZone", "failed to load zones", err)
+	}
+
+	// 3. Comprobar los polígonos candidatos, si varios cubren el punto gana la zona preferida
+	var best *zoneArea
+	for _, candidate := range index.Search(longitude, latitude) {
+		if !candidate.polygon.ContainsPoint(point) {
+			continue
+		}
+		if best == nil || candidate.preferredOver(best) {
+			area := candidate
+			best = &area
+		}
+	}
+
+	if best == nil {
+		return "", errPackage.NewDomainErrorWithCause("ZoneLookupService", "ResolveZone", errPackage.ErrAddressOutOfCoverage.Error(), errPackage.ErrAddressOutOfCoverage)
+	}
+
+	return best.zoneID, nil
+}
+
+func (s *ZoneLookupService) InvalidateZones() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+}
+
+// currentIndex retorna el índice vigente. Si la reconstrucción por vencimiento falla se sigue usando
+// el anterior, solo falla cuando no hay índice o fue invalidado por un cambio de zonas
+func (s *ZoneLookupService) currentIndex(ctx context.Context) (*spatial.RTree[zoneArea], error) {
+	s.mu.RLock()
+	index, fresh, invalidated := s.index, s.isFresh(), s.generation != s.builtFrom
+	s.mu.RUnlock()
+	if fresh {
+		return index, nil
+	}
+
+	// Una sola reconstrucción a la vez, las demás búsquedas esperan y usan su resultado
+	s.buildMu.Lock()
+	defer s.buildMu.Unlock()
+
+	s.mu.RLock()
+	index, fresh = s.index, s.isFresh()
+	generation := s.generation
+	s.mu.RUnlock()
+	if fresh {
+		return index, nil
+	}
+
+	rebuilt, err := s.build(ctx)
+	if err != nil {
+		if index != nil && !invalidated {
+			logs.Warn("Failed to refresh zone index, using the previous one", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return index, nil
+		}
+		return nil, err
+	}
+
+	// Un cambio de zonas durante la construcción deja el índice invalidado para la siguiente búsqueda
+	s.mu.Lock()
+	s.index = rebuilt
+	s.builtAt = time.Now()
+	s.builtFrom = generation
+	s.mu.Unlock()
+
+	return rebuilt, nil
+}
+
+// isFresh indica si el índice sigue vigente, se llama con el bloqueo tomado
+func (s *ZoneLookupService) isFresh() bool {
+	if s.index == nil || s.generation != s.builtFrom {
+		return false
+	}
+	return s.refreshInterval <= 0 || time.Since(s.builtAt) < s.refreshInterval
+}
+
+// build arma el índice con la cobertura de cada zona activa o, si no tiene, con sus límites
+func (s *ZoneLookupService) build(ctx context.Context) (*spatial.RTree[zoneArea], error) {
+	areas, err := s.repo.GetCoverageAreas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]spatial.Entry[zoneArea], 0, len(areas))
+	for _, area := range areas {
+		polygon, err := coveragePolygon(area)
+		if err != nil {
+			logs.Warn("Zone skipped from coverage index", map[string]interface{}{
+				"zoneID": area.ZoneID,
+				"error":  err.Error(),
+			})
+			continue
+		}
+
+		entries = append(entries, spatial.Entry[zoneArea]{
+			Bounds: polygonBounds(polygon),
+			Value: zoneArea{
+				zoneID:   area.ZoneID,
+				priority: area.PriorityLevel,
+				polygon:  polygon,
+			},
+		})
+	}
+
+	logs.Info("Zone coverage index built", map[string]interface{}{
+		"zones": len(entries),
+	})
+
+	return spatial.NewRTree(entries), nil
+}
+
+// coveragePolygon retorna el polígono que atiende la zona
+func coveragePolygon(area entities.ZoneCoverageArea) (*value_objects.GeoPolygon, error) {
+	if area.CoverageArea != "" {
+		return value_objects.NewGeoPolygonFromWKT(area.CoverageArea)
+	}
+	return value_objects.NewGeoPolygonFromWKT(area.Boundaries)
+}
+
+// polygonBounds calcula el rectángulo envolvente del polígono, con la longitud como eje X
+func polygonBounds(polygon *value_objects.GeoPolygon) spatial.Rect {
+	vertices := polygon.Vertices()
+	bounds := spatial.Rect{
+		MinX: vertices[0].Longitude(), MaxX: vertices[0].Longitude(),
+		MinY: vertices[0].Latitude(), MaxY: vertices[0].Latitude(),
+	}
+	for _, vertex := range vertices[1:] {
+		bounds.MinX = min(bounds.MinX, vertex.Longitude())
+		bounds.MaxX = max(bounds.MaxX, vertex.Longitude())
+		bounds.MinY = min(bounds.MinY, vertex.Latitude())
+		bounds.MaxY = max(bounds.MaxY, vertex.Latitude())
+	}
+	return bounds
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// ZoneService gestiona las zonas de cobertura. Los límites se reciben en GeoJSON o WKT, se validan
+// y se guardan en WKT junto con el centroide del polígono. Cada cambio invalida el índice de cobertura
+type ZoneService struct {
+	repo     ports.ZoneRepository
+	resolver interfaces.ZoneResolver
+}
+
+func NewZoneService(repo ports.ZoneRepository, resolver interfaces.ZoneResolver) interfaces.ZoneManager {
+	return &ZoneService{
+		repo:     repo,
+		resolver: resolver,
+	}
+}
+
+func (s *ZoneService) CreateZone(ctx context.Context, input *entities.ZoneInput) (*entities.Zone, error) {
+	// 1. Validar los datos y el polígono de la zona
+	polygon, err := validateZoneInput(input, "CreateZone")
+	if err != nil {
+		return nil, err
+	}
+	code := strings.ToUpper(strings.TrimSpace(input.Code))
+	if err = s.checkCode(ctx, code, "", "CreateZone"); err != nil {
+		return nil, err
+	}
+
+	// 2. Guardar la zona con el centroide del polígono
+	now := time.Now()
+	zone := &entities.Zone{
+		ID:              uuid.NewString(),
+		Name:            strings.TrimSpace(input.Name),
+		Code:            code,
+		Boundaries:      polygon.ToWKT(),
+		CenterPoint:     polygon.Centroid().ToWKT(),
+		BaseRate:        input.BaseRate,
+		MaxDeliveryTime: input.MaxDeliveryTime,
+		PriorityLevel:   zonePriority(input.PriorityLevel),
+		IsActive:        input.IsActive == nil || *input.IsActive,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err = s.repo.Create(ctx, zone); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("ZoneService", "CreateZone", "failed to create zone", err)
+	}
+	s.resolver.InvalidateZones()
+
+	return zone, nil
+}
+
+func (s *ZoneService) UpdateZone(ctx context.Context, zoneID string, input *entities.ZoneInput) (*entities.Zone, error) {
+	// 1. Obtener la zona
+	zone, err := s.GetZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validar los datos nuevos, el código debe seguir siendo único
+	polygon, err := validateZoneInput(input, "UpdateZone")
+	if err != nil {
+		return nil, err
+	}
+	code := strings.ToUpper(strings.TrimSpace(input.Code))
+	if err = s.checkCode(ctx, code, zone.ID, "UpdateZone"); err != nil {
+		return nil, err
+	}
+
+	// 3. Reemplazar los datos y recalcular el centro
+	zone.Name = strings.TrimSpace(input.Name)
+	zone.Code = code
+	zone.Boundaries = polygon.ToWKT()
+	zone.CenterPoint = polygon.Centroid().ToWKT()
+	zone.BaseRate = input.BaseRate
+	zone.MaxDeliveryTime = input.MaxDeliveryTime
+	zone.PriorityLevel = zonePriority(input.PriorityLevel)
+	if input.IsActive != nil {
+		zone.IsActive = *input.IsActive
+	}
+	zone.UpdatedAt = time.Now()
+
+	if err = s.repo.Update(ctx, zone); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("ZoneService", "UpdateZone", "failed to update zone", err)
+	}
+	s.resolver.InvalidateZones()
+
+	return zone, nil
+}
+
+func (s *ZoneService) DeleteZone(ctx context.Context, zoneID string) error {
+	if _, err := s.GetZone(ctx, zoneID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Deactivate(ctx, zoneID); err != nil {
+		return errPackage.NewDomainErrorWithCause("ZoneService", "DeleteZone", "failed to deactivate zone", err)
+	}
+	s.resolver.InvalidateZones()
+
+	return nil
+}
+
+func (s *ZoneService) GetZone(ctx context.Context, zoneID string) (*entities.Zone, error) {
+	zone, err := s.repo.GetByID(ctx, zoneID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("ZoneService", "GetZone", errPackage.ErrZoneNotFound.Error(), errPackage.ErrZoneNotFound)
+		}
+		return nil, errPackage.NewDomainErrorWithCause("ZoneService", "GetZone", "failed to get zone", err)
+	}
+
+	return zone, nil
+}
+
+func (s *ZoneService) GetZones(ctx context.Context, includeInactive bool) ([]entities.Zone, error) {
+	zones, err := s.repo.GetZones(ctx, includeInactive)
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("ZoneService", "GetZones", "failed to get zones", err)
+	}
+
+	return zones, nil
+}
+
+// checkCode rechaza el código si otra zona ya lo usa
+func (s *ZoneService) checkCode(ctx context.Context, code, excludeID, operation string) error {
+	exists, err := s.repo.ExistsCode(ctx, code, excludeID)
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("ZoneService", operation, "failed to check zone code", err)
+	}
+	if exists {
+		return errPackage.NewDomainErrorWithCause("ZoneService", operation, errPackage.ErrZoneCodeAlreadyExists.Error(), errPackage.ErrZoneCodeAlreadyExists)
+	}
+
+	return nil
+}
+
+// validateZoneInput valida los datos de la zona y convierte sus límites en un polígono
+func validateZoneInput(input *entities.ZoneInput, operation string) (*value_objects.GeoPolygon, error) {
+	if strings.TrimSpace(input.Name) == "" || strings.TrimSpace(input.Code) == "" ||
+		input.BaseRate < 0 || input.MaxDeliveryTime <= 0 || input.PriorityLevel < 0 {
+		return nil, errPackage.NewDomainErrorWithCause("ZoneService", operation, errPackage.ErrInvalidZone.Error(), errPackage.ErrInvalidZone)
+	}
+
+	polygon, err := parseZoneBoundaries(input.Boundaries)
+	if err == nil {
+		err = polygon.Validate()
+	}
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("ZoneService", operation, errPackage.ErrInvalidZoneBoundaries.Error()+": "+err.Error(), errPackage.ErrInvalidZoneBoundaries)
+	}
+
+	return polygon, nil
+}
+
+// parseZoneBoundaries interpreta los límites como GeoJSON si son un objeto y como WKT en otro caso
+func parseZoneBoundaries(boundaries string) (*value_objects.GeoPolygon, error) {
+	boundaries = strings.TrimSpace(boundaries)
+	if strings.HasPrefix(boundaries, "{") {
+		return value_objects.NewGeoPolygonFromGeoJSON(boundaries)
+	}
+
+	return value_objects.NewGeoPolygonFromWKT(strings.ToUpper(boundaries))
+}
+
+// zonePriority usa la prioridad por defecto de la tabla cuando no se indica
+func zonePriority(priority int) int {
+	if priority == 0 {
+		return 1
+	}
+	return priority
+}
@@ -137,6 +137,70 @@ func (p *GeoPolygon) IsValid() bool {
 	return true
 }
 
+// IsClosed indica si el último vértice coincide con el primero
+func (p *GeoPolygon) IsClosed() bool {
+	if len(p.vertices) < 2 {
+		return false
+	}
+	return p.vertices[0].Equals(p.vertices[len(p.vertices)-1])
+}
+
+// IsSimple indica si los lados del polígono no se cruzan entre sí. Los lados consecutivos comparten
+// un vértice, por eso solo se comparan los que no son vecinos
+func (p *GeoPolygon) IsSimple() bool {
+	vertices := p.vertices
+	if p.IsClosed() {
+		vertices = vertices[:len(vertices)-1]
+	}
+
+	edges := len(vertices)
+	for i := 0; i < edges; i++ {
+		a1, a2 := vertices[i], vertices[(i+1)%edges]
+		for j := i + 2; j < edges; j++ {
+			if i == 0 && j == edges-1 {
+				continue
+			}
+			if segmentsIntersect(a1, a2, vertices[j], vertices[(j+1)%edges]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Validate verifica que el polígono delimite un área: coordenadas válidas, al menos 3 vértices distintos,
+// cerrado y sin lados que se crucen
+func (p *GeoPolygon) Validate() error {
+	if !p.IsClosed() {
+		return fmt.Errorf("polygon is not closed, the last vertex must repeat the first one")
+	}
+
+	distinct := p.vertices[:len(p.vertices)-1]
+	if len(distinct) < 3 {
+		return fmt.Errorf("polygon must have at least 3 vertices")
+	}
+	for i, vertex := range distinct {
+		if !vertex.IsValid() {
+			return fmt.Errorf("invalid coordinate at index %d", i)
+		}
+		for j := 0; j < i; j++ {
+			if vertex.Equals(distinct[j]) {
+				return fmt.Errorf("repeated vertex at index %d", i)
+			}
+		}
+	}
+
+	if !p.IsSimple() {
+		return fmt.Errorf("polygon edges intersect each other")
+	}
+	if p.Area() < 1 {
+		return fmt.Errorf("polygon has no area")
+	}
+
+	return nil
+}
+
 // ToString devuelve el polígono en formato simple lat,lng;lat,lng;...
 func (p *GeoPolygon) ToString() string {
 	points := make([]string, len(p.vertices))
@@ -203,7 +267,7 @@ func (p *GeoPolygon) ContainsPoint(point *GeoPoint) bool {
 	return inside
 }
 
-// Centroid calcula el punto central (centroide) del polígono
+// Centroid calcula el punto central (centroide) del polígono, sin importar el sentido de los vértices
 func (p *GeoPolygon) Centroid() *GeoPoint {
 	var area float64
 	var centerX, centerY float64
@@ -218,8 +282,8 @@ func (p *GeoPolygon) Centroid() *GeoPoint {
 		area += f
 	}
 
+	// El área conserva su signo, las sumas cambian de signo con el sentido de los vértices
 	area /= 2
-	area = math.Abs(area)
 
 	centerX /= (6 * area)
 	centerY /= (6 * area)
@@ -263,3 +327,41 @@ func (p *GeoPolygon) Perimeter() float64 {
 	}
 	return perimeter
 }
+
+// segmentsIntersect indica si el segmento a1-a2 toca al segmento b1-b2, usando la longitud como eje X
+func segmentsIntersect(a1, a2, b1, b2 *GeoPoint) bool {
+	o1 := orientation(a1, a2, b1)
+	o2 := orientation(a1, a2, b2)
+	o3 := orientation(b1, b2, a1)
+	o4 := orientation(b1, b2, a2)
+
+	if o1 != o2 && o3 != o4 && o1 != 0 && o2 != 0 && o3 != 0 && o4 != 0 {
+		return true
+	}
+
+	// Los puntos colineales solo cuentan si caen sobre el otro segmento
+	return (o1 == 0 && onSegment(a1, a2, b1)) ||
+		(o2 == 0 && onSegment(a1, a2, b2)) ||
+		(o3 == 0 && onSegment(b1, b2, a1)) ||
+		(o4 == 0 && onSegment(b1, b2, a2))
+}
+
+// orientation retorna 1 si c está a la izquierda de a-b, -1 si está a la derecha y 0 si es colineal
+func orientation(a, b, c *GeoPoint) int {
+	const epsilon = 1e-12
+	cross := (b.longitude-a.longitude)*(c.latitude-a.latitude) - (b.latitude-a.latitude)*(c.longitude-a.longitude)
+	switch {
+	case cross > epsilon:
+		return 1
+	case cross < -epsilon:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// onSegment indica si c, colineal con a-b, está dentro del rectángulo que forman a y b
+func onSegment(a, b, c *GeoPoint) bool {
+	return c.longitude >= math.Min(a.longitude, b.longitude) && c.longitude <= math.Max(a.longitude, b.longitude) &&
+		c.latitude >= math.Min(a.latitude, b.latitude) && c.latitude <= math.Max(a.latitude, b.latitude)
+}
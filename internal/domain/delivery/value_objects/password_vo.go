@@ -22,7 +22,18 @@ func (p *Password) ToString() string {
 	return p.value
 }
 
+// IsValid exige al menos 8 caracteres con minúscula, mayúscula, dígito y un símbolo de @$!%*?&.
+// RE2 no soporta lookaheads, por lo que cada requisito se valida con su propia expresión
 func (p *Password) IsValid() bool {
-	regex := `^(?=.*[a-z])(?=.*[A-Z])(?=.*\d)(?=.*[@$!%*?&])[A-Za-z\d@$!%*?&]{8,}$`
-	return regexp.MustCompile(regex).MatchString(p.value)
+	if !regexp.MustCompile(`^[A-Za-z\d@$!%*?&]{8,}$`).MatchString(p.value) {
+		return false
+	}
+
+	for _, required := range []string{`[a-z]`, `[A-Z]`, `\d`, `[@$!%*?&]`} {
+		if !regexp.MustCompile(required).MatchString(p.value) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -41,6 +41,11 @@ func (e *DomainError) Error() string {
 	return e.Message + " | cause: " + e.Err.Error()
 }
 
+// Unwrap expone la causa para que pueda evaluarse con errors.Is y errors.As
+func (e *DomainError) Unwrap() error {
+	return e.Err
+}
+
 func (e *DomainError) IsNotFoundError() bool {
 	return strings.Contains(e.Error(), "not found")
 }
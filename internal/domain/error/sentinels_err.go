@@ -16,6 +16,9 @@ var (
 	ErrUnexpectedSigningMethod = errors.New("unexpected signing method")
 	ErrTokenExpired            = errors.New("token has expired")
 	ErrInvalidToken            = errors.New("token is invalid")
+	ErrUnknownSigningKey       = errors.New("token was signed with an unknown key")
+	ErrInvalidSigningKey       = errors.New("signing key is invalid or unsupported, use RSA of at least 2048 bits or ECDSA P-256")
+	ErrNoSigningKey            = errors.New("no signing key configured, set JWT_SECRET or JWT_SIGNING_KEYS")
 
 	ErrUserDeactivated                      = errors.New("user is deactivated")
 	ErrUserCannotActivateOrDeactivateItself = errors.New("user cannot activate or deactivate itself")
@@ -27,6 +30,17 @@ var (
 	ErrUserAlreadyHasRole  = errors.New("user already has the role")
 	ErrUserDoesNotHaveRole = errors.New("user does not have the role")
 
+	ErrRoleNotFound              = errors.New("role not found")
+	ErrRoleAlreadyExists         = errors.New("role name already exists")
+	ErrInvalidRoleName           = errors.New("invalid role name, use uppercase letters, numbers and underscores (e.g. DISPATCHER)")
+	ErrSystemRoleProtected       = errors.New("system roles cannot be deactivated or deleted")
+	ErrPermissionNotFound        = errors.New("permission not found")
+	ErrPermissionAlreadyExists   = errors.New("a permission with the same resource and action already exists")
+	ErrInvalidPermissionData     = errors.New("invalid permission, resource and action must be lowercase words (e.g. orders:create)")
+	ErrPermissionAlreadyAssigned = errors.New("permission is already assigned to the role")
+	ErrPermissionNotAssigned     = errors.New("permission is not assigned to the role")
+	ErrPermissionIDsRequired     = errors.New("at least one permission ID is required")
+
 	ErrInvalidEmail               = errors.New("invalid email format")
 	ErrInvalidPassword            = errors.New("invalid password format, minimum 8 characters, at least one uppercase letter, one lowercase letter, one number and one special character")
 	ErrValidationErrorsFound      = errors.New("validation errors has been found")
@@ -60,4 +74,46 @@ var (
 	ErrZoneInactive          = errors.New("zone is inactive")
 	ErrTooManyBranchesInZone = errors.New("company already has maximum number of branches in this zone")
 	ErrAddressNotFound       = errors.New("address not found")
+
+	ErrInvalidPickupLocation   = errors.New("pickup address has no valid coordinates")
+	ErrInvalidDeliveryLocation = errors.New("delivery address has no valid coordinates")
+	ErrInvalidDeliveryRate     = errors.New("company delivery rate must not be negative")
+
+	ErrDriverNotFound         = errors.New("driver not found")
+	ErrDriverAlreadyExists    = errors.New("user already has a driver profile")
+	ErrDriverInactive         = errors.New("driver is inactive")
+	ErrUserIsNotDriver        = errors.New("user does not have the DRIVER role")
+	ErrInvalidDriverData      = errors.New("invalid driver data, license and vehicle information are required")
+	ErrInvalidVehicleType     = errors.New("invalid vehicle type")
+	ErrLicenseExpired         = errors.New("driver license is expired")
+	ErrDuplicateLicenseNumber = errors.New("license number already exists")
+	ErrDuplicateVehiclePlate  = errors.New("vehicle plate already exists")
+	ErrPrimaryZoneRequired    = errors.New("primary zone is required")
+	ErrDuplicateDriverZone    = errors.New("a zone cannot be assigned more than once to a driver")
+
+	ErrNoDriversAvailable      = errors.New("no drivers available for the order zone")
+	ErrOrderNotDispatchable    = errors.New("order status does not allow driver assignment")
+	ErrOrderAlreadyAssigned    = errors.New("order already has an assigned driver")
+	ErrInvalidDispatchStrategy = errors.New("invalid dispatch strategy")
+
+	ErrOrderHasNoDriver = errors.New("order has no assigned driver")
+	ErrInvalidLocation  = errors.New("invalid location coordinates")
+
+	ErrOrderNotFound     = errors.New("order not found")
+	ErrOrderAccessDenied = errors.New("user is not allowed to access this order")
+
+	ErrNotificationTemplateNotFound = errors.New("no active notification template for the notification type")
+	ErrInvalidNotificationTemplate  = errors.New("notification template could not be rendered")
+	ErrNotificationNotFound         = errors.New("notification not found")
+	ErrInvalidNotificationType      = errors.New("notification type must be ORDER_CREATED, ORDER_STATUS_CHANGED, ORDER_DRIVER_ASSIGNED, ORDER_ASSIGNED_TO_DRIVER, ORDER_DELIVERED or ORDER_DELETED, and each type may appear only once")
+
+	ErrNotificationDeviceNotFound = errors.New("notification device not found")
+	ErrInvalidDeviceType          = errors.New("device type must be ANDROID, IOS or WEB")
+	ErrDeviceTokenRequired        = errors.New("device token is required")
+	ErrInvalidDeviceToken         = errors.New("device token is invalid or no longer registered")
+
+	ErrInvalidZone           = errors.New("zone name and code are required, base rate cannot be negative and max delivery time and priority level must be positive")
+	ErrInvalidZoneBoundaries = errors.New("zone boundaries must be a closed GeoJSON or WKT polygon without self-intersections")
+	ErrZoneCodeAlreadyExists = errors.New("zone code already exists")
+	ErrAddressOutOfCoverage  = errors.New("delivery address is outside the coverage area of every active zone")
 )
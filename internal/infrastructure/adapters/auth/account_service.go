@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	PasswordResetPurpose     = "password_reset"
+	EmailVerificationPurpose = "email_verification"
+
+	passwordResetTTL     = 30 * time.Minute
+	emailVerificationTTL = 24 * time.Hour
+)
+
+type accountService struct {
+	userRepo      domainPorts.UserRepository
+	authenticator ports.Authenticator
+	tokens        ports.OneTimeTokenProvider
+	mailSender    ports.MailSender
+	linkBaseURL   string
+}
+
+func NewAccountService(
+	userRepo domainPorts.UserRepository,
+	authenticator ports.Authenticator,
+	tokens ports.OneTimeTokenProvider,
+	mailSender ports.MailSender,
+	linkBaseURL string,
+) ports.AccountRecoverer {
+	return &accountService{
+		userRepo:      userRepo,
+		authenticator: authenticator,
+		tokens:        tokens,
+		mailSender:    mailSender,
+		linkBaseURL:   strings.TrimRight(linkBaseURL, "/"),
+	}
+}
+
+// RequestPasswordReset envía un enlace de recuperación. Si el correo no existe no se informa
+// al cliente para no permitir enumerar usuarios.
+func (s *accountService) RequestPasswordReset(ctx context.Context, email string) error {
+	// 1. Buscar el usuario activo
+	user, ok, err := s.findActiveUser(ctx, email, "RequestPasswordReset")
+	if err != nil || !ok {
+		return err
+	}
+
+	// 2. Emitir el token
+	token, err := s.tokens.Issue(ctx, PasswordResetPurpose, user.ID, passwordResetTTL)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("AccountService", "RequestPasswordReset", err)
+	}
+
+	// 3. Enviar el correo
+	return s.send(ctx, "RequestPasswordReset", &ports.MailMessage{
+		To:      user.Email,
+		Subject: "Recuperación de contraseña",
+		Body: fmt.Sprintf(
+			"Hola %s,\n\nRecibimos una solicitud para restablecer tu contraseña. Usa el siguiente enlace antes de %d minutos:\n\n%s\n\nSi no solicitaste el cambio puedes ignorar este correo.",
+			user.FullName, int(passwordResetTTL.Minutes()), s.link("/reset-password", token),
+		),
+	})
+}
+
+// ResetPassword consume el token y actualiza la contraseña, cerrando todas las sesiones del usuario
+func (s *accountService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	// 1. Validar la nueva contraseña antes de consumir el token
+	if !value_objects.NewPassword(newPassword).IsValid() {
+		return errPackage.NewGeneralServiceError("AccountService", "ResetPassword", domainErr.ErrInvalidPassword)
+	}
+
+	// 2. Consumir el token
+	userID, err := s.tokens.Consume(ctx, PasswordResetPurpose, token)
+	if err != nil {
+		return err
+	}
+
+	// 3. Actualizar la contraseña
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("AccountService", "ResetPassword", err)
+	}
+	if err = s.userRepo.Update(ctx, userID, &entities.User{PasswordHash: string(hash), UpdatedAt: time.Now()}); err != nil {
+		logs.Error("Failed to update password", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("AccountService", "ResetPassword", err)
+	}
+
+	// 4. Revocar las sesiones abiertas con la contraseña anterior
+	sessions, err := s.authenticator.GetActiveSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err = s.authenticator.RevokeSession(ctx, userID, session.ID); err != nil {
+			logs.Warn("Failed to revoke session after password reset", map[string]interface{}{
+				"user_id":    userID,
+				"session_id": session.ID,
+			})
+		}
+	}
+
+	logs.Info("Password reset successfully", map[string]interface{}{
+		"user_id": userID,
+	})
+	return nil
+}
+
+// RequestEmailVerification envía un enlace de verificación si el correo aún no está verificado
+func (s *accountService) RequestEmailVerification(ctx context.Context, email string) error {
+	// 1. Buscar el usuario activo
+	user, ok, err := s.findActiveUser(ctx, email, "RequestEmailVerification")
+	if err != nil || !ok || user.EmailVerifiedAt != nil {
+		return err
+	}
+
+	// 2. Emitir el token
+	token, err := s.tokens.Issue(ctx, EmailVerificationPurpose, user.ID, emailVerificationTTL)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("AccountService", "RequestEmailVerification", err)
+	}
+
+	// 3. Enviar el correo
+	return s.send(ctx, "RequestEmailVerification", &ports.MailMessage{
+		To:      user.Email,
+		Subject: "Verifica tu correo electrónico",
+		Body: fmt.Sprintf(
+			"Hola %s,\n\nConfirma tu correo electrónico con el siguiente enlace, válido por %d horas:\n\n%s",
+			user.FullName, int(emailVerificationTTL.Hours()), s.link("/verify-email", token),
+		),
+	})
+}
+
+// VerifyEmail consume el token y marca el correo del usuario como verificado
+func (s *accountService) VerifyEmail(ctx context.Context, token string) error {
+	// 1. Consumir el token
+	userID, err := s.tokens.Consume(ctx, EmailVerificationPurpose, token)
+	if err != nil {
+		return err
+	}
+
+	// 2. Marcar el correo como verificado
+	now := time.Now()
+	if err = s.userRepo.Update(ctx, userID, &entities.User{EmailVerifiedAt: &now, UpdatedAt: now}); err != nil {
+		logs.Error("Failed to verify email", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("AccountService", "VerifyEmail", err)
+	}
+
+	logs.Info("Email verified successfully", map[string]interface{}{
+		"user_id": userID,
+	})
+	return nil
+}
+
+// findActiveUser busca un usuario activo por correo, ok es falso si no existe o está inactivo
+func (s *accountService) findActiveUser(ctx context.Context, email, operation string) (*entities.User, bool, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, false, errPackage.NewGeneralServiceError("AccountService", operation, errPackage.ErrEmailRequired)
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error("Failed to get user by email", map[string]interface{}{
+			"operation": operation,
+			"error":     err.Error(),
+		})
+		return nil, false, errPackage.NewGeneralServiceError("AccountService", operation, err)
+	}
+	if err != nil || !user.IsActive || user.DeletedAt != nil {
+		logs.Info("Account request ignored for unknown or inactive email", map[string]interface{}{
+			"operation": operation,
+		})
+		return nil, false, nil
+	}
+
+	return user, true, nil
+}
+
+func (s *accountService) send(ctx context.Context, operation string, message *ports.MailMessage) error {
+	if err := s.mailSender.Send(ctx, message); err != nil {
+		return errPackage.NewGeneralServiceError("AccountService", operation, err)
+	}
+	return nil
+}
+
+func (s *accountService) link(path, token string) string {
+	return s.linkBaseURL + path + "?token=" + url.QueryEscape(token)
+}
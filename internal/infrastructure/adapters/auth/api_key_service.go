@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	// APIKeyPrefix identifica las keys emitidas por el sistema
+	APIKeyPrefix = "dlv_"
+
+	apiKeySecretBytes = 32
+	apiKeyIDBytes     = 4
+
+	// apiKeyTouchInterval evita escribir en base de datos en cada petición autenticada
+	apiKeyTouchInterval = time.Minute
+)
+
+type apiKeyService struct {
+	apiKeyRepo  domainPorts.APIKeyRepository
+	companyRepo domainPorts.CompanyRepository
+}
+
+func NewAPIKeyService(apiKeyRepo domainPorts.APIKeyRepository, companyRepo domainPorts.CompanyRepository) ports.APIKeyManager {
+	return &apiKeyService{
+		apiKeyRepo:  apiKeyRepo,
+		companyRepo: companyRepo,
+	}
+}
+
+// Create valida los datos, genera la key y guarda únicamente su hash
+func (s *apiKeyService) Create(ctx context.Context, input *auth.APIKeyInput) (*auth.IssuedAPIKey, error) {
+	// 1. Validar nombre y scopes
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Create", errPackage.ErrAPIKeyNameRequired)
+	}
+
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Create", err)
+	}
+
+	// 2. Validar que la sucursal pertenezca a la empresa y esté activa
+	branch, err := s.companyRepo.GetBranchByID(ctx, input.BranchID)
+	if err != nil || branch.CompanyID != input.CompanyID || !branch.IsActive {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Create", errPackage.ErrAPIKeyBranchInvalid)
+	}
+
+	// 3. Generar la key
+	plainKey, prefix, keyHash, err := generateAPIKey()
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Create", err)
+	}
+
+	key := &entities.CompanyAPIKey{
+		ID:        uuid.NewString(),
+		CompanyID: input.CompanyID,
+		BranchID:  input.BranchID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   keyHash,
+		Scopes:    strings.Join(scopes, ","),
+		CreatedBy: input.CreatedBy,
+		ExpiresAt: input.ExpiresAt,
+	}
+
+	// 4. Guardar la key
+	if err := s.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Create", err)
+	}
+
+	logs.Info("API key created", map[string]interface{}{
+		"api_key_id": key.ID,
+		"company_id": key.CompanyID,
+		"scopes":     key.Scopes,
+	})
+
+	return &auth.IssuedAPIKey{Key: key, PlainKey: plainKey}, nil
+}
+
+func (s *apiKeyService) List(ctx context.Context, companyID string) ([]entities.CompanyAPIKey, error) {
+	keys, err := s.apiKeyRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "List", err)
+	}
+
+	return keys, nil
+}
+
+func (s *apiKeyService) GetByID(ctx context.Context, keyID string) (*entities.CompanyAPIKey, error) {
+	key, err := s.apiKeyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewGeneralServiceError("APIKeyService", "GetByID", errPackage.ErrAPIKeyNotFound)
+		}
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "GetByID", err)
+	}
+
+	return key, nil
+}
+
+// Rotate genera un nuevo secreto para la key, el anterior deja de ser válido de inmediato
+func (s *apiKeyService) Rotate(ctx context.Context, keyID string) (*auth.IssuedAPIKey, error) {
+	// 1. Generar el nuevo secreto
+	plainKey, prefix, keyHash, err := generateAPIKey()
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Rotate", err)
+	}
+
+	// 2. Reemplazarlo solo si la key sigue activa
+	if err := s.apiKeyRepo.Rotate(ctx, keyID, prefix, keyHash); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewGeneralServiceError("APIKeyService", "Rotate", errPackage.ErrAPIKeyNotFound)
+		}
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Rotate", err)
+	}
+
+	// 3. Obtener la key actualizada
+	key, err := s.GetByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Info("API key rotated", map[string]interface{}{
+		"api_key_id": key.ID,
+		"company_id": key.CompanyID,
+	})
+
+	return &auth.IssuedAPIKey{Key: key, PlainKey: plainKey}, nil
+}
+
+func (s *apiKeyService) Revoke(ctx context.Context, keyID string) error {
+	if err := s.apiKeyRepo.Revoke(ctx, keyID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errPackage.NewGeneralServiceError("APIKeyService", "Revoke", errPackage.ErrAPIKeyNotFound)
+		}
+		return errPackage.NewGeneralServiceError("APIKeyService", "Revoke", err)
+	}
+
+	logs.Info("API key revoked", map[string]interface{}{
+		"api_key_id": keyID,
+	})
+
+	return nil
+}
+
+// Authenticate valida la key recibida y construye los claims de la identidad de integración
+func (s *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*auth.AuthClaims, error) {
+	// 1. Descartar valores que no tienen el formato de una key
+	if !strings.HasPrefix(rawKey, APIKeyPrefix) {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Authenticate", errPackage.ErrInvalidAPIKey)
+	}
+
+	// 2. Buscar la key por su hash
+	key, err := s.apiKeyRepo.GetByHash(ctx, hashAPIKey(rawKey))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewGeneralServiceError("APIKeyService", "Authenticate", errPackage.ErrInvalidAPIKey)
+		}
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Authenticate", err)
+	}
+
+	// 3. Validar que la key y la empresa sigan activas
+	now := time.Now()
+	if !key.IsUsable(now) {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Authenticate", errPackage.ErrInvalidAPIKey)
+	}
+
+	company, err := s.companyRepo.GetCompanyByID(ctx, key.CompanyID)
+	if err != nil || !company.IsActive {
+		return nil, errPackage.NewGeneralServiceError("APIKeyService", "Authenticate", errPackage.ErrInvalidAPIKey)
+	}
+
+	// 4. Registrar el uso, un fallo aquí no debe impedir la petición
+	if err := s.apiKeyRepo.TouchLastUsed(ctx, key.ID, now, apiKeyTouchInterval); err != nil {
+		logs.Warn("Failed to update API key last use", map[string]interface{}{
+			"api_key_id": key.ID,
+			"error":      err.Error(),
+		})
+	}
+
+	claims := &auth.AuthClaims{
+		UserID:    key.ID,
+		CompanyID: key.CompanyID,
+		Role:      constants.IntegrationRole,
+		IssuedAt:  key.CreatedAt,
+		APIKeyID:  key.ID,
+		BranchID:  key.BranchID,
+		Scopes:    key.ScopeList(),
+	}
+	if key.ExpiresAt != nil {
+		claims.ExpiresAt = *key.ExpiresAt
+	}
+
+	return claims, nil
+}
+
+// normalizeScopes elimina duplicados y verifica que todos los scopes se puedan conceder a una key
+func normalizeScopes(scopes []string) ([]string, error) {
+	unique := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !constants.APIKeyScopes[scope] {
+			return nil, errPackage.ErrInvalidAPIKeyScopes
+		}
+		unique[scope] = true
+	}
+
+	if len(unique) == 0 {
+		return nil, errPackage.ErrInvalidAPIKeyScopes
+	}
+
+	result := make([]string, 0, len(unique))
+	for scope := range unique {
+		result = append(result, scope)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// generateAPIKey genera una key con el formato dlv_<id>_<secreto>, el prefijo dlv_<id> se guarda para mostrarlo
+func generateAPIKey() (plainKey, prefix, keyHash string, err error) {
+	idBytes := make([]byte, apiKeyIDBytes)
+	if _, err = rand.Read(idBytes); err != nil {
+		return "", "", "", err
+	}
+
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err = rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+
+	prefix = APIKeyPrefix + hex.EncodeToString(idBytes)
+	plainKey = prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	return plainKey, prefix, hashAPIKey(plainKey), nil
+}
+
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
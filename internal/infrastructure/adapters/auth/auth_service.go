@@ -2,6 +2,10 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"github.com/google/uuid"
@@ -17,74 +21,305 @@ import (
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
+const (
+	// defaultRefreshTokenTTL se usa cuando no se configura REFRESH_TOKEN_TTL_HOURS
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	// sessionActivityInterval evita escribir la última actividad de la sesión en cada petición
+	sessionActivityInterval = time.Minute
+)
+
 type authService struct {
-	userRepo     domainPorts.UserRepository
-	tokenService ports.TokenProvider
+	userRepo        domainPorts.UserRepository
+	tokenService    ports.TokenProvider
+	refreshTokenTTL time.Duration
 }
 
-func NewAuthService(userRepo domainPorts.UserRepository, tokenService ports.TokenProvider) ports.Authenticator {
+func NewAuthService(userRepo domainPorts.UserRepository, tokenService ports.TokenProvider, refreshTokenTTL time.Duration) ports.Authenticator {
+	if refreshTokenTTL <= 0 {
+		refreshTokenTTL = defaultRefreshTokenTTL
+	}
+
 	return &authService{
-		userRepo:     userRepo,
-		tokenService: tokenService,
+		userRepo:        userRepo,
+		tokenService:    tokenService,
+		refreshTokenTTL: refreshTokenTTL,
 	}
 }
 
-func (s *authService) CreateSession(ctx context.Context, authUser *entities.User, deviceInfo map[string]interface{}, ipAddress string) (string, error) {
+func (s *authService) CreateSession(ctx context.Context, authUser *entities.User, deviceInfo map[string]interface{}, ipAddress string) (*auth.TokenPair, error) {
+	sessionID := uuid.NewString()
 
-	// 1. Obtener el rol principal del usuario
-	roles, err := s.userRepo.GetUserRoles(ctx, authUser.ID)
+	// 1. Construir los claims con el rol principal del usuario
+	claims, err := s.buildClaims(ctx, authUser.ID, authUser.CompanyID, sessionID)
 	if err != nil {
-		logs.Error("Failed to get users roles", map[string]interface{}{
-			"error": err.Error(),
-		})
-		return "", errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
-	}
-	var roleName string
-	if len(roles) > 0 {
-		roleName = roles[0].Name
-	}
-
-	// 2. Generar token
-	claims := &auth.AuthClaims{
-		UserID:    authUser.ID,
-		CompanyID: authUser.CompanyID,
-		Role:      roleName,
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
 	}
 
+	// 2. Generar access token
 	token, err := s.tokenService.GenerateToken(claims)
 	if err != nil {
 		logs.Error("Failed to generate token", map[string]interface{}{
 			"error": err.Error(),
 		})
-		return "", errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
 	}
 
-	// 3. Crear sesion en base de datos
+	// 3. Generar refresh token
+	now := time.Now()
+	refreshToken, refreshEntity, err := s.newRefreshToken(sessionID, authUser.ID, now)
+	if err != nil {
+		_ = s.tokenService.RevokeToken(token)
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
+	}
+
+	// 4. Crear sesion en base de datos, la sesión vive mientras su refresh token sea válido
 	deviceInfoJSON, _ := json.Marshal(deviceInfo)
 	session := &entities.UserSession{
-		ID:           uuid.NewString(),
+		ID:           sessionID,
 		UserID:       authUser.ID,
 		Token:        token,
 		DeviceInfo:   string(deviceInfoJSON),
 		IPAddress:    ipAddress,
-		ExpiresAt:    time.Now().Add(s.tokenService.GetTokenTTL()),
-		LastActivity: time.Now(),
+		ExpiresAt:    refreshEntity.ExpiresAt,
+		LastActivity: now,
 	}
-	if err := s.userRepo.CreateSession(ctx, session); err != nil {
+	if err := s.userRepo.CreateSessionWithRefreshToken(ctx, session, refreshEntity); err != nil {
 		// Si falla la creacion de la sesion, se revoca el token
 		_ = s.tokenService.RevokeToken(token)
 		logs.Error("Failed to create session", map[string]interface{}{
 			"error": err.Error(),
 		})
-		return "", errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "CreateSession", err)
 	}
 
 	logs.Info("User logged in successfully", map[string]interface{}{
 		"email": authUser.Email,
-		"role":  roleName,
+		"role":  claims.Role,
+	})
+
+	return &auth.TokenPair{
+		AccessToken:      token,
+		AccessExpiresAt:  claims.ExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshEntity.ExpiresAt,
+	}, nil
+}
+
+func (s *authService) RefreshSession(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
+	if refreshToken == "" {
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", errPackage.ErrRefreshTokenRequired)
+	}
+
+	// 1. Buscar el refresh token por su hash
+	stored, err := s.userRepo.GetRefreshTokenByHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil || stored.Session == nil {
+		logs.Warn("Refresh token not found", map[string]interface{}{
+			"error": err,
+		})
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", errPackage.ErrInvalidRefreshToken)
+	}
+	session := stored.Session
+
+	// 2. Un token ya usado indica que fue robado: se revoca toda la familia de la sesión
+	if stored.UsedAt != nil {
+		s.revokeSessionFamily(ctx, session)
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", errPackage.ErrRefreshTokenReused)
+	}
+
+	// 3. Verificar la vigencia del token y de la sesión
+	now := time.Now()
+	if now.After(stored.ExpiresAt) || now.After(session.ExpiresAt) {
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", errPackage.ErrInvalidRefreshToken)
+	}
+
+	// 4. Verificar que el usuario siga activo
+	user, err := s.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil || !user.IsActive {
+		s.revokeSessionFamily(ctx, session)
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", errPackage.ErrInactiveUser)
+	}
+
+	// 5. Emitir un nuevo access token con el rol actual del usuario
+	claims, err := s.buildClaims(ctx, user.ID, user.CompanyID, session.ID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", err)
+	}
+	token, err := s.tokenService.GenerateToken(claims)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", err)
+	}
+
+	// 6. Rotar el refresh token
+	newRefreshToken, refreshEntity, err := s.newRefreshToken(session.ID, user.ID, now)
+	if err != nil {
+		_ = s.tokenService.RevokeToken(token)
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", err)
+	}
+
+	previousToken := session.Token
+	session.Token = token
+	session.LastActivity = now
+	session.ExpiresAt = refreshEntity.ExpiresAt
+	if err = s.userRepo.RotateRefreshToken(ctx, stored.ID, session, refreshEntity); err != nil {
+		_ = s.tokenService.RevokeToken(token)
+
+		// Otra petición usó el mismo token al mismo tiempo, se trata como reutilización
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			session.Token = previousToken
+			s.revokeSessionFamily(ctx, session)
+			return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", errPackage.ErrRefreshTokenReused)
+		}
+
+		logs.Error("Failed to rotate refresh token", map[string]interface{}{
+			"session_id": session.ID,
+			"error":      err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "RefreshSession", err)
+	}
+
+	// 7. Revocar el access token anterior
+	if err = s.tokenService.RevokeToken(previousToken); err != nil {
+		logs.Warn("Failed to revoke previous access token", map[string]interface{}{
+			"session_id": session.ID,
+		})
+	}
+
+	logs.Info("Session refreshed successfully", map[string]interface{}{
+		"user_id":    user.ID,
+		"session_id": session.ID,
 	})
 
-	return token, nil
+	return &auth.TokenPair{
+		AccessToken:      token,
+		AccessExpiresAt:  claims.ExpiresAt,
+		RefreshToken:     newRefreshToken,
+		RefreshExpiresAt: refreshEntity.ExpiresAt,
+	}, nil
+}
+
+func (s *authService) GetActiveSessions(ctx context.Context, userID string) ([]entities.UserSession, error) {
+	sessions, err := s.userRepo.GetActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get active sessions", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("Authenticator", "GetActiveSessions", err)
+	}
+
+	return sessions, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	// 1. Buscar la sesión, solo se puede revocar una sesión propia
+	session, err := s.userRepo.GetSessionByID(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		logs.Warn("Session not found for revocation", map[string]interface{}{
+			"user_id":    userID,
+			"session_id": sessionID,
+		})
+		return errPackage.NewGeneralServiceError("Authenticator", "RevokeSession", errPackage.ErrUserSessionNotFound)
+	}
+
+	// 2. Eliminar el access token de la cache
+	if err = s.tokenService.RevokeToken(session.Token); err != nil {
+		return errPackage.NewGeneralServiceError("Authenticator", "RevokeSession", err)
+	}
+
+	// 3. Eliminar la sesión y sus refresh tokens de la base de datos
+	if err = s.userRepo.DeleteSession(ctx, session.ID); err != nil {
+		logs.Error("Failed to delete session", map[string]interface{}{
+			"session_id": session.ID,
+			"error":      err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("Authenticator", "RevokeSession", err)
+	}
+
+	logs.Info("Session revoked successfully", map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	})
+	return nil
+}
+
+// TouchSession registra la actividad de la sesión, como mucho una vez por sessionActivityInterval
+func (s *authService) TouchSession(ctx context.Context, sessionID string) error {
+	if err := s.userRepo.TouchSession(ctx, sessionID, time.Now(), sessionActivityInterval); err != nil {
+		return errPackage.NewGeneralServiceError("Authenticator", "TouchSession", err)
+	}
+
+	return nil
+}
+
+// buildClaims construye los claims del access token usando el rol principal del usuario
+func (s *authService) buildClaims(ctx context.Context, userID, companyID, sessionID string) (*auth.AuthClaims, error) {
+	roles, err := s.userRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get users roles", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	var roleName string
+	if len(roles) > 0 {
+		roleName = roles[0].Name
+	}
+
+	return &auth.AuthClaims{
+		UserID:    userID,
+		CompanyID: companyID,
+		Role:      roleName,
+		SessionID: sessionID,
+	}, nil
+}
+
+// newRefreshToken genera un refresh token aleatorio y la entidad que guarda su hash
+func (s *authService) newRefreshToken(sessionID, userID string, now time.Time) (string, *entities.SessionRefreshToken, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		logs.Error("Failed to generate refresh token", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, &entities.SessionRefreshToken{
+		ID:        uuid.NewString(),
+		SessionID: sessionID,
+		UserID:    userID,
+		TokenHash: hashRefreshToken(token),
+		ExpiresAt: now.Add(s.refreshTokenTTL),
+		CreatedAt: now,
+	}, nil
+}
+
+// revokeSessionFamily revoca el access token vigente y elimina la sesión con todos sus refresh tokens
+func (s *authService) revokeSessionFamily(ctx context.Context, session *entities.UserSession) {
+	logs.Warn("Revoking session family", map[string]interface{}{
+		"user_id":    session.UserID,
+		"session_id": session.ID,
+	})
+
+	if err := s.tokenService.RevokeToken(session.Token); err != nil {
+		logs.Warn("Failed to revoke session access token", map[string]interface{}{
+			"session_id": session.ID,
+		})
+	}
+	if err := s.userRepo.DeleteSession(ctx, session.ID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error("Failed to delete session", map[string]interface{}{
+			"session_id": session.ID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// hashRefreshToken obtiene el hash SHA-256 del refresh token, en base de datos nunca se guarda el token en claro
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
 func (s *authService) ValidateCredentials(ctx context.Context, email, password string) (*entities.User, error) {
@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	loginAttemptsKeyPrefix = "login_attempts:"
+	loginBlockKeyPrefix    = "login_block:"
+
+	// loginAttemptWindow es el tiempo durante el que se acumulan los intentos fallidos
+	loginAttemptWindow = 15 * time.Minute
+	baseLoginDelay     = time.Second
+	maxLoginDelay      = 30 * time.Second
+
+	defaultMaxAttemptsPerEmail = 10
+	defaultMaxAttemptsPerIP    = 50
+	defaultLockoutDuration     = 15 * time.Minute
+)
+
+// loginSubject es cada dimensión por la que se cuentan los intentos: el correo o la IP. Del correo solo se
+// registra el hash, el correo en claro se usa únicamente para asociar un bloqueo a su usuario
+type loginSubject struct {
+	kind        string
+	value       string
+	email       string
+	key         string
+	maxAttempts int
+}
+
+type loginThrottler struct {
+	cacheService        ports.Cacher
+	userRepo            domainPorts.UserRepository
+	events              interfaces.EventRecorder
+	maxAttemptsPerEmail int
+	maxAttemptsPerIP    int
+	lockoutDuration     time.Duration
+}
+
+func NewLoginThrottler(cache ports.Cacher, userRepo domainPorts.UserRepository, events interfaces.EventRecorder, maxAttemptsPerEmail, maxAttemptsPerIP int, lockoutDuration time.Duration) ports.LoginThrottler {
+	if maxAttemptsPerEmail <= 0 {
+		maxAttemptsPerEmail = defaultMaxAttemptsPerEmail
+	}
+	if maxAttemptsPerIP <= 0 {
+		maxAttemptsPerIP = defaultMaxAttemptsPerIP
+	}
+	if lockoutDuration <= 0 {
+		lockoutDuration = defaultLockoutDuration
+	}
+
+	return &loginThrottler{
+		cacheService:        cache,
+		userRepo:            userRepo,
+		events:              events,
+		maxAttemptsPerEmail: maxAttemptsPerEmail,
+		maxAttemptsPerIP:    maxAttemptsPerIP,
+		lockoutDuration:     lockoutDuration,
+	}
+}
+
+// Check retorna la mayor espera pendiente entre el correo y la IP, cero si se puede intentar
+func (t *loginThrottler) Check(_ context.Context, email, ipAddress string) (time.Duration, error) {
+	return t.check(t.subjects(email, ipAddress)), nil
+}
+
+// CheckMFA retorna la mayor espera pendiente entre el usuario y la IP para verificar un código MFA
+func (t *loginThrottler) CheckMFA(_ context.Context, userID, ipAddress string) (time.Duration, error) {
+	return t.check(t.mfaSubjects(userID, ipAddress)), nil
+}
+
+// RegisterFailure incrementa los contadores. A partir de la mitad del máximo se aplica una demora
+// que se duplica con cada fallo, y al llegar al máximo se bloquea el correo o la IP temporalmente.
+// Solo los bloqueos se registran como SystemEvent, así un ataque no genera una escritura por intento
+func (t *loginThrottler) RegisterFailure(ctx context.Context, email, ipAddress string) (time.Duration, error) {
+	return t.registerFailure(ctx, t.subjects(email, ipAddress))
+}
+
+// RegisterMFAFailure cuenta un código MFA incorrecto por usuario y por IP. El contador no depende del reto,
+// así pedir un reto nuevo no da más intentos
+func (t *loginThrottler) RegisterMFAFailure(ctx context.Context, userID, ipAddress string) (time.Duration, error) {
+	return t.registerFailure(ctx, t.mfaSubjects(userID, ipAddress))
+}
+
+// RegisterSuccess reinicia los intentos del correo, los de la IP expiran con su ventana
+func (t *loginThrottler) RegisterSuccess(_ context.Context, email, _ string) error {
+	key := t.emailKey(email)
+	if err := t.cacheService.Delete(loginAttemptsKeyPrefix + key); err != nil {
+		return err
+	}
+
+	return t.cacheService.Delete(loginBlockKeyPrefix + key)
+}
+
+// RegisterMFASuccess reinicia los intentos MFA del usuario, los de la IP expiran con su ventana
+func (t *loginThrottler) RegisterMFASuccess(_ context.Context, userID string) error {
+	key := mfaUserKey(userID)
+	if err := t.cacheService.Delete(loginAttemptsKeyPrefix + key); err != nil {
+		return err
+	}
+
+	return t.cacheService.Delete(loginBlockKeyPrefix + key)
+}
+
+// check retorna la mayor espera pendiente entre los sujetos
+func (t *loginThrottler) check(subjects []loginSubject) time.Duration {
+	var retryAfter time.Duration
+	for _, subject := range subjects {
+		value, err := t.cacheService.Get(loginBlockKeyPrefix + subject.key)
+		if err != nil {
+			continue
+		}
+
+		deadline, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		if remaining := time.Until(time.Unix(0, deadline)); remaining > retryAfter {
+			retryAfter = remaining
+		}
+	}
+
+	return retryAfter
+}
+
+// registerFailure incrementa los contadores de los sujetos. A partir de la mitad del máximo se aplica una
+// demora que se duplica con cada fallo, y al llegar al máximo se bloquea el sujeto temporalmente
+func (t *loginThrottler) registerFailure(ctx context.Context, subjects []loginSubject) (time.Duration, error) {
+	var retryAfter time.Duration
+	for _, subject := range subjects {
+		// 1. Incrementar el contador del sujeto
+		attempts, err := t.cacheService.Increment(loginAttemptsKeyPrefix+subject.key, loginAttemptWindow)
+		if err != nil {
+			return 0, err
+		}
+
+		// 2. Calcular la espera según la cantidad de intentos
+		var wait time.Duration
+		switch freeAttempts := int64(subject.maxAttempts / 2); {
+		case attempts >= int64(subject.maxAttempts):
+			wait = t.lockoutDuration
+			t.lockout(ctx, subject, attempts)
+		case attempts > freeAttempts:
+			wait = progressiveDelay(attempts - freeAttempts)
+		default:
+			continue
+		}
+
+		// 3. Guardar el instante a partir del cual se puede volver a intentar
+		deadline := strconv.FormatInt(time.Now().Add(wait).UnixNano(), 10)
+		if err = t.cacheService.Set(loginBlockKeyPrefix+subject.key, []byte(deadline), wait); err != nil {
+			return 0, err
+		}
+		if wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	return retryAfter, nil
+}
+
+// lockout reinicia el contador y registra el bloqueo como SystemEvent para revisión de seguridad
+func (t *loginThrottler) lockout(ctx context.Context, subject loginSubject, attempts int64) {
+	_ = t.cacheService.Delete(loginAttemptsKeyPrefix + subject.key)
+
+	lockedUntil := time.Now().Add(t.lockoutDuration)
+	logs.Warn("Login locked after too many failed attempts", map[string]interface{}{
+		"subject_type": subject.kind,
+		"subject":      subject.value,
+		"attempts":     attempts,
+		"locked_until": lockedUntil,
+	})
+
+	// 1. Asociar el evento al usuario cuando el bloqueo es por correo o por códigos MFA
+	var sourceID string
+	switch subject.kind {
+	case "email":
+		sourceID = t.userIDByEmail(ctx, subject.email)
+	case "mfa_user":
+		sourceID = subject.value
+	}
+
+	// 2. Registrar el evento
+	t.events.Record(ctx, &entities.SystemEventInput{
+		EventType: constants.SystemEventLoginLockout,
+		Source:    constants.SystemEventSourceAuth,
+		SourceID:  sourceID,
+		Severity:  constants.SystemEventSeverityWarning,
+		Data: map[string]interface{}{
+			"subject_type": subject.kind,
+			"subject":      subject.value,
+			"attempts":     attempts,
+			"locked_until": lockedUntil,
+		},
+	})
+}
+
+// userIDByEmail obtiene el ID del usuario del correo, vacío si no existe
+func (t *loginThrottler) userIDByEmail(ctx context.Context, email string) string {
+	user, err := t.userRepo.GetByEmail(ctx, normalizeLoginEmail(email))
+	if err != nil || user == nil {
+		return ""
+	}
+
+	return user.ID
+}
+
+func (t *loginThrottler) subjects(email, ipAddress string) []loginSubject {
+	hash := emailHash(email)
+	subjects := []loginSubject{{
+		kind:        "email",
+		value:       hash,
+		email:       normalizeLoginEmail(email),
+		key:         "email:" + hash,
+		maxAttempts: t.maxAttemptsPerEmail,
+	}}
+
+	return t.withIPSubject(subjects, ipAddress)
+}
+
+// mfaSubjects cuenta los códigos MFA por usuario con el mismo máximo que el correo. La IP comparte el
+// contador con los intentos de login
+func (t *loginThrottler) mfaSubjects(userID, ipAddress string) []loginSubject {
+	subjects := []loginSubject{{
+		kind:        "mfa_user",
+		value:       userID,
+		key:         mfaUserKey(userID),
+		maxAttempts: t.maxAttemptsPerEmail,
+	}}
+
+	return t.withIPSubject(subjects, ipAddress)
+}
+
+func (t *loginThrottler) withIPSubject(subjects []loginSubject, ipAddress string) []loginSubject {
+	if ipAddress == "" {
+		return subjects
+	}
+
+	return append(subjects, loginSubject{
+		kind:        "ip",
+		value:       ipAddress,
+		key:         "ip:" + ipAddress,
+		maxAttempts: t.maxAttemptsPerIP,
+	})
+}
+
+// emailKey usa el hash del correo para no guardar correos en claro en las claves de Redis
+func (t *loginThrottler) emailKey(email string) string {
+	return "email:" + emailHash(email)
+}
+
+// emailHash identifica el correo en claves, logs y eventos sin exponerlo
+func emailHash(email string) string {
+	sum := sha256.Sum256([]byte(normalizeLoginEmail(email)))
+	return hex.EncodeToString(sum[:])
+}
+
+func mfaUserKey(userID string) string {
+	return "mfa_user:" + userID
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// progressiveDelay duplica la demora con cada fallo: 1s, 2s, 4s... hasta maxLoginDelay
+func progressiveDelay(excess int64) time.Duration {
+	delay := baseLoginDelay
+	for i := int64(1); i < excess && delay < maxLoginDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxLoginDelay {
+		delay = maxLoginDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	rolePermissionsKeyPrefix = "role_permissions:"
+	rolePermissionsTTL       = 10 * time.Minute
+)
+
+type permissionResolver struct {
+	roleRepo domainPorts.RolerRepository
+	cache    ports.Cacher
+}
+
+func NewPermissionResolver(roleRepo domainPorts.RolerRepository, cache ports.Cacher) ports.PermissionResolver {
+	return &permissionResolver{
+		roleRepo: roleRepo,
+		cache:    cache,
+	}
+}
+
+// GetRolePermissions obtiene los permisos del rol, primero desde la caché y si no existen desde la base de datos
+func (r *permissionResolver) GetRolePermissions(ctx context.Context, roleName string) (map[string]bool, error) {
+	key := rolePermissionsKeyPrefix + roleName
+
+	// 1. Intentar obtener los permisos desde la caché
+	if cached, err := r.cache.Get(key); err == nil {
+		var permissions []string
+		if err := json.Unmarshal([]byte(cached), &permissions); err == nil {
+			return toPermissionSet(permissions), nil
+		}
+		logs.Warn("Invalid cached permissions, reloading from database", map[string]interface{}{
+			"role": roleName,
+		})
+	}
+
+	// 2. Obtener el rol, un rol inexistente o inactivo no tiene permisos
+	permissions := make([]string, 0)
+	role, err := r.roleRepo.GetRoleByName(ctx, roleName)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error("Failed to get role by name", map[string]interface{}{
+			"role":  roleName,
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("PermissionResolver", "GetRolePermissions", errPackage.ErrFailedToResolvePermissions)
+	}
+
+	// 3. Obtener los permisos asignados al rol
+	if role != nil && role.IsActive {
+		rolePermissions, err := r.roleRepo.GetRolePermissions(ctx, role.ID)
+		if err != nil {
+			logs.Error("Failed to get role permissions", map[string]interface{}{
+				"role":  roleName,
+				"error": err.Error(),
+			})
+			return nil, errPackage.NewGeneralServiceError("PermissionResolver", "GetRolePermissions", errPackage.ErrFailedToResolvePermissions)
+		}
+		for _, permission := range rolePermissions {
+			permissions = append(permissions, constants.PermissionKey(permission.Resource, permission.Action))
+		}
+	}
+
+	// 4. Guardar en caché, un fallo aquí no impide responder
+	if data, err := json.Marshal(permissions); err == nil {
+		if err := r.cache.Set(key, data, rolePermissionsTTL); err != nil {
+			logs.Warn("Failed to cache role permissions", map[string]interface{}{
+				"role":  roleName,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return toPermissionSet(permissions), nil
+}
+
+// InvalidateRolePermissions elimina los permisos del rol de la caché para forzar su recarga
+func (r *permissionResolver) InvalidateRolePermissions(ctx context.Context, roleName string) error {
+	return r.cache.Delete(rolePermissionsKeyPrefix + roleName)
+}
+
+func toPermissionSet(permissions []string) map[string]bool {
+	set := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = true
+	}
+	return set
+}
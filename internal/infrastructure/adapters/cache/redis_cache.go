@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"github.com/go-redis/redis/v8"
+	"sync"
 	"time"
 
 	"github.com/MarlonG1/delivery-backend/configs"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
@@ -15,6 +19,11 @@ type RedisTokenCache struct {
 	client *redis.Client
 	config *config.EnvConfig
 	ctx    context.Context
+
+	// Estado de la conexión, solo se registran eventos cuando Redis deja de responder o se recupera
+	events      interfaces.EventRecorder
+	unavailable bool
+	mu          sync.Mutex
 }
 
 // NewRedisTokenCache crea una nueva instancia de RedisTokenCache
@@ -54,9 +63,54 @@ func NewRedisTokenCache(redisConf *config.RedisConfig) (*RedisTokenCache, error)
 	}, nil
 }
 
+// SetEventRecorder configura dónde se registran las caídas y recuperaciones de Redis.
+// Se configura después de crear la caché porque el registro de eventos no depende de Redis
+func (c *RedisTokenCache) SetEventRecorder(events interfaces.EventRecorder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.events = events
+}
+
+// trackAvailability registra un evento cuando una operación falla estando Redis disponible
+// y otro cuando vuelve a responder, así una caída genera un solo par de eventos
+func (c *RedisTokenCache) trackAvailability(operation string, err error) {
+	c.mu.Lock()
+	failed := err != nil && !errors.Is(err, redis.Nil)
+	if failed == c.unavailable || c.events == nil {
+		c.unavailable = failed
+		c.mu.Unlock()
+		return
+	}
+	c.unavailable = failed
+	events := c.events
+	c.mu.Unlock()
+
+	input := &entities.SystemEventInput{
+		EventType: constants.SystemEventCacheRecovered,
+		Source:    constants.SystemEventSourceCache,
+		Severity:  constants.SystemEventSeverityInfo,
+		Data: map[string]interface{}{
+			"operation": operation,
+		},
+	}
+	if failed {
+		input.EventType = constants.SystemEventCacheUnavailable
+		input.Severity = constants.SystemEventSeverityCritical
+		input.Logs = []entities.EventLogInput{{
+			LogLevel:    constants.SystemEventSeverityError,
+			Description: err.Error(),
+			Metadata:    map[string]interface{}{"operation": operation},
+		}}
+	}
+
+	events.Record(context.Background(), input)
+}
+
 // Set guarda un token en Redis con un tiempo de vida determinado
 func (c *RedisTokenCache) Set(key string, saveInfo []byte, ttl time.Duration) error {
 	err := c.client.Set(c.ctx, key, saveInfo, ttl).Err()
+	c.trackAvailability("Set", err)
 	if err != nil {
 		logs.Error("Failed to set value in Redis", map[string]interface{}{
 			"key":   key,
@@ -79,6 +133,7 @@ func (c *RedisTokenCache) Set(key string, saveInfo []byte, ttl time.Duration) er
 // Get obtiene un token de Redis y lo convierte en un AuthClaims
 func (c *RedisTokenCache) Get(key string) (string, error) {
 	cacheInfo, err := c.client.Get(c.ctx, key).Result()
+	c.trackAvailability("Get", err)
 	if errors.Is(err, redis.Nil) {
 		logs.Error("Token not found in Redis", map[string]interface{}{
 			"key": key,
@@ -108,6 +163,7 @@ func (c *RedisTokenCache) Get(key string) (string, error) {
 // Delete elimina un token de Redis
 func (c *RedisTokenCache) Delete(key string) error {
 	err := c.client.Del(c.ctx, key).Err()
+	c.trackAvailability("Delete", err)
 	if err != nil {
 		logs.Error("Failed to delete token from Redis", map[string]interface{}{
 			"key":   key,
@@ -126,6 +182,35 @@ func (c *RedisTokenCache) Delete(key string) error {
 	return nil
 }
 
+// incrementScript incrementa y fija la expiración en una sola operación atómica
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// Increment incrementa el contador de forma atómica. La expiración solo se fija al crear la clave,
+// así la ventana del contador no se extiende con cada incremento
+func (c *RedisTokenCache) Increment(key string, ttl time.Duration) (int64, error) {
+	count, err := incrementScript.Run(c.ctx, c.client, []string{key}, ttl.Milliseconds()).Int64()
+	c.trackAvailability("Increment", err)
+	if err != nil {
+		logs.Error("Failed to increment counter in Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewGeneralServiceError(
+			"RedisTokenCache",
+			"Increment",
+			errPackage.ErrFailedIncrement,
+		)
+	}
+
+	return count, nil
+}
+
 // Close cierra la conexión con Redis
 func (c *RedisTokenCache) Close() error {
 	err := c.client.Close()
@@ -150,6 +235,7 @@ func (c *RedisTokenCache) GetRedisClient() *redis.Client {
 
 func (c *RedisTokenCache) RPush(key string, value []byte) error {
 	err := c.client.RPush(c.ctx, key, value).Err()
+	c.trackAvailability("RPush", err)
 	if err != nil {
 		logs.Error("Failed to RPush value to Redis", map[string]interface{}{
 			"key":   key,
@@ -170,6 +256,7 @@ func (c *RedisTokenCache) RPush(key string, value []byte) error {
 
 func (c *RedisTokenCache) LPush(key string, value []byte) error {
 	err := c.client.LPush(c.ctx, key, value).Err()
+	c.trackAvailability("LPush", err)
 	if err != nil {
 		logs.Error("Failed to LPush value to Redis", map[string]interface{}{
 			"key":   key,
@@ -190,6 +277,7 @@ func (c *RedisTokenCache) LPush(key string, value []byte) error {
 
 func (c *RedisTokenCache) LRange(key string, start, stop int64) ([]string, error) {
 	result, err := c.client.LRange(c.ctx, key, start, stop).Result()
+	c.trackAvailability("LRange", err)
 	if err != nil {
 		logs.Error("Failed to get range from Redis", map[string]interface{}{
 			"key":   key,
@@ -207,6 +295,7 @@ func (c *RedisTokenCache) LRange(key string, start, stop int64) ([]string, error
 
 func (c *RedisTokenCache) LLen(key string) (int64, error) {
 	length, err := c.client.LLen(c.ctx, key).Result()
+	c.trackAvailability("LLen", err)
 	if err != nil {
 		logs.Error("Failed to get list length from Redis", map[string]interface{}{
 			"key":   key,
@@ -224,6 +313,7 @@ func (c *RedisTokenCache) LLen(key string) (int64, error) {
 
 func (c *RedisTokenCache) LTrim(key string, start, stop int64) error {
 	err := c.client.LTrim(c.ctx, key, start, stop).Err()
+	c.trackAvailability("LTrim", err)
 	if err != nil {
 		logs.Error("Failed to trim list in Redis", map[string]interface{}{
 			"key":   key,
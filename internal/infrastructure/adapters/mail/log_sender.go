@@ -0,0 +1,24 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// LogSender registra los correos en el log en lugar de enviarlos, se usa cuando no hay SMTP configurado.
+// El cuerpo no se registra porque puede contener enlaces con tokens de un solo uso.
+type LogSender struct{}
+
+func NewLogSender() ports.MailSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(_ context.Context, message *ports.MailMessage) error {
+	logs.Warn("Mail delivery is not configured, message only logged", map[string]interface{}{
+		"to":      message.To,
+		"subject": message.Subject,
+	})
+	return nil
+}
@@ -0,0 +1,79 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type SMTPSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPSender(host, port, username, password, from string) ports.MailSender {
+	return &SMTPSender{
+		addr:     net.JoinHostPort(host, port),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send envía el correo por SMTP, la autenticación solo se usa si hay usuario configurado
+func (s *SMTPSender) Send(ctx context.Context, message *ports.MailMessage) error {
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.addr, auth, s.from, []string{message.To}, s.buildMessage(message))
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if err != nil {
+		logs.Error("Failed to send mail", map[string]interface{}{
+			"to":    message.To,
+			"error": err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("SMTPSender", "Send", errPackage.ErrFailedToSendMail)
+	}
+
+	logs.Info("Mail sent successfully", map[string]interface{}{
+		"to":      message.To,
+		"subject": message.Subject,
+	})
+	return nil
+}
+
+// buildMessage arma el mensaje con las cabeceras mínimas de un correo de texto plano
+func (s *SMTPSender) buildMessage(message *ports.MailMessage) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,522 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	mfaChallengeKeyPrefix = "mfa_challenge:"
+
+	// mfaChallengeTTL es el tiempo que tiene el usuario para completar el segundo paso del login
+	mfaChallengeTTL = 5 * time.Minute
+	// maxChallengeAttempts invalida el reto tras varios códigos incorrectos
+	maxChallengeAttempts = 5
+
+	recoveryCodeCount = 10
+	defaultIssuer     = "Delivery Backend"
+)
+
+// challengeState es lo que se guarda en caché para cada reto de MFA
+type challengeState struct {
+	UserID             string                 `json:"user_id"`
+	DeviceInfo         map[string]interface{} `json:"device_info,omitempty"`
+	IPAddress          string                 `json:"ip_address"`
+	EnrollmentRequired bool                   `json:"enrollment_required"`
+	Attempts           int                    `json:"attempts"`
+	ExpiresAt          time.Time              `json:"expires_at"`
+}
+
+type MFAService struct {
+	userRepo      domainPorts.UserRepository
+	cacheService  ports.Cacher
+	cipher        *secretCipher
+	issuer        string
+	requiredRoles map[string]bool
+}
+
+func NewMFAService(userRepo domainPorts.UserRepository, cache ports.Cacher, encryptionKey, issuer string, requiredRoles []string) (ports.MFAManager, error) {
+	cipher, err := newSecretCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if issuer == "" {
+		issuer = defaultIssuer
+	}
+
+	roles := make(map[string]bool, len(requiredRoles))
+	for _, role := range requiredRoles {
+		if role = strings.ToUpper(strings.TrimSpace(role)); role != "" {
+			roles[role] = true
+		}
+	}
+
+	return &MFAService{
+		userRepo:      userRepo,
+		cacheService:  cache,
+		cipher:        cipher,
+		issuer:        issuer,
+		requiredRoles: roles,
+	}, nil
+}
+
+func (s *MFAService) GetStatus(ctx context.Context, userID string) (*auth.MFAStatus, error) {
+	// 1. Verificar si algún rol del usuario exige MFA
+	required, err := s.isRequired(ctx, userID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "GetStatus", err)
+	}
+	status := &auth.MFAStatus{Required: required}
+
+	// 2. Obtener la configuración MFA, si no existe el MFA está desactivado
+	mfa, err := s.userRepo.GetMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status, nil
+		}
+		return nil, errPackage.NewGeneralServiceError("MFAService", "GetStatus", err)
+	}
+	if mfa.EnabledAt == nil {
+		return status, nil
+	}
+
+	// 3. Contar los códigos de recuperación disponibles
+	remaining, err := s.userRepo.CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "GetStatus", err)
+	}
+	status.Enabled = true
+	status.RecoveryCodesRemaining = remaining
+
+	return status, nil
+}
+
+// BeginEnrollment genera un nuevo secreto pendiente de confirmar, reemplaza cualquier inscripción pendiente anterior
+func (s *MFAService) BeginEnrollment(ctx context.Context, userID string) (*auth.MFAEnrollment, error) {
+	// 1. Verificar que el MFA no esté activo
+	mfa, err := s.userRepo.GetMFA(ctx, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginEnrollment", err)
+	}
+	if mfa != nil && mfa.EnabledAt != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginEnrollment", errPackage.ErrMFAAlreadyEnabled)
+	}
+
+	// 2. Obtener el usuario para la etiqueta de la aplicación autenticadora
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginEnrollment", err)
+	}
+
+	// 3. Generar y guardar el secreto cifrado
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginEnrollment", err)
+	}
+	encrypted, err := s.cipher.encrypt(secret)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginEnrollment", err)
+	}
+
+	now := time.Now()
+	if err = s.userRepo.SaveMFA(ctx, &entities.UserMFA{
+		UserID:    userID,
+		Secret:    encrypted,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		logs.Error("Failed to save MFA enrollment", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginEnrollment", err)
+	}
+
+	return &auth.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: ProvisioningURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment activa el MFA si el código corresponde al secreto pendiente
+func (s *MFAService) ConfirmEnrollment(ctx context.Context, userID, code string) ([]string, error) {
+	// 1. Obtener la inscripción pendiente
+	mfa, err := s.userRepo.GetMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewGeneralServiceError("MFAService", "ConfirmEnrollment", errPackage.ErrMFAEnrollmentNotFound)
+		}
+		return nil, errPackage.NewGeneralServiceError("MFAService", "ConfirmEnrollment", err)
+	}
+	if mfa.EnabledAt != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "ConfirmEnrollment", errPackage.ErrMFAAlreadyEnabled)
+	}
+
+	// 2. Verificar el código TOTP
+	if err = s.verifyTOTP(ctx, mfa, code); err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "ConfirmEnrollment", err)
+	}
+
+	// 3. Activar el MFA con nuevos códigos de recuperación
+	codes, records, err := s.newRecoveryCodes(userID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "ConfirmEnrollment", err)
+	}
+	if err = s.userRepo.EnableMFA(ctx, userID, time.Now(), records); err != nil {
+		logs.Error("Failed to enable MFA", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("MFAService", "ConfirmEnrollment", err)
+	}
+
+	logs.Info("MFA enabled", map[string]interface{}{
+		"user_id": userID,
+	})
+	return codes, nil
+}
+
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) {
+	// 1. Obtener el MFA activo y verificar el código TOTP
+	mfa, err := s.getEnabledMFA(ctx, userID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "RegenerateRecoveryCodes", err)
+	}
+	if err = s.verifyTOTP(ctx, mfa, code); err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "RegenerateRecoveryCodes", err)
+	}
+
+	// 2. Reemplazar los códigos de recuperación
+	codes, records, err := s.newRecoveryCodes(userID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "RegenerateRecoveryCodes", err)
+	}
+	if err = s.userRepo.ReplaceRecoveryCodes(ctx, userID, records); err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "RegenerateRecoveryCodes", err)
+	}
+
+	return codes, nil
+}
+
+func (s *MFAService) Disable(ctx context.Context, userID, code string) error {
+	// 1. Un usuario cuyo rol exige MFA no puede desactivarlo
+	required, err := s.isRequired(ctx, userID)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("MFAService", "Disable", err)
+	}
+	if required {
+		return errPackage.NewGeneralServiceError("MFAService", "Disable", errPackage.ErrMFARequiredByRole)
+	}
+
+	// 2. Verificar el código, se acepta TOTP o código de recuperación
+	mfa, err := s.getEnabledMFA(ctx, userID)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("MFAService", "Disable", err)
+	}
+	if err = s.verifyCode(ctx, mfa, code); err != nil {
+		return errPackage.NewGeneralServiceError("MFAService", "Disable", err)
+	}
+
+	// 3. Eliminar el secreto y los códigos de recuperación
+	if err = s.userRepo.DeleteMFA(ctx, userID); err != nil {
+		return errPackage.NewGeneralServiceError("MFAService", "Disable", err)
+	}
+
+	logs.Info("MFA disabled", map[string]interface{}{
+		"user_id": userID,
+	})
+	return nil
+}
+
+// CreateChallenge guarda los datos del login hasta que el usuario complete el segundo paso
+func (s *MFAService) CreateChallenge(ctx context.Context, user *entities.User, deviceInfo map[string]interface{}, ipAddress string, enrollmentRequired bool) (*auth.MFAChallenge, error) {
+	// 1. Generar el token del reto
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "CreateChallenge", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	// 2. Guardar el estado del reto
+	state := &challengeState{
+		UserID:             user.ID,
+		DeviceInfo:         deviceInfo,
+		IPAddress:          ipAddress,
+		EnrollmentRequired: enrollmentRequired,
+		ExpiresAt:          time.Now().Add(mfaChallengeTTL),
+	}
+	if err := s.saveChallenge(token, state); err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "CreateChallenge", err)
+	}
+
+	return &auth.MFAChallenge{
+		Token:              token,
+		ExpiresAt:          state.ExpiresAt,
+		EnrollmentRequired: enrollmentRequired,
+	}, nil
+}
+
+// BeginChallengeEnrollment permite inscribir el MFA durante el login cuando el rol lo exige y el usuario aún no lo tiene
+func (s *MFAService) BeginChallengeEnrollment(ctx context.Context, challengeToken string) (*auth.MFAEnrollment, error) {
+	state, err := s.getChallenge(challengeToken)
+	if err != nil || !state.EnrollmentRequired {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "BeginChallengeEnrollment", errPackage.ErrMFAChallengeInvalid)
+	}
+
+	return s.BeginEnrollment(ctx, state.UserID)
+}
+
+// VerifyChallenge completa el segundo paso del login.
+// Si el reto exige inscripción, el código confirma el secreto pendiente y se devuelven los códigos de recuperación.
+func (s *MFAService) VerifyChallenge(ctx context.Context, challengeToken, code string) (*auth.MFAChallengeResult, error) {
+	// 1. Obtener el reto
+	state, err := s.getChallenge(challengeToken)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "VerifyChallenge", errPackage.ErrMFAChallengeInvalid)
+	}
+
+	// 2. Verificar el código según el tipo de reto
+	var recoveryCodes []string
+	if state.EnrollmentRequired {
+		recoveryCodes, err = s.ConfirmEnrollment(ctx, state.UserID, code)
+	} else {
+		var mfa *entities.UserMFA
+		if mfa, err = s.getEnabledMFA(ctx, state.UserID); err == nil {
+			err = s.verifyCode(ctx, mfa, code)
+		}
+	}
+	if err != nil {
+		if errors.Is(err, errPackage.ErrInvalidMFACode) {
+			s.registerFailedAttempt(challengeToken, state)
+		}
+		return nil, errPackage.NewGeneralServiceError("MFAService", "VerifyChallenge", err)
+	}
+
+	// 3. El reto solo puede usarse una vez
+	_ = s.cacheService.Delete(challengeKey(challengeToken))
+
+	// 4. Verificar que el usuario siga activo
+	user, err := s.userRepo.GetByID(ctx, state.UserID)
+	if err != nil || !user.IsActive {
+		return nil, errPackage.NewGeneralServiceError("MFAService", "VerifyChallenge", errPackage.ErrInactiveUser)
+	}
+
+	return &auth.MFAChallengeResult{
+		User:          user,
+		DeviceInfo:    state.DeviceInfo,
+		IPAddress:     state.IPAddress,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// ChallengeUserID retorna el usuario del reto sin consumirlo, para limitar los intentos antes de verificar el código
+func (s *MFAService) ChallengeUserID(_ context.Context, challengeToken string) (string, error) {
+	state, err := s.getChallenge(challengeToken)
+	if err != nil {
+		return "", errPackage.NewGeneralServiceError("MFAService", "ChallengeUserID", errPackage.ErrMFAChallengeInvalid)
+	}
+
+	return state.UserID, nil
+}
+
+// isRequired indica si alguno de los roles del usuario exige MFA
+func (s *MFAService) isRequired(ctx context.Context, userID string) (bool, error) {
+	if len(s.requiredRoles) == 0 {
+		return false, nil
+	}
+
+	roles, err := s.userRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if s.requiredRoles[role.Name] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *MFAService) getEnabledMFA(ctx context.Context, userID string) (*entities.UserMFA, error) {
+	mfa, err := s.userRepo.GetMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrMFANotEnabled
+		}
+		return nil, err
+	}
+	if mfa.EnabledAt == nil {
+		return nil, errPackage.ErrMFANotEnabled
+	}
+
+	return mfa, nil
+}
+
+// verifyCode acepta un código TOTP o, si no tiene su formato, un código de recuperación
+func (s *MFAService) verifyCode(ctx context.Context, mfa *entities.UserMFA, code string) error {
+	normalized := normalizeCode(code)
+	if normalized == "" {
+		return errPackage.ErrMFACodeRequired
+	}
+	if isNumeric(normalized) && len(normalized) == TOTPDigits {
+		return s.verifyTOTP(ctx, mfa, normalized)
+	}
+
+	if err := s.userRepo.UseRecoveryCode(ctx, mfa.UserID, hashRecoveryCode(normalized)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errPackage.ErrInvalidMFACode
+		}
+		return err
+	}
+
+	logs.Info("MFA recovery code used", map[string]interface{}{
+		"user_id": mfa.UserID,
+	})
+	return nil
+}
+
+// verifyTOTP valida el código y registra su intervalo para que no pueda reutilizarse
+func (s *MFAService) verifyTOTP(ctx context.Context, mfa *entities.UserMFA, code string) error {
+	code = normalizeCode(code)
+	if code == "" {
+		return errPackage.ErrMFACodeRequired
+	}
+
+	secret, err := s.cipher.decrypt(mfa.Secret)
+	if err != nil {
+		logs.Error("Failed to decrypt MFA secret", map[string]interface{}{
+			"user_id": mfa.UserID,
+		})
+		return err
+	}
+
+	step, ok := ValidateTOTPCode(secret, code, time.Now())
+	if !ok || step <= mfa.LastUsedStep {
+		return errPackage.ErrInvalidMFACode
+	}
+	if err = s.userRepo.AdvanceMFAStep(ctx, mfa.UserID, step); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errPackage.ErrInvalidMFACode
+		}
+		return err
+	}
+
+	return nil
+}
+
+// newRecoveryCodes genera los códigos en claro para el usuario y las entidades con su hash
+func (s *MFAService) newRecoveryCodes(userID string) ([]string, []entities.MFARecoveryCode, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	records := make([]entities.MFARecoveryCode, 0, recoveryCodeCount)
+	now := time.Now()
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := base32.StdEncoding.EncodeToString(raw)
+
+		codes = append(codes, code[:4]+"-"+code[4:])
+		records = append(records, entities.MFARecoveryCode{
+			ID:        uuid.NewString(),
+			UserID:    userID,
+			CodeHash:  hashRecoveryCode(code),
+			CreatedAt: now,
+		})
+	}
+
+	return codes, records, nil
+}
+
+// registerFailedAttempt incrementa los intentos del reto y lo elimina al alcanzar el máximo
+func (s *MFAService) registerFailedAttempt(token string, state *challengeState) {
+	state.Attempts++
+	if state.Attempts >= maxChallengeAttempts {
+		logs.Warn("MFA challenge invalidated after too many attempts", map[string]interface{}{
+			"user_id": state.UserID,
+		})
+		_ = s.cacheService.Delete(challengeKey(token))
+		return
+	}
+
+	_ = s.saveChallenge(token, state)
+}
+
+func (s *MFAService) saveChallenge(token string, state *challengeState) error {
+	ttl := time.Until(state.ExpiresAt)
+	if ttl <= 0 {
+		return errPackage.ErrMFAChallengeInvalid
+	}
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return s.cacheService.Set(challengeKey(token), value, ttl)
+}
+
+func (s *MFAService) getChallenge(token string) (*challengeState, error) {
+	if token == "" {
+		return nil, errPackage.ErrMFAChallengeInvalid
+	}
+
+	value, err := s.cacheService.Get(challengeKey(token))
+	if err != nil {
+		return nil, errPackage.ErrMFAChallengeInvalid
+	}
+
+	var state challengeState
+	if err = json.Unmarshal([]byte(value), &state); err != nil || time.Now().After(state.ExpiresAt) {
+		return nil, errPackage.ErrMFAChallengeInvalid
+	}
+
+	return &state, nil
+}
+
+func challengeKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return mfaChallengeKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeCode elimina espacios y guiones para aceptar los códigos tal como los muestran las aplicaciones
+func normalizeCode(code string) string {
+	code = strings.ReplaceAll(code, " ", "")
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ToUpper(code)
+}
+
+func isNumeric(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// EmailSender entrega las notificaciones por correo con el MailSender configurado
+type EmailSender struct {
+	mailSender ports.MailSender
+}
+
+func NewEmailSender(mailSender ports.MailSender) interfaces.NotificationSender {
+	return &EmailSender{
+		mailSender: mailSender,
+	}
+}
+
+func (s *EmailSender) Channel() string {
+	return constants.NotificationChannelEmail
+}
+
+func (s *EmailSender) Send(ctx context.Context, notification *entities.Notification, recipient *entities.User) error {
+	if recipient.Email == "" {
+		return errors.New("recipient has no email address")
+	}
+
+	return s.mailSender.Send(ctx, &ports.MailMessage{
+		To:      recipient.Email,
+		Subject: notification.Title,
+		Body:    notification.Content,
+	})
+}
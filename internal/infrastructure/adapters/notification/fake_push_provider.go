@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// PushedMessage es un mensaje entregado por el FakePushProvider
+type PushedMessage struct {
+	Token   string
+	Message entities.PushMessage
+}
+
+// FakePushProvider guarda los mensajes push en memoria en lugar de enviarlos, se usa en pruebas.
+// Cada token puede configurarse para fallar con un error
+type FakePushProvider struct {
+	deviceTypes []string
+	failures    map[string]error
+	pushed      []PushedMessage
+	mu          sync.Mutex
+}
+
+func NewFakePushProvider(deviceTypes ...string) *FakePushProvider {
+	return &FakePushProvider{
+		deviceTypes: deviceTypes,
+		failures:    make(map[string]error),
+	}
+}
+
+func (p *FakePushProvider) DeviceTypes() []string {
+	return p.deviceTypes
+}
+
+func (p *FakePushProvider) Push(_ context.Context, token string, message *entities.PushMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.failures[token]; err != nil {
+		return err
+	}
+
+	p.pushed = append(p.pushed, PushedMessage{
+		Token:   token,
+		Message: *message,
+	})
+	return nil
+}
+
+// FailToken hace que los envíos al token fallen con el error, nil los vuelve a aceptar
+func (p *FakePushProvider) FailToken(token string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err == nil {
+		delete(p.failures, token)
+		return
+	}
+	p.failures[token] = err
+}
+
+// Pushed retorna una copia de los mensajes entregados
+func (p *FakePushProvider) Pushed() []PushedMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PushedMessage(nil), p.pushed...)
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// LogPushProvider registra los mensajes push en el log en lugar de enviarlos, se usa mientras no hay
+// un proveedor FCM o APNs configurado
+type LogPushProvider struct{}
+
+func NewLogPushProvider() interfaces.PushProvider {
+	return &LogPushProvider{}
+}
+
+func (p *LogPushProvider) DeviceTypes() []string {
+	return []string{constants.DeviceTypeAndroid, constants.DeviceTypeIOS, constants.DeviceTypeWeb}
+}
+
+// Push registra solo el título y un hash corto del token, el token del dispositivo es una credencial
+// de envío y el cuerpo puede incluir datos del pedido
+func (p *LogPushProvider) Push(_ context.Context, token string, message *entities.PushMessage) error {
+	logs.Warn("Push delivery is not configured, message only logged", map[string]interface{}{
+		"token_hash": tokenFingerprint(token),
+		"title":      message.Title,
+	})
+	return nil
+}
+
+// tokenFingerprint retorna los primeros caracteres del hash del token, suficientes para correlacionar
+// entradas del log sin exponer el token
+func tokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:12]
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// SentNotification es una notificación entregada por el InMemorySender
+type SentNotification struct {
+	Channel      string
+	Notification entities.Notification
+	Recipient    entities.User
+}
+
+// InMemorySender guarda las notificaciones en memoria en lugar de enviarlas, se usa en pruebas
+// y como canal de desarrollo. Con un error configurado todos los envíos fallan
+type InMemorySender struct {
+	channel string
+	err     error
+	sent    []SentNotification
+	mu      sync.Mutex
+}
+
+func NewInMemorySender(channel string) *InMemorySender {
+	return &InMemorySender{
+		channel: channel,
+	}
+}
+
+func (s *InMemorySender) Channel() string {
+	return s.channel
+}
+
+func (s *InMemorySender) Send(_ context.Context, notification *entities.Notification, recipient *entities.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.sent = append(s.sent, SentNotification{
+		Channel:      s.channel,
+		Notification: *notification,
+		Recipient:    *recipient,
+	})
+	return nil
+}
+
+// FailWith hace que los siguientes envíos fallen con el error, nil los vuelve a aceptar
+func (s *InMemorySender) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
+
+// Sent retorna una copia de las notificaciones entregadas
+func (s *InMemorySender) Sent() []SentNotification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]SentNotification(nil), s.sent...)
+}
@@ -0,0 +1,154 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// PushSender entrega las notificaciones a los dispositivos activos del usuario con el proveedor de cada
+// tipo de dispositivo. Un dispositivo se desactiva cuando el proveedor rechaza su token o tras maxFailures
+// envíos fallidos seguidos
+type PushSender struct {
+	devices     ports.NotificationDeviceRepository
+	providers   map[string]interfaces.PushProvider
+	maxFailures int
+}
+
+func NewPushSender(devices ports.NotificationDeviceRepository, maxFailures int, providers ...interfaces.PushProvider) interfaces.NotificationSender {
+	if maxFailures <= 0 {
+		maxFailures = constants.DefaultMaxDeviceFailures
+	}
+
+	registered := make(map[string]interfaces.PushProvider)
+	for _, provider := range providers {
+		for _, deviceType := range provider.DeviceTypes() {
+			registered[deviceType] = provider
+		}
+	}
+
+	return &PushSender{
+		devices:     devices,
+		providers:   registered,
+		maxFailures: maxFailures,
+	}
+}
+
+func (s *PushSender) Channel() string {
+	return constants.NotificationChannelPush
+}
+
+// Send entrega la notificación a cada dispositivo activo, basta con que un dispositivo la reciba
+func (s *PushSender) Send(ctx context.Context, notification *entities.Notification, recipient *entities.User) error {
+	// 1. Obtener los dispositivos activos del usuario
+	devices, err := s.devices.GetActiveByUser(ctx, recipient.ID)
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		return errors.New("recipient has no active push devices")
+	}
+
+	message := &entities.PushMessage{
+		Title: notification.Title,
+		Body:  notification.Content,
+		Data:  pushData(notification),
+	}
+
+	// 2. Enviar a cada dispositivo con el proveedor de su tipo
+	delivered := false
+	for i := range devices {
+		device := &devices[i]
+		provider, ok := s.providers[device.DeviceType]
+		if !ok {
+			logs.Warn("No push provider for device type", map[string]interface{}{
+				"deviceID":   device.ID,
+				"deviceType": device.DeviceType,
+			})
+			continue
+		}
+
+		if err = provider.Push(ctx, device.DeviceToken, message); err != nil {
+			s.registerFailure(ctx, device, err)
+			continue
+		}
+
+		delivered = true
+		if err = s.devices.MarkUsed(ctx, device.ID, time.Now()); err != nil {
+			logs.Warn("Failed to mark push device as used", map[string]interface{}{
+				"deviceID": device.ID,
+				"error":    err.Error(),
+			})
+		}
+	}
+
+	if !delivered {
+		return errors.New("push notification was not delivered to any device")
+	}
+
+	return nil
+}
+
+// pushData arma los datos del mensaje con los valores de texto de la metadata, por ejemplo el pedido relacionado
+func pushData(notification *entities.Notification) map[string]string {
+	data := map[string]string{}
+
+	var metadata map[string]interface{}
+	if notification.Metadata != "" && json.Unmarshal([]byte(notification.Metadata), &metadata) == nil {
+		for key, value := range metadata {
+			if text, ok := value.(string); ok {
+				data[key] = text
+			}
+		}
+	}
+
+	data["notification_id"] = notification.ID
+	data["type"] = notification.Type
+	return data
+}
+
+// registerFailure cuenta el fallo del dispositivo y lo desactiva si el token es inválido o llegó al máximo de fallos
+func (s *PushSender) registerFailure(ctx context.Context, device *entities.NotificationDevice, cause error) {
+	failures := s.maxFailures
+	if !errors.Is(cause, errPackage.ErrInvalidDeviceToken) {
+		var err error
+		if failures, err = s.devices.IncrementFailures(ctx, device.ID); err != nil {
+			logs.Error("Failed to count push device failure", map[string]interface{}{
+				"deviceID": device.ID,
+				"error":    err.Error(),
+			})
+			return
+		}
+	}
+
+	logs.Warn("Failed to send push notification", map[string]interface{}{
+		"deviceID": device.ID,
+		"failures": failures,
+		"error":    cause.Error(),
+	})
+
+	if failures < s.maxFailures {
+		return
+	}
+	if err := s.devices.Deactivate(ctx, device.ID); err != nil {
+		logs.Error("Failed to deactivate push device", map[string]interface{}{
+			"deviceID": device.ID,
+			"error":    err.Error(),
+		})
+		return
+	}
+
+	logs.Info("Push device deactivated", map[string]interface{}{
+		"deviceID": device.ID,
+		"userID":   device.UserID,
+		"failures": failures,
+	})
+}
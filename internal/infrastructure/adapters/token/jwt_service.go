@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	"time"
 
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
@@ -12,16 +13,30 @@ import (
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
+// defaultAccessTokenTTL se usa cuando no se configura ACCESS_TOKEN_TTL_MINUTES
+const defaultAccessTokenTTL = 15 * time.Minute
+
 type JWTService struct {
-	secretKey    string
+	keys         *KeySet
 	tokenTTL     time.Duration
 	cacheService ports.Cacher
 }
 
-func NewJWTService(secretKey string, cache ports.Cacher) *JWTService {
+// NewJWTService crea el servicio firmando con HS256 y el secreto indicado
+func NewJWTService(secretKey string, tokenTTL time.Duration, cache ports.Cacher) *JWTService {
+	key := newHMACKey(secretKey)
+	return NewJWTServiceWithKeys(&KeySet{active: key, keys: map[string]*SigningKey{}, legacy: key}, tokenTTL, cache)
+}
+
+// NewJWTServiceWithKeys crea el servicio firmando con la clave activa del conjunto
+func NewJWTServiceWithKeys(keys *KeySet, tokenTTL time.Duration, cache ports.Cacher) *JWTService {
+	if tokenTTL <= 0 {
+		tokenTTL = defaultAccessTokenTTL
+	}
+
 	return &JWTService{
-		secretKey:    secretKey,
-		tokenTTL:     15 * 24 * time.Hour,
+		keys:         keys,
+		tokenTTL:     tokenTTL,
 		cacheService: cache,
 	}
 }
@@ -31,19 +46,29 @@ func (s *JWTService) GenerateToken(claims *auth.AuthClaims) (string, error) {
 	now := time.Now()
 	exp := now.Add(s.tokenTTL)
 
+	claims.IssuedAt = now
 	claims.ExpiresAt = exp
 
-	// 1. Crear los claims del JWT
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	// 1. Crear los claims del JWT, el kid identifica la clave para los verificadores externos. El jti hace
+	// único cada token, sin él dos tokens de la misma sesión emitidos en el mismo segundo serían iguales
+	// y revocar el anterior al refrescar revocaría también el nuevo
+	active := s.keys.Active()
+	token := jwt.NewWithClaims(active.Method, jwt.MapClaims{
+		"jti":  uuid.NewString(),
 		"sub":  claims.UserID,
 		"role": claims.Role,
 		"cid":  claims.CompanyID,
+		"sid":  claims.SessionID,
 		"exp":  exp.Unix(),
 		"iat":  now.Unix(),
 	})
 
+	if active.ID != "" {
+		token.Header["kid"] = active.ID
+	}
+
 	// 2. Firma del token
-	signedToken, err := token.SignedString([]byte(s.secretKey))
+	signedToken, err := token.SignedString(active.signingKey)
 	if err != nil {
 		logs.Error("Failed to sign token", map[string]interface{}{
 			"error": err.Error(),
@@ -94,12 +119,7 @@ func (s *JWTService) ValidateToken(tokenString string) (*auth.AuthClaims, error)
 	}
 
 	// 2. Validar el token
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("%w: %v", errPackage.ErrUnexpectedSigningMethod, token.Header["alg"])
-		}
-		return []byte(s.secretKey), nil
-	})
+	token, err := jwt.Parse(tokenString, s.verificationKey)
 	if err != nil || !token.Valid {
 		logs.Error("Invalid token", map[string]interface{}{
 			"error": err,
@@ -118,6 +138,22 @@ func (s *JWTService) ValidateToken(tokenString string) (*auth.AuthClaims, error)
 	return &userClaims, nil
 }
 
+// verificationKey obtiene la clave según el kid del token y exige el algoritmo de esa clave,
+// así un token no puede elegir un algoritmo distinto al de la clave con la que se firmó
+func (s *JWTService) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys.Lookup(kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errPackage.ErrUnknownSigningKey, kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("%w: %v", errPackage.ErrUnexpectedSigningMethod, token.Header["alg"])
+	}
+
+	return key.verifyKey, nil
+}
+
 // RevokeToken revoca un token eliminándolo de la caché
 func (s *JWTService) RevokeToken(token string) error {
 	key := "token:" + token
@@ -0,0 +1,248 @@
+package token
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+const minRSAKeyBits = 2048
+
+// SigningKey es una clave con la que se firman o verifican tokens
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	signingKey interface{} // nil si la clave solo se usa para verificar
+	verifyKey  interface{}
+	public     *auth.JSONWebKey // nil para la clave HMAC, que no se publica
+}
+
+// KeySet contiene la clave activa de firma y las claves aceptadas al verificar.
+// Para rotar sin cerrar sesiones se agrega la nueva clave como activa y la anterior
+// se conserva hasta que expiren los tokens que firmó.
+type KeySet struct {
+	active  *SigningKey
+	keys    map[string]*SigningKey
+	ordered []*SigningKey // claves publicadas, en el orden configurado
+	legacy  *SigningKey   // clave HMAC de los tokens emitidos sin kid
+}
+
+// NewHMACKeySet crea un conjunto que firma con HS256, el comportamiento previo a las claves asimétricas
+func NewHMACKeySet(secret string) (*KeySet, error) {
+	if secret == "" {
+		return nil, errPackage.ErrNoSigningKey
+	}
+
+	key := newHMACKey(secret)
+	return &KeySet{active: key, keys: map[string]*SigningKey{}, legacy: key}, nil
+}
+
+// LoadKeySet lee las claves PEM de las rutas indicadas, la primera es la clave activa.
+// Si no hay rutas se firma con HS256 usando hmacSecret. Si hay rutas y hmacSecret no está
+// vacío, se siguen aceptando los tokens HS256 emitidos antes de configurar las claves.
+func LoadKeySet(paths []string, hmacSecret string) (*KeySet, error) {
+	pems := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signing key %s: %w", path, err)
+		}
+		pems = append(pems, data)
+	}
+
+	if len(pems) == 0 {
+		return NewHMACKeySet(hmacSecret)
+	}
+
+	return NewKeySet(pems, hmacSecret)
+}
+
+// NewKeySet crea el conjunto a partir de claves PEM. La primera debe ser una clave privada,
+// las demás pueden ser privadas o públicas y solo se usan para verificar.
+func NewKeySet(pems [][]byte, hmacSecret string) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]*SigningKey, len(pems))}
+
+	for i, data := range pems {
+		key, err := parseSigningKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %d: %w", i+1, err)
+		}
+
+		if i == 0 {
+			if key.signingKey == nil {
+				return nil, fmt.Errorf("signing key 1: %w: the active key must be a private key", errPackage.ErrInvalidSigningKey)
+			}
+			set.active = key
+		}
+		if _, exists := set.keys[key.ID]; !exists {
+			set.keys[key.ID] = key
+			set.ordered = append(set.ordered, key)
+		}
+	}
+
+	if set.active == nil {
+		return nil, errPackage.ErrNoSigningKey
+	}
+	if hmacSecret != "" {
+		set.legacy = newHMACKey(hmacSecret)
+	}
+
+	return set, nil
+}
+
+// Active retorna la clave con la que se firman los tokens nuevos
+func (s *KeySet) Active() *SigningKey {
+	return s.active
+}
+
+// Lookup obtiene la clave de verificación según el kid del token.
+// Los tokens sin kid se verifican con la clave HMAC, si está configurada.
+func (s *KeySet) Lookup(kid string) (*SigningKey, bool) {
+	if kid == "" {
+		return s.legacy, s.legacy != nil
+	}
+
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// JWKS retorna las claves públicas del conjunto, empezando por la activa
+func (s *KeySet) JWKS() *auth.JSONWebKeySet {
+	set := &auth.JSONWebKeySet{Keys: make([]auth.JSONWebKey, 0, len(s.ordered))}
+	for _, key := range s.ordered {
+		set.Keys = append(set.Keys, *key.public)
+	}
+
+	return set
+}
+
+func newHMACKey(secret string) *SigningKey {
+	return &SigningKey{
+		Method:     jwt.SigningMethodHS256,
+		signingKey: []byte(secret),
+		verifyKey:  []byte(secret),
+	}
+}
+
+// parseSigningKey interpreta una clave PEM RSA o EC en cualquiera de los formatos habituales
+func parseSigningKey(data []byte) (*SigningKey, error) {
+	// 1. Decodificar el bloque PEM
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: not a PEM encoded key", errPackage.ErrInvalidSigningKey)
+	}
+
+	// 2. Intentar los formatos de clave privada y luego los de clave pública
+	var private, public interface{}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		private = key
+	} else if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		private = key
+	} else if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		private = key
+	} else if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		public = key
+	} else if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		public = key
+	} else {
+		return nil, fmt.Errorf("%w: unrecognized key format %q", errPackage.ErrInvalidSigningKey, block.Type)
+	}
+
+	switch key := private.(type) {
+	case *rsa.PrivateKey:
+		public = &key.PublicKey
+	case *ecdsa.PrivateKey:
+		public = &key.PublicKey
+	}
+
+	// 3. Construir la clave según su tipo
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("%w: RSA key has %d bits", errPackage.ErrInvalidSigningKey, key.N.BitLen())
+		}
+		return newAsymmetricKey(jwt.SigningMethodRS256, private, key, rsaJWK(key)), nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("%w: EC key must use the P-256 curve", errPackage.ErrInvalidSigningKey)
+		}
+		return newAsymmetricKey(jwt.SigningMethodES256, private, key, ecJWK(key)), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported key type %T", errPackage.ErrInvalidSigningKey, public)
+	}
+}
+
+func newAsymmetricKey(method jwt.SigningMethod, private, public interface{}, jwk *auth.JSONWebKey) *SigningKey {
+	jwk.Kid = thumbprint(jwk)
+	jwk.Use = "sig"
+	jwk.Alg = method.Alg()
+
+	return &SigningKey{
+		ID:         jwk.Kid,
+		Method:     method,
+		signingKey: private,
+		verifyKey:  public,
+		public:     jwk,
+	}
+}
+
+func rsaJWK(key *rsa.PublicKey) *auth.JSONWebKey {
+	return &auth.JSONWebKey{
+		Kty: "RSA",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(key *ecdsa.PublicKey) *auth.JSONWebKey {
+	size := (key.Curve.Params().BitSize + 7) / 8
+	return &auth.JSONWebKey{
+		Kty: "EC",
+		Crv: key.Curve.Params().Name,
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+	}
+}
+
+// thumbprint calcula el kid como el JWK thumbprint de la clave (RFC 7638),
+// así el kid es estable y no hay que configurarlo
+func thumbprint(jwk *auth.JSONWebKey) string {
+	var members interface{}
+	if jwk.Kty == "RSA" {
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	} else {
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{jwk.Crv, jwk.Kty, jwk.X, jwk.Y}
+	}
+
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
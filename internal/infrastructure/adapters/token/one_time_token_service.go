@@ -0,0 +1,90 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const oneTimeTokenKeyPrefix = "one_time_token:"
+
+type OneTimeTokenService struct {
+	cacheService ports.Cacher
+}
+
+func NewOneTimeTokenService(cache ports.Cacher) ports.OneTimeTokenProvider {
+	return &OneTimeTokenService{
+		cacheService: cache,
+	}
+}
+
+// Issue genera un token aleatorio para el sujeto. En caché solo se guarda su hash
+// y se invalida el token emitido anteriormente para el mismo sujeto y propósito.
+func (s *OneTimeTokenService) Issue(_ context.Context, purpose, subject string, ttl time.Duration) (string, error) {
+	// 1. Generar el token
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		logs.Error("Failed to generate one-time token", map[string]interface{}{
+			"purpose": purpose,
+			"error":   err.Error(),
+		})
+		return "", errPackage.NewGeneralServiceError("OneTimeTokenService", "Issue", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	tokenKey := s.tokenKey(purpose, token)
+	subjectKey := s.subjectKey(purpose, subject)
+
+	// 2. Invalidar el token anterior del sujeto
+	if previousKey, err := s.cacheService.Get(subjectKey); err == nil {
+		_ = s.cacheService.Delete(previousKey)
+	}
+
+	// 3. Guardar el token y la referencia del sujeto
+	if err := s.cacheService.Set(tokenKey, []byte(subject), ttl); err != nil {
+		return "", err
+	}
+	if err := s.cacheService.Set(subjectKey, []byte(tokenKey), ttl); err != nil {
+		_ = s.cacheService.Delete(tokenKey)
+		return "", err
+	}
+
+	return token, nil
+}
+
+// Consume valida el token y lo elimina para que no pueda volver a usarse
+func (s *OneTimeTokenService) Consume(_ context.Context, purpose, token string) (string, error) {
+	if token == "" {
+		return "", errPackage.NewGeneralServiceError("OneTimeTokenService", "Consume", errPackage.ErrInvalidAccountToken)
+	}
+
+	// 1. Obtener el sujeto asociado al token
+	tokenKey := s.tokenKey(purpose, token)
+	subject, err := s.cacheService.Get(tokenKey)
+	if err != nil {
+		return "", errPackage.NewGeneralServiceError("OneTimeTokenService", "Consume", errPackage.ErrInvalidAccountToken)
+	}
+
+	// 2. Eliminar el token y la referencia del sujeto
+	if err = s.cacheService.Delete(tokenKey); err != nil {
+		return "", err
+	}
+	_ = s.cacheService.Delete(s.subjectKey(purpose, subject))
+
+	return subject, nil
+}
+
+func (s *OneTimeTokenService) tokenKey(purpose, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return oneTimeTokenKeyPrefix + purpose + ":" + hex.EncodeToString(sum[:])
+}
+
+func (s *OneTimeTokenService) subjectKey(purpose, subject string) string {
+	return oneTimeTokenKeyPrefix + purpose + ":subject:" + subject
+}
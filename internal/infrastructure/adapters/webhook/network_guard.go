@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// isPublicIP indica si la IP es enrutable en internet. Se rechazan las direcciones de loopback, privadas,
+// link-local (incluye 169.254.169.254), multicast y no especificadas para que un webhook no pueda
+// usarse para llamar servicios internos.
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// checkPublicHost rechaza los hosts locales y las IPs no públicas. Los nombres que no se pueden resolver
+// se aceptan porque la conexión vuelve a validar la IP resuelta.
+func checkPublicHost(ctx context.Context, host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errPackage.ErrWebhookURLNotAllowed
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublicIP(ip) {
+			return errPackage.ErrWebhookURLNotAllowed
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return errPackage.ErrWebhookURLNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// guardedDialControl valida la IP ya resuelta justo antes de conectar, así un cambio de DNS posterior
+// a la creación del webhook no permite alcanzar direcciones internas
+func guardedDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return errPackage.ErrWebhookURLNotAllowed
+	}
+
+	return nil
+}
+
+// newGuardedTransport crea un transporte que solo conecta con IPs públicas. No usa proxy porque la
+// conexión al proxy evitaría la validación de la IP de destino.
+func newGuardedTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   guardedDialControl,
+	}).DialContext
+
+	return transport
+}
@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers enviados en cada entrega
+const (
+	SignatureHeader  = "X-Delivery-Signature"
+	EventHeader      = "X-Delivery-Event"
+	EventIDHeader    = "X-Delivery-Event-Id"
+	DeliveryIDHeader = "X-Delivery-Id"
+)
+
+// Sign calcula la firma de una entrega con el formato t=<unix>,v1=<hex>.
+// Se firma "<timestamp>.<body>" con HMAC-SHA256 para que el receptor pueda rechazar reenvíos antiguos.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := timestamp.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", unix, computeSignature(secret, unix, body))
+}
+
+// VerifySignature valida el header de firma de una entrega y que no sea más antigua que tolerance
+func VerifySignature(secret, header string, body []byte, tolerance time.Duration, now time.Time) bool {
+	// 1. Obtener el timestamp y la firma del header
+	var unix int64
+	var signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return false
+			}
+			unix = parsed
+		case "v1":
+			signature = value
+		}
+	}
+
+	if unix == 0 || signature == "" {
+		return false
+	}
+
+	// 2. Verificar la antigüedad de la entrega
+	if tolerance > 0 && now.Sub(time.Unix(unix, 0)) > tolerance {
+		return false
+	}
+
+	// 3. Comparar la firma en tiempo constante
+	expected := computeSignature(secret, unix, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func computeSignature(secret string, unix int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(unix, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,472 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	secretPrefix = "whsec_"
+	secretBytes  = 32
+
+	defaultMaxAttempts = 8
+	defaultRetryBase   = 30 * time.Second
+	defaultTimeout     = 10 * time.Second
+	maxRetryDelay      = 6 * time.Hour
+
+	// dueBatchSize es la cantidad de entregas vencidas que se procesan en cada ejecución del worker
+	dueBatchSize = 100
+	// deliveryListLimit es la cantidad de entregas que se muestran por suscripción
+	deliveryListLimit = 100
+	// maxErrorLength limita el error guardado en el registro de intentos
+	maxErrorLength = 500
+	// maxResponseBody limita lo que se lee de la respuesta del receptor
+	maxResponseBody = 4 << 10
+)
+
+type webhookService struct {
+	repo                 domainPorts.WebhookRepository
+	client               *http.Client
+	maxAttempts          int
+	retryBase            time.Duration
+	allowPrivateNetworks bool
+}
+
+// WebhookService publica los eventos de pedidos como entregas de webhooks y las envía con reintentos
+type WebhookService interface {
+	ports.WebhookManager
+	PublishOrderEvent(ctx context.Context, event *entities.OrderEvent) error
+}
+
+// NewWebhookService crea el servicio de webhooks. Salvo que allowPrivateNetworks esté activo, el cliente
+// solo conecta con IPs públicas. Las redirecciones nunca se siguen.
+func NewWebhookService(repo domainPorts.WebhookRepository, client *http.Client, maxAttempts int, retryBase time.Duration, allowPrivateNetworks bool) WebhookService {
+	if client == nil {
+		client = &http.Client{}
+	}
+	if client.Timeout <= 0 {
+		client.Timeout = defaultTimeout
+	}
+	if !allowPrivateNetworks {
+		client.Transport = newGuardedTransport()
+	}
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if retryBase <= 0 {
+		retryBase = defaultRetryBase
+	}
+
+	return &webhookService{
+		repo:                 repo,
+		client:               client,
+		maxAttempts:          maxAttempts,
+		retryBase:            retryBase,
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// CreateSubscription valida la URL y los eventos y genera el secreto de firma.
+// El secreto se guarda en claro porque se necesita para firmar cada entrega.
+func (s *webhookService) CreateSubscription(ctx context.Context, input *entities.WebhookSubscriptionInput) (*entities.IssuedWebhookSubscription, error) {
+	// 1. Validar la URL y los eventos
+	if err := s.validateURL(ctx, input.URL); err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "CreateSubscription", err)
+	}
+
+	events, err := normalizeEvents(input.Events)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "CreateSubscription", err)
+	}
+
+	// 2. Generar el secreto
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "CreateSubscription", err)
+	}
+	secret := secretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	// 3. Guardar la suscripción
+	subscription := &entities.WebhookSubscription{
+		ID:        uuid.NewString(),
+		CompanyID: input.CompanyID,
+		URL:       input.URL,
+		Secret:    secret,
+		Events:    strings.Join(events, ","),
+		IsActive:  true,
+		CreatedBy: input.CreatedBy,
+	}
+	if err := s.repo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "CreateSubscription", err)
+	}
+
+	logs.Info("Webhook subscription created", map[string]interface{}{
+		"subscription_id": subscription.ID,
+		"company_id":      subscription.CompanyID,
+		"events":          subscription.Events,
+	})
+
+	return &entities.IssuedWebhookSubscription{Subscription: subscription, Secret: secret}, nil
+}
+
+func (s *webhookService) ListSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error) {
+	subscriptions, err := s.repo.ListSubscriptionsByCompany(ctx, companyID)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "ListSubscriptions", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *webhookService) GetSubscription(ctx context.Context, subscriptionID string) (*entities.WebhookSubscription, error) {
+	subscription, err := s.repo.GetSubscriptionByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, notFoundOr(err, errPackage.ErrWebhookNotFound, "GetSubscription")
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	if err := s.repo.DeleteSubscription(ctx, subscriptionID); err != nil {
+		return notFoundOr(err, errPackage.ErrWebhookNotFound, "DeleteSubscription")
+	}
+
+	logs.Info("Webhook subscription deleted", map[string]interface{}{
+		"subscription_id": subscriptionID,
+	})
+
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, subscriptionID string) ([]entities.WebhookDelivery, error) {
+	deliveries, err := s.repo.ListDeliveriesBySubscription(ctx, subscriptionID, deliveryListLimit)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "ListDeliveries", err)
+	}
+
+	return deliveries, nil
+}
+
+func (s *webhookService) GetDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	delivery, err := s.repo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, notFoundOr(err, errPackage.ErrWebhookDeliveryNotFound, "GetDelivery")
+	}
+
+	return delivery, nil
+}
+
+// ReplayDelivery crea una nueva entrega con el mismo evento y la envía de inmediato.
+// Conserva el ID del evento para que el receptor pueda descartar duplicados.
+func (s *webhookService) ReplayDelivery(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	// 1. Obtener la entrega original
+	original, err := s.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Crear la nueva entrega
+	replay := entities.WebhookDelivery{
+		ID:             uuid.NewString(),
+		SubscriptionID: original.SubscriptionID,
+		CompanyID:      original.CompanyID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         constants.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+		ReplayOf:       &original.ID,
+	}
+	if err := s.repo.CreateDeliveries(ctx, []entities.WebhookDelivery{replay}); err != nil {
+		return nil, errPackage.NewGeneralServiceError("WebhookService", "ReplayDelivery", err)
+	}
+
+	logs.Info("Webhook delivery replayed", map[string]interface{}{
+		"delivery_id": replay.ID,
+		"replay_of":   original.ID,
+	})
+
+	// 3. Enviar sin esperar la respuesta del receptor
+	go s.deliverAll([]string{replay.ID})
+
+	return &replay, nil
+}
+
+// PublishOrderEvent crea una entrega por cada suscripción de la empresa interesada en el evento
+func (s *webhookService) PublishOrderEvent(ctx context.Context, event *entities.OrderEvent) error {
+	// 1. Obtener las suscripciones activas de la empresa
+	if event.CompanyID == "" {
+		return nil
+	}
+
+	subscriptions, err := s.repo.GetActiveSubscriptions(ctx, event.CompanyID)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("WebhookService", "PublishOrderEvent", err)
+	}
+
+	// 2. Omitir las suscripciones que ya recibieron el evento, el relay puede publicarlo más de una vez
+	existing, err := s.repo.GetSubscriptionIDsForEvent(ctx, event.ID)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("WebhookService", "PublishOrderEvent", err)
+	}
+	alreadyCreated := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		alreadyCreated[id] = true
+	}
+
+	// 3. Construir el cuerpo una sola vez, todas las entregas del evento llevan el mismo
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("WebhookService", "PublishOrderEvent", err)
+	}
+
+	now := time.Now()
+	deliveries := make([]entities.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event.Type) || alreadyCreated[subscription.ID] {
+			continue
+		}
+
+		deliveries = append(deliveries, entities.WebhookDelivery{
+			ID:             uuid.NewString(),
+			SubscriptionID: subscription.ID,
+			CompanyID:      event.CompanyID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        string(payload),
+			Status:         constants.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	// 4. Guardar las entregas antes de enviarlas, si el envío falla el worker las reintenta
+	if err := s.repo.CreateDeliveries(ctx, deliveries); err != nil {
+		return errPackage.NewGeneralServiceError("WebhookService", "PublishOrderEvent", err)
+	}
+
+	ids := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.ID)
+	}
+	go s.deliverAll(ids)
+
+	return nil
+}
+
+// ProcessDueDeliveries envía las entregas pendientes cuyo siguiente intento ya venció
+func (s *webhookService) ProcessDueDeliveries(ctx context.Context) (int, error) {
+	ids, err := s.repo.GetDueDeliveryIDs(ctx, time.Now(), dueBatchSize)
+	if err != nil {
+		return 0, errPackage.NewGeneralServiceError("WebhookService", "ProcessDueDeliveries", err)
+	}
+
+	sent := 0
+	for _, id := range ids {
+		if s.deliver(ctx, id) {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+func (s *webhookService) deliverAll(ids []string) {
+	for _, id := range ids {
+		s.deliver(context.Background(), id)
+	}
+}
+
+// deliver reserva la entrega, la envía y registra el intento. Retorna true si se realizó un intento.
+func (s *webhookService) deliver(ctx context.Context, deliveryID string) bool {
+	// 1. Reservar la entrega, otro proceso pudo haberla tomado
+	now := time.Now()
+	delivery, err := s.repo.ClaimDelivery(ctx, deliveryID, now, now.Add(s.leaseDuration()))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Error("Failed to claim webhook delivery", map[string]interface{}{
+				"delivery_id": deliveryID,
+				"error":       err.Error(),
+			})
+		}
+		return false
+	}
+
+	// 2. Enviar la petición firmada
+	statusCode, sendErr := s.send(ctx, delivery)
+	finishedAt := time.Now()
+
+	// 3. Calcular el nuevo estado de la entrega
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = ""
+	if sendErr == nil {
+		delivery.Status = constants.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &finishedAt
+	} else {
+		delivery.LastError = truncate(sendErr.Error(), maxErrorLength)
+		if delivery.Attempts >= s.maxAttempts {
+			delivery.Status = constants.WebhookDeliveryFailed
+		} else {
+			delivery.NextAttemptAt = finishedAt.Add(s.retryDelay(delivery.Attempts))
+		}
+	}
+
+	// 4. Registrar el intento
+	attempt := &entities.WebhookDeliveryAttempt{
+		ID:            uuid.NewString(),
+		DeliveryID:    delivery.ID,
+		AttemptNumber: delivery.Attempts,
+		StatusCode:    statusCode,
+		Error:         delivery.LastError,
+		DurationMs:    finishedAt.Sub(now).Milliseconds(),
+		AttemptedAt:   now,
+	}
+	if err := s.repo.RecordAttempt(ctx, delivery, attempt); err != nil {
+		logs.Error("Failed to record webhook attempt", map[string]interface{}{
+			"delivery_id": delivery.ID,
+			"error":       err.Error(),
+		})
+	}
+
+	if sendErr != nil {
+		logs.Warn("Webhook delivery attempt failed", map[string]interface{}{
+			"delivery_id": delivery.ID,
+			"attempt":     delivery.Attempts,
+			"status":      delivery.Status,
+			"error":       delivery.LastError,
+		})
+	}
+
+	return true
+}
+
+// send realiza la petición HTTP, cualquier respuesta fuera del rango 2xx se considera un fallo
+func (s *webhookService) send(ctx context.Context, delivery *entities.WebhookDelivery) (int, error) {
+	if delivery.Subscription == nil || !delivery.Subscription.IsActive {
+		return 0, fmt.Errorf("subscription is not active")
+	}
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(EventIDHeader, delivery.EventID)
+	req.Header.Set(DeliveryIDHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(delivery.Subscription.Secret, time.Now(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// retryDelay duplica la espera con cada intento fallido: base, 2*base, 4*base... hasta maxRetryDelay
+func (s *webhookService) retryDelay(attempts int) time.Duration {
+	delay := s.retryBase
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	return delay
+}
+
+// leaseDuration es el tiempo que una entrega queda reservada mientras se envía
+func (s *webhookService) leaseDuration() time.Duration {
+	return 2 * s.client.Timeout
+}
+
+// validateURL verifica que la URL sea http o https absoluta y que no apunte a una dirección interna
+func (s *webhookService) validateURL(ctx context.Context, raw string) error {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errPackage.ErrInvalidWebhookURL
+	}
+
+	if s.allowPrivateNetworks {
+		return nil
+	}
+
+	return checkPublicHost(ctx, parsed.Hostname())
+}
+
+// normalizeEvents elimina duplicados y verifica que todos los eventos existan
+func normalizeEvents(events []string) ([]string, error) {
+	unique := make(map[string]bool, len(events))
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if !constants.ValidOrderEvents[event] {
+			return nil, errPackage.ErrInvalidWebhookEvents
+		}
+		unique[event] = true
+	}
+
+	if len(unique) == 0 {
+		return nil, errPackage.ErrInvalidWebhookEvents
+	}
+
+	result := make([]string, 0, len(unique))
+	for event := range unique {
+		result = append(result, event)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+func notFoundOr(err, notFound error, operation string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errPackage.NewGeneralServiceError("WebhookService", operation, notFound)
+	}
+	return errPackage.NewGeneralServiceError("WebhookService", operation, err)
+}
+
+func truncate(value string, max int) string {
+	if len(value) <= max {
+		return value
+	}
+	return value[:max]
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// APIKeyCreateRequest representa la creación de una API key de integración
+type APIKeyCreateRequest struct {
+	// Nombre descriptivo de la integración
+	Name string `json:"name" example:"ERP" validate:"required"`
+	// Sucursal a la que se asignan los pedidos creados con la key
+	BranchID string `json:"branch_id" validate:"required"`
+	// Permisos concedidos: orders:create, orders:read, tracking:read
+	Scopes []string `json:"scopes" example:"orders:create,orders:read" validate:"required"`
+	// Fecha de expiración opcional
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Empresa de la key, solo la pueden indicar los administradores
+	CompanyID string `json:"company_id,omitempty"`
+}
+
+// APIKeyResponse describe una API key sin su secreto
+type APIKeyResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name" example:"ERP"`
+	// Prefijo de la key para identificarla
+	Prefix     string     `json:"prefix" example:"dlv_1a2b3c4d"`
+	CompanyID  string     `json:"company_id"`
+	BranchID   string     `json:"branch_id"`
+	Scopes     []string   `json:"scopes" example:"orders:create,orders:read"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// IssuedAPIKeyResponse contiene la key en claro, solo se muestra al crearla o rotarla
+type IssuedAPIKeyResponse struct {
+	APIKeyResponse
+	// Key completa, se envía en el header X-API-Key
+	Key string `json:"key" example:"dlv_1a2b3c4d_..."`
+}
+
+func NewAPIKeyResponse(key *entities.CompanyAPIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		CompanyID:  key.CompanyID,
+		BranchID:   key.BranchID,
+		Scopes:     key.ScopeList(),
+		LastUsedAt: key.LastUsedAt,
+		ExpiresAt:  key.ExpiresAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func NewAPIKeyListResponse(keys []entities.CompanyAPIKey) []APIKeyResponse {
+	response := make([]APIKeyResponse, 0, len(keys))
+	for i := range keys {
+		response = append(response, NewAPIKeyResponse(&keys[i]))
+	}
+
+	return response
+}
+
+func NewIssuedAPIKeyResponse(issued *auth.IssuedAPIKey) IssuedAPIKeyResponse {
+	return IssuedAPIKeyResponse{
+		APIKeyResponse: NewAPIKeyResponse(issued.Key),
+		Key:            issued.PlainKey,
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLogResponse describe un cambio registrado en la auditoría
+type AuditLogResponse struct {
+	ID         string `json:"id"`
+	UserID     string `json:"user_id,omitempty"`
+	UserName   string `json:"user_name,omitempty" example:"John Doe"`
+	UserEmail  string `json:"user_email,omitempty" example:"example@example.com"`
+	APIKeyID   string `json:"api_key_id,omitempty"`
+	CompanyID  string `json:"company_id,omitempty"`
+	Action     string `json:"action" example:"update"`
+	EntityType string `json:"entity_type" example:"order"`
+	EntityID   string `json:"entity_id"`
+	// Estado de la entidad antes del cambio, null en las creaciones
+	OldValues json.RawMessage `json:"old_values" swaggertype:"object"`
+	// Estado de la entidad después del cambio
+	NewValues json.RawMessage `json:"new_values" swaggertype:"object"`
+	IPAddress string          `json:"ip_address" example:"203.0.113.10"`
+	UserAgent string          `json:"user_agent"`
+	CreatedAt time.Time       `json:"created_at"`
+}
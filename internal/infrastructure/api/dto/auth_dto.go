@@ -6,6 +6,7 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
 	"io"
+	"time"
 )
 
 type LoginRequest struct {
@@ -17,10 +18,72 @@ type LoginRequest struct {
 	DeviceInfo map[string]interface{} `json:"device_info,omitempty"`
 }
 
-// LoginResponse representa la estructura de la respuesta de login
+// LoginResponse representa la estructura de la respuesta de login y de refresh
 type LoginResponse struct {
-	// JWT Token
+	// JWT access token de corta duración
 	Token string `json:"token"`
+	// Fecha de expiración del access token
+	ExpiresAt time.Time `json:"expires_at"`
+	// Refresh token rotativo, se invalida al usarse
+	RefreshToken string `json:"refresh_token"`
+	// Fecha de expiración del refresh token
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	// Códigos de recuperación, solo se envían al completar la inscripción MFA durante el login
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// RefreshRequest representa la solicitud para renovar el access token
+type RefreshRequest struct {
+	// Refresh token obtenido en el login o en el último refresh
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// EmailRequest representa una solicitud que solo requiere el correo del usuario
+type EmailRequest struct {
+	// Correo del usuario
+	Email string `json:"email" example:"user@example.com" validate:"required,email"`
+}
+
+// ResetPasswordRequest representa la solicitud para establecer una nueva contraseña
+type ResetPasswordRequest struct {
+	// Token recibido por correo
+	Token string `json:"token" validate:"required"`
+	// Nueva contraseña
+	Password string `json:"password" example:"N3wP@ssword" validate:"required"`
+}
+
+// TokenRequest representa una solicitud que solo requiere un token recibido por correo
+type TokenRequest struct {
+	// Token recibido por correo
+	Token string `json:"token" validate:"required"`
+}
+
+// SessionResponse representa una sesión activa del usuario
+type SessionResponse struct {
+	// ID de la sesión
+	ID string `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	// Información del dispositivo enviada al iniciar sesión
+	DeviceInfo map[string]interface{} `json:"device_info,omitempty"`
+	// Dirección IP desde la que se inició la sesión
+	IPAddress string `json:"ip_address" example:"200.43.52.1"`
+	// Última actividad registrada
+	LastActivity time.Time `json:"last_activity"`
+	// Fecha de expiración de la sesión
+	ExpiresAt time.Time `json:"expires_at"`
+	// Fecha de creación de la sesión
+	CreatedAt time.Time `json:"created_at"`
+	// Indica si es la sesión del token usado en la petición
+	Current bool `json:"current"`
+}
+
+// NewLoginResponse construye la respuesta a partir del par de tokens emitido
+func NewLoginResponse(tokens *auth.TokenPair) LoginResponse {
+	return LoginResponse{
+		Token:            tokens.AccessToken,
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	}
 }
 
 func NewLoginRequest(body io.ReadCloser) (*LoginRequest, error) {
@@ -0,0 +1,176 @@
+package dto
+
+import (
+	"time"
+)
+
+// DriverCreateRequest representa la solicitud para registrar el perfil de un repartidor
+type DriverCreateRequest struct {
+	// ID del usuario con rol DRIVER al que se asocia el perfil
+	UserID string `json:"user_id" example:"d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a" binding:"required"`
+
+	// Número de licencia de conducir
+	LicenseNumber string `json:"license_number" example:"LIC-0012345" binding:"required"`
+
+	// Fecha de vencimiento de la licencia
+	LicenseExpiry time.Time `json:"license_expiry" example:"2027-12-31T00:00:00Z" binding:"required" format:"date-time"`
+
+	// Tipo de vehículo (BICYCLE, MOTORCYCLE, CAR, VAN, TRUCK)
+	VehicleType string `json:"vehicle_type" example:"MOTORCYCLE" binding:"required" enums:"BICYCLE,MOTORCYCLE,CAR,VAN,TRUCK"`
+
+	// Placa del vehículo
+	VehiclePlate string `json:"vehicle_plate" example:"ABC123" binding:"required"`
+
+	// Modelo del vehículo
+	VehicleModel string `json:"vehicle_model" example:"Yamaha NMAX 2023" binding:"required"`
+
+	// Color del vehículo
+	VehicleColor string `json:"vehicle_color" example:"Negro" binding:"required"`
+
+	// Detalles adicionales del vehículo (opcional)
+	VehicleDetails map[string]interface{} `json:"vehicle_details,omitempty"`
+
+	// Documentación del repartidor (opcional)
+	Documentation map[string]interface{} `json:"documentation,omitempty"`
+}
+
+// DriverUpdateRequest representa la solicitud para actualizar los datos de licencia y vehículo
+type DriverUpdateRequest struct {
+	// Número de licencia de conducir
+	LicenseNumber string `json:"license_number,omitempty" example:"LIC-0012345"`
+
+	// Fecha de vencimiento de la licencia
+	LicenseExpiry *time.Time `json:"license_expiry,omitempty" example:"2027-12-31T00:00:00Z" format:"date-time"`
+
+	// Tipo de vehículo (BICYCLE, MOTORCYCLE, CAR, VAN, TRUCK)
+	VehicleType string `json:"vehicle_type,omitempty" example:"MOTORCYCLE" enums:"BICYCLE,MOTORCYCLE,CAR,VAN,TRUCK"`
+
+	// Placa del vehículo
+	VehiclePlate string `json:"vehicle_plate,omitempty" example:"ABC123"`
+
+	// Modelo del vehículo
+	VehicleModel string `json:"vehicle_model,omitempty" example:"Yamaha NMAX 2023"`
+
+	// Color del vehículo
+	VehicleColor string `json:"vehicle_color,omitempty" example:"Negro"`
+
+	// Detalles adicionales del vehículo
+	VehicleDetails map[string]interface{} `json:"vehicle_details,omitempty"`
+
+	// Documentación del repartidor
+	Documentation map[string]interface{} `json:"documentation,omitempty"`
+}
+
+// DriverZonesAssignRequest representa la solicitud para asignar zonas a un repartidor
+type DriverZonesAssignRequest struct {
+	// ID de la zona primaria
+	PrimaryZoneID string `json:"primary_zone_id" example:"f8c3e8d7-b6a5-4d3c-9f1e-0a2b4c6d8e0f" binding:"required"`
+
+	// IDs de las zonas secundarias
+	SecondaryZoneIDs []string `json:"secondary_zone_ids,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+}
+
+// DriverZoneResponse representa una zona asignada a un repartidor
+type DriverZoneResponse struct {
+	// ID de la zona
+	ZoneID string `json:"zone_id" example:"f8c3e8d7-b6a5-4d3c-9f1e-0a2b4c6d8e0f"`
+
+	// Nombre de la zona
+	ZoneName string `json:"zone_name,omitempty" example:"Zona Norte"`
+
+	// Indica si es la zona primaria del repartidor
+	IsPrimary bool `json:"is_primary" example:"true"`
+
+	// Calificación de eficiencia en la zona
+	EfficiencyRating float64 `json:"efficiency_rating" example:"4.8"`
+
+	// Entregas completadas en la zona
+	DeliveriesCompleted int `json:"deliveries_completed" example:"120"`
+}
+
+// DriverResponse representa la respuesta con información de un repartidor
+type DriverResponse struct {
+	// ID del usuario repartidor
+	UserID string `json:"user_id" example:"d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a"`
+
+	// Nombre completo del repartidor
+	FullName string `json:"full_name,omitempty" example:"Carlos Pérez"`
+
+	// Email del repartidor
+	Email string `json:"email,omitempty" example:"carlos@expressdelivery.com"`
+
+	// Teléfono del repartidor
+	Phone string `json:"phone,omitempty" example:"+573001112233"`
+
+	// Número de licencia de conducir
+	LicenseNumber string `json:"license_number" example:"LIC-0012345"`
+
+	// Fecha de vencimiento de la licencia
+	LicenseExpiry time.Time `json:"license_expiry" format:"date-time"`
+
+	// Tipo de vehículo
+	VehicleType string `json:"vehicle_type" example:"MOTORCYCLE"`
+
+	// Placa del vehículo
+	VehiclePlate string `json:"vehicle_plate" example:"ABC123"`
+
+	// Modelo del vehículo
+	VehicleModel string `json:"vehicle_model" example:"Yamaha NMAX 2023"`
+
+	// Color del vehículo
+	VehicleColor string `json:"vehicle_color" example:"Negro"`
+
+	// Detalles adicionales del vehículo en formato JSON
+	VehicleDetails string `json:"vehicle_details,omitempty" example:"{\"cargo_box\":true}"`
+
+	// Documentación del repartidor en formato JSON
+	Documentation string `json:"documentation,omitempty" example:"{\"soat\":\"2026-10-01\"}"`
+
+	// Indica si el repartidor está activo
+	IsActive bool `json:"is_active" example:"true"`
+
+	// Calificación del repartidor
+	Rating float64 `json:"rating" example:"4.9"`
+
+	// Entregas completadas
+	CompletedDeliveries int `json:"completed_deliveries" example:"350"`
+
+	// Última entrega realizada
+	LastDelivery *time.Time `json:"last_delivery,omitempty" format:"date-time"`
+
+	// Zonas asignadas al repartidor
+	Zones []DriverZoneResponse `json:"zones"`
+
+	// Cuando se creó el perfil
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+
+	// Última actualización del perfil
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
+
+// DriverListResponse representa un item en la lista de repartidores
+type DriverListResponse struct {
+	// ID del usuario repartidor
+	UserID string `json:"user_id" example:"d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a"`
+
+	// Nombre completo del repartidor
+	FullName string `json:"full_name" example:"Carlos Pérez"`
+
+	// Tipo de vehículo
+	VehicleType string `json:"vehicle_type" example:"MOTORCYCLE"`
+
+	// Placa del vehículo
+	VehiclePlate string `json:"vehicle_plate" example:"ABC123"`
+
+	// Nombre de la zona primaria
+	PrimaryZoneName string `json:"primary_zone_name,omitempty" example:"Zona Norte"`
+
+	// Indica si el repartidor está activo
+	IsActive bool `json:"is_active" example:"true"`
+
+	// Calificación del repartidor
+	Rating float64 `json:"rating" example:"4.9"`
+
+	// Cuando se creó el perfil
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+)
+
+// MFAChallengeResponse se devuelve en el login cuando el usuario debe completar el segundo paso
+type MFAChallengeResponse struct {
+	// Siempre true, permite al cliente distinguir esta respuesta de LoginResponse
+	MFARequired bool `json:"mfa_required" example:"true"`
+	// Token del reto, se envía junto al código TOTP
+	ChallengeToken string `json:"challenge_token"`
+	// Fecha de expiración del reto
+	ExpiresAt time.Time `json:"expires_at"`
+	// Indica que el rol exige MFA y el usuario debe inscribirse antes de continuar
+	EnrollmentRequired bool `json:"enrollment_required" example:"false"`
+}
+
+// MFAVerifyRequest representa el segundo paso del login
+type MFAVerifyRequest struct {
+	// Token del reto obtenido en el login
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	// Código TOTP de 6 dígitos o código de recuperación
+	Code string `json:"code" example:"123456" validate:"required"`
+}
+
+// MFAChallengeTokenRequest representa una solicitud que solo requiere el token del reto
+type MFAChallengeTokenRequest struct {
+	// Token del reto obtenido en el login
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+// MFACodeRequest representa una solicitud que requiere un código MFA del usuario autenticado
+type MFACodeRequest struct {
+	// Código TOTP de 6 dígitos o código de recuperación
+	Code string `json:"code" example:"123456" validate:"required"`
+}
+
+// MFAEnrollmentResponse contiene el secreto TOTP para registrar en la aplicación autenticadora
+type MFAEnrollmentResponse struct {
+	// Secreto en base32 para ingresarlo manualmente
+	Secret string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	// URI otpauth:// para generar el código QR
+	OTPAuthURL string `json:"otpauth_url" example:"otpauth://totp/Delivery%20Backend:user@example.com?secret=JBSWY3DPEHPK3PXP"`
+}
+
+// MFAStatusResponse describe el estado del MFA del usuario autenticado
+type MFAStatusResponse struct {
+	// Indica si el MFA está activo
+	Enabled bool `json:"enabled" example:"true"`
+	// Indica si el rol del usuario exige MFA
+	Required bool `json:"required" example:"true"`
+	// Códigos de recuperación sin usar
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining" example:"10"`
+}
+
+// RecoveryCodesResponse contiene los códigos de recuperación, solo se muestran una vez
+type RecoveryCodesResponse struct {
+	// Códigos de recuperación de un solo uso
+	RecoveryCodes []string `json:"recovery_codes" example:"ABCD-EFGH,IJKL-MNOP"`
+}
+
+func NewMFAChallengeResponse(challenge *auth.MFAChallenge) MFAChallengeResponse {
+	return MFAChallengeResponse{
+		MFARequired:        true,
+		ChallengeToken:     challenge.Token,
+		ExpiresAt:          challenge.ExpiresAt,
+		EnrollmentRequired: challenge.EnrollmentRequired,
+	}
+}
+
+func NewMFAEnrollmentResponse(enrollment *auth.MFAEnrollment) MFAEnrollmentResponse {
+	return MFAEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OTPAuthURL: enrollment.ProvisioningURI,
+	}
+}
+
+func NewMFAStatusResponse(status *auth.MFAStatus) MFAStatusResponse {
+	return MFAStatusResponse{
+		Enabled:                status.Enabled,
+		Required:               status.Required,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	}
+}
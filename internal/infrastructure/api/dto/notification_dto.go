@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NotificationResponse describe una notificación de la bandeja del usuario
+type NotificationResponse struct {
+	ID      string `json:"id"`
+	Type    string `json:"type" example:"ORDER_STATUS_CHANGED"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	// Datos relacionados, por ejemplo el pedido
+	Metadata  json.RawMessage `json:"metadata" swaggertype:"object"`
+	IsRead    bool            `json:"is_read"`
+	ReadAt    *time.Time      `json:"read_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// UnreadNotificationsResponse es el total de notificaciones no leídas del usuario
+type UnreadNotificationsResponse struct {
+	UnreadCount int64 `json:"unread_count" example:"3"`
+}
+
+// MarkAllNotificationsReadResponse es la cantidad de notificaciones que se marcaron como leídas
+type MarkAllNotificationsReadResponse struct {
+	Updated int64 `json:"updated" example:"3"`
+}
+
+// NotificationDeviceRequest son los datos para registrar o refrescar un dispositivo push
+type NotificationDeviceRequest struct {
+	// Token entregado por FCM o APNs a la app
+	DeviceToken string `json:"device_token" validate:"required"`
+	// Tipo de dispositivo: ANDROID, IOS o WEB. Al refrescar es opcional
+	DeviceType string `json:"device_type" example:"ANDROID"`
+}
+
+// NotificationDeviceResponse describe un dispositivo registrado para recibir notificaciones push
+type NotificationDeviceResponse struct {
+	ID         string     `json:"id"`
+	DeviceType string     `json:"device_type" example:"ANDROID"`
+	IsActive   bool       `json:"is_active"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// NotificationPreferenceItem son los canales habilitados para un tipo de notificación
+type NotificationPreferenceItem struct {
+	NotificationType string `json:"notification_type" validate:"required" example:"ORDER_STATUS_CHANGED"`
+	EmailEnabled     bool   `json:"email_enabled"`
+	PushEnabled      bool   `json:"push_enabled"`
+	SMSEnabled       bool   `json:"sms_enabled"`
+}
+
+// NotificationPreferenceRequest son las preferencias a guardar, los tipos que no se indican no cambian
+type NotificationPreferenceRequest struct {
+	Preferences []NotificationPreferenceItem `json:"preferences" validate:"required"`
+}
+
+// NotificationPreferenceResponse son los canales efectivos de un tipo de notificación
+type NotificationPreferenceResponse struct {
+	NotificationPreferenceItem
+	// Origen de los valores: USER, COMPANY o DEFAULT
+	Source string `json:"source" example:"COMPANY"`
+}
@@ -17,16 +17,6 @@ type OrderCreateRequest struct {
 	// @required
 	ClientID string `json:"client_id" example:"c7d8e9f0-3f4a-5c6b-7d8e-9f0a1b2c3d4e" binding:"required"`
 
-	// Price of the delivery in local currency
-	// @minimum 0
-	// @required
-	Price float64 `json:"price" example:"25.50" binding:"required,min=0"`
-
-	// Distance of the delivery in kilometers
-	// @minimum 0
-	// @required
-	Distance float64 `json:"distance" example:"7.2" binding:"required,min=0"`
-
 	// Scheduled pickup time
 	// @required
 	PickupTime time.Time `json:"pickup_time" example:"2023-05-15T14:30:00Z" binding:"required" format:"date-time"`
@@ -71,9 +61,80 @@ func (o *OrderCreateRequest) Validate() error {
 		return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrClientIDRequired)
 	}
 
+	return o.DeliveryAddress.Validate()
+}
+
+// OrderQuoteRequest represents the request body for quoting an order before creating it
+// @Description Request structure for calculating the delivery price of an order
+type OrderQuoteRequest struct {
+	// Unique identifier of the company pickup location
+	// @required
+	CompanyPickUpID string `json:"company_pickup_id" example:"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11" binding:"required"`
+
+	// Details about the package being delivered
+	// @required
+	PackageDetails PackageDetailRequest `json:"package_details" binding:"required"`
+
+	// Latitude coordinate of the destination
+	// @required
+	DeliveryLatitude float64 `json:"delivery_latitude" example:"4.6584" binding:"required,latitude"`
+
+	// Longitude coordinate of the destination
+	// @required
+	DeliveryLongitude float64 `json:"delivery_longitude" example:"-74.0937" binding:"required,longitude"`
+}
+
+func (o *OrderQuoteRequest) Validate() error {
+	if o.CompanyPickUpID == "" {
+		return infraErr.NewGeneralServiceError("OrderQuoteDTO", "Validate", domainErr.ErrCompanyPickUpIDRequired)
+	}
+
+	if !validCoordinates(o.DeliveryLatitude, o.DeliveryLongitude) {
+		return infraErr.NewGeneralServiceError("OrderQuoteDTO", "Validate", domainErr.ErrInvalidDeliveryLocation)
+	}
+
 	return nil
 }
 
+// PriceQuoteResponse represents the calculated price of an order
+// @Description Delivery price with its itemized breakdown
+type PriceQuoteResponse struct {
+	// Zone used for the base rate
+	ZoneID string `json:"zone_id" example:"f8c3e8d7-b6a5-4d3c-9f1e-0a2b4c6d8e0f"`
+
+	// Distance between pickup and delivery in kilometers
+	DistanceKm float64 `json:"distance_km" example:"7.2"`
+
+	// Greater of actual and volumetric weight in kilograms
+	BillableWeight float64 `json:"billable_weight" example:"2.5"`
+
+	// Demand multiplier applied by the zone coverage
+	SurgeMultiplier float64 `json:"surge_multiplier" example:"1.5"`
+
+	// Currency of the amounts
+	Currency string `json:"currency" example:"USD"`
+
+	// Itemized price breakdown
+	Items []PriceQuoteItemResponse `json:"items"`
+
+	// Total price of the delivery
+	Total float64 `json:"total" example:"25.50"`
+}
+
+// PriceQuoteItemResponse represents a single concept of the price breakdown
+// @Description Price breakdown item
+type PriceQuoteItemResponse struct {
+	// Concept code
+	// @enum [BASE_RATE,DISTANCE,OVERWEIGHT,FRAGILE,URGENT,SURGE]
+	Concept string `json:"concept" example:"DISTANCE" enums:"BASE_RATE,DISTANCE,OVERWEIGHT,FRAGILE,URGENT,SURGE"`
+
+	// Human readable description
+	Description string `json:"description" example:"7.20 km x 1.50"`
+
+	// Amount of the concept
+	Amount float64 `json:"amount" example:"10.80"`
+}
+
 // PackageDetailRequest contains details about the package
 // @Description Package characteristics and handling information
 type PackageDetailRequest struct {
@@ -132,12 +193,35 @@ type DeliveryAddressRequest struct {
 	// Postal or ZIP code
 	PostalCode string `json:"postal_code,omitempty" example:"10001"`
 
-	// TODO: Temporalmente desactivados hasta implementar correctamente el manejo geoespacial
+	// Latitude coordinate of the destination
+	// @required
+	Latitude float64 `json:"latitude" example:"4.6584" binding:"required,latitude"`
+
+	// Longitude coordinate of the destination
+	// @required
+	Longitude float64 `json:"longitude" example:"-74.0937" binding:"required,longitude"`
 
 	// Additional notes about the address
 	AddressNotes string `json:"address_notes,omitempty" example:"Ring doorbell twice"`
 }
 
+func (a *DeliveryAddressRequest) Validate() error {
+	if !validCoordinates(a.Latitude, a.Longitude) {
+		return infraErr.NewGeneralServiceError("DeliveryAddressDTO", "Validate", domainErr.ErrInvalidDeliveryLocation)
+	}
+
+	return nil
+}
+
+// validCoordinates verifica que las coordenadas estén en rango y no sean el origen (0,0) por defecto
+func validCoordinates(latitude, longitude float64) bool {
+	if latitude == 0 && longitude == 0 {
+		return false
+	}
+
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
 // OrderResponse represents the response for an order
 // @Description Order information with all related details
 type OrderResponse struct {
@@ -168,6 +252,9 @@ type OrderResponse struct {
 	// Driver full name
 	DriverName string `json:"driver_name,omitempty" example:"Michael Johnson"`
 
+	// Delivery zone resolved from the delivery address
+	ZoneID *string `json:"zone_id,omitempty" example:"9f0e1d2c-3b4a-5968-7766-554433221100"`
+
 	// Tracking number for the order
 	TrackingNumber string `json:"tracking_number" example:"DEL-230512-7890"`
 
@@ -398,14 +485,6 @@ type OrderDriverAssignRequest struct {
 // OrderUpdateRequest represents the request body for updating an existing order
 // @Description Request structure for updating a delivery order
 type OrderUpdateRequest struct {
-	// Price of the delivery in local currency
-	// @minimum 0
-	Price float64 `json:"price,omitempty" example:"25.50" binding:"omitempty,min=0"`
-
-	// Distance of the delivery in kilometers
-	// @minimum 0
-	Distance float64 `json:"distance,omitempty" example:"7.2" binding:"omitempty,min=0"`
-
 	// Scheduled pickup time - only modifiable if order is still in PENDING state
 	PickupTime *time.Time `json:"pickup_time,omitempty" example:"2023-05-15T14:30:00Z" format:"date-time"`
 
@@ -514,3 +593,21 @@ type OrderStatusUpdateRequest struct {
 	// Optional description about the status change
 	Description string `json:"description,omitempty" example:"Driver has accepted the order and is heading to pickup location"`
 }
+
+// DispatchResultResponse represents the driver automatically assigned to an order
+type DispatchResultResponse struct {
+	OrderID    string    `json:"order_id" example:"b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e"`
+	DriverID   string    `json:"driver_id" example:"d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a"`
+	ZoneID     string    `json:"zone_id" example:"f8c3e8d7-b6a5-4d3c-9f1e-0a2b4c6d8e0f"`
+	Strategy   string    `json:"strategy" example:"NEAREST" enums:"NEAREST,ROUND_ROBIN,LOAD_BALANCED"`
+	DistanceKm float64   `json:"distance_km" example:"1.85"`
+	Score      float64   `json:"score" example:"1.85"`
+	Candidates int       `json:"candidates" example:"4"`
+	AssignedAt time.Time `json:"assigned_at" format:"date-time"`
+}
+
+// DispatchBatchResponse represents the outcome of dispatching every pending order
+type DispatchBatchResponse struct {
+	Dispatched int                      `json:"dispatched" example:"3"`
+	Results    []DispatchResultResponse `json:"results"`
+}
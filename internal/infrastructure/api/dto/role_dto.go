@@ -0,0 +1,40 @@
+package dto
+
+// RoleCreateRequest representa la solicitud para crear un rol personalizado
+type RoleCreateRequest struct {
+	// Nombre del rol, en mayúsculas y sin espacios. No puede modificarse luego de creado
+	Name string `json:"name" example:"DISPATCHER" binding:"required"`
+
+	// Descripción del rol
+	Description string `json:"description" example:"Coordinador de despachos"`
+}
+
+// RoleUpdateRequest representa la solicitud para actualizar un rol
+type RoleUpdateRequest struct {
+	// Descripción del rol
+	Description string `json:"description" example:"Coordinador de despachos"`
+
+	// Estado del rol, si se omite el rol queda activo
+	IsActive *bool `json:"is_active,omitempty" example:"true"`
+}
+
+// PermissionRequest representa la solicitud para crear o actualizar un permiso
+type PermissionRequest struct {
+	// Nombre legible del permiso, por defecto "recurso:acción"
+	Name string `json:"name,omitempty" example:"orders:dispatch"`
+
+	// Descripción del permiso
+	Description string `json:"description" example:"Asignar repartidores a pedidos"`
+
+	// Recurso sobre el que aplica el permiso
+	Resource string `json:"resource" example:"orders" binding:"required"`
+
+	// Acción permitida sobre el recurso
+	Action string `json:"action" example:"dispatch" binding:"required"`
+}
+
+// RolePermissionsRequest representa la solicitud para asignar permisos a un rol
+type RolePermissionsRequest struct {
+	// IDs de los permisos a asignar
+	PermissionIDs []string `json:"permission_ids" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6" binding:"required"`
+}
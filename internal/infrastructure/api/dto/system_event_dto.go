@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SystemEventResponse describe un evento registrado por un componente del sistema
+type SystemEventResponse struct {
+	ID        string `json:"id"`
+	EventType string `json:"event_type" example:"CACHE_UNAVAILABLE"`
+	Source    string `json:"source" example:"CACHE"`
+	SourceID  string `json:"source_id"`
+	Severity  string `json:"severity" example:"CRITICAL"`
+	// Datos del evento, dependen del tipo
+	EventData  json.RawMessage    `json:"event_data" swaggertype:"object"`
+	OccurredAt time.Time          `json:"occurred_at"`
+	Logs       []EventLogResponse `json:"logs"`
+}
+
+// EventLogResponse describe un log adjunto a un evento del sistema
+type EventLogResponse struct {
+	ID          string          `json:"id"`
+	LogLevel    string          `json:"log_level" example:"ERROR"`
+	Description string          `json:"description"`
+	Metadata    json.RawMessage `json:"metadata" swaggertype:"object"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// SystemEventSummaryResponse es la cantidad de eventos de un tipo y severidad
+type SystemEventSummaryResponse struct {
+	EventType string `json:"event_type" example:"LOGIN_FAILED"`
+	Severity  string `json:"severity" example:"INFO"`
+	Count     int64  `json:"count" example:"42"`
+}
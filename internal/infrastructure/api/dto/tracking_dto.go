@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+// LocationHistoryResponse representa el recorrido de un pedido como un Feature GeoJSON (RFC 7946)
+type LocationHistoryResponse struct {
+	// Tipo GeoJSON, siempre "Feature"
+	Type string `json:"type" example:"Feature"`
+
+	// Recorrido como LineString, nulo si hay menos de dos puntos registrados
+	Geometry *LineStringGeometry `json:"geometry"`
+
+	// Datos del recorrido
+	Properties LocationHistoryProperties `json:"properties"`
+}
+
+// LineStringGeometry representa una geometría GeoJSON LineString con posiciones [longitud, latitud]
+type LineStringGeometry struct {
+	Type        string       `json:"type" example:"LineString"`
+	Coordinates [][2]float64 `json:"coordinates"`
+}
+
+// LocationHistoryProperties representa los metadatos del recorrido
+type LocationHistoryProperties struct {
+	// ID del pedido
+	OrderID string `json:"order_id" example:"b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e"`
+
+	// Número de seguimiento del pedido
+	TrackingNumber string `json:"tracking_number" example:"ORD-20250401-ABC123"`
+
+	// IDs de los repartidores que reportaron ubicación
+	DriverIDs []string `json:"driver_ids"`
+
+	// Cantidad de puntos registrados
+	Points int `json:"points" example:"42"`
+
+	// Primer punto registrado
+	StartedAt *time.Time `json:"started_at,omitempty" format:"date-time"`
+
+	// Último punto registrado
+	EndedAt *time.Time `json:"ended_at,omitempty" format:"date-time"`
+
+	// Fecha de cada posición, en el mismo orden que las coordenadas
+	Timestamps []time.Time `json:"timestamps"`
+}
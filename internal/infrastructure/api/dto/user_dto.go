@@ -1,9 +1,7 @@
 package dto
 
 import (
-	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
-	"strings"
 )
 
 type UserDTO struct {
@@ -70,10 +68,6 @@ func (u *UserDTO) Validate() error {
 		if role == "" {
 			return errPackage.NewGeneralServiceError("UserDTO", "Validate", errPackage.ErrRoleMissing)
 		}
-
-		if !constants.ValidRoles[strings.ToUpper(role)] {
-			return errPackage.NewGeneralServiceError("UserDTO", "Validate", errPackage.ErrInvalidRole)
-		}
 	}
 
 	if u.Profile == nil {
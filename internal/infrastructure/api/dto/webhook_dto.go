@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// WebhookCreateRequest representa el registro de un webhook de la empresa
+type WebhookCreateRequest struct {
+	// URL que recibe los eventos por POST
+	URL string `json:"url" example:"https://erp.example.com/webhooks/delivery" validate:"required"`
+	// Eventos: order.created, order.status_changed, order.driver_assigned, order.location_updated, order.delivered, order.deleted, order.restored
+	Events []string `json:"events" example:"order.created,order.delivered" validate:"required"`
+	// Empresa del webhook, solo la pueden indicar los administradores
+	CompanyID string `json:"company_id,omitempty"`
+}
+
+// WebhookResponse describe una suscripción sin su secreto
+type WebhookResponse struct {
+	ID        string    `json:"id"`
+	CompanyID string    `json:"company_id"`
+	URL       string    `json:"url" example:"https://erp.example.com/webhooks/delivery"`
+	Events    []string  `json:"events" example:"order.created,order.delivered"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IssuedWebhookResponse contiene el secreto de firma, solo se muestra al crear el webhook
+type IssuedWebhookResponse struct {
+	WebhookResponse
+	// Secreto para verificar el header X-Delivery-Signature
+	Secret string `json:"secret" example:"whsec_..."`
+}
+
+// WebhookDeliveryResponse describe una entrega de un evento a un webhook
+type WebhookDeliveryResponse struct {
+	ID             string     `json:"id"`
+	SubscriptionID string     `json:"subscription_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type" example:"order.delivered"`
+	Status         string     `json:"status" example:"SUCCEEDED"`
+	Attempts       int        `json:"attempts"`
+	LastStatusCode int        `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	ReplayOf       *string    `json:"replay_of,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// WebhookDeliveryDetailResponse incluye el cuerpo enviado y el registro de intentos
+type WebhookDeliveryDetailResponse struct {
+	WebhookDeliveryResponse
+	Payload  json.RawMessage                  `json:"payload" swaggertype:"object"`
+	Attempts []WebhookDeliveryAttemptResponse `json:"attempt_log"`
+}
+
+// WebhookDeliveryAttemptResponse describe un intento de envío
+type WebhookDeliveryAttemptResponse struct {
+	AttemptNumber int       `json:"attempt_number"`
+	StatusCode    int       `json:"status_code,omitempty"`
+	Error         string    `json:"error,omitempty"`
+	DurationMs    int64     `json:"duration_ms"`
+	AttemptedAt   time.Time `json:"attempted_at"`
+}
+
+func NewWebhookResponse(subscription *entities.WebhookSubscription) WebhookResponse {
+	return WebhookResponse{
+		ID:        subscription.ID,
+		CompanyID: subscription.CompanyID,
+		URL:       subscription.URL,
+		Events:    subscription.EventList(),
+		IsActive:  subscription.IsActive,
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+func NewWebhookListResponse(subscriptions []entities.WebhookSubscription) []WebhookResponse {
+	response := make([]WebhookResponse, 0, len(subscriptions))
+	for i := range subscriptions {
+		response = append(response, NewWebhookResponse(&subscriptions[i]))
+	}
+
+	return response
+}
+
+func NewIssuedWebhookResponse(issued *entities.IssuedWebhookSubscription) IssuedWebhookResponse {
+	return IssuedWebhookResponse{
+		WebhookResponse: NewWebhookResponse(issued.Subscription),
+		Secret:          issued.Secret,
+	}
+}
+
+func NewWebhookDeliveryResponse(delivery *entities.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		ReplayOf:       delivery.ReplayOf,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func NewWebhookDeliveryListResponse(deliveries []entities.WebhookDelivery) []WebhookDeliveryResponse {
+	response := make([]WebhookDeliveryResponse, 0, len(deliveries))
+	for i := range deliveries {
+		response = append(response, NewWebhookDeliveryResponse(&deliveries[i]))
+	}
+
+	return response
+}
+
+func NewWebhookDeliveryDetailResponse(delivery *entities.WebhookDelivery) WebhookDeliveryDetailResponse {
+	attempts := make([]WebhookDeliveryAttemptResponse, 0, len(delivery.AttemptLog))
+	for _, attempt := range delivery.AttemptLog {
+		attempts = append(attempts, WebhookDeliveryAttemptResponse{
+			AttemptNumber: attempt.AttemptNumber,
+			StatusCode:    attempt.StatusCode,
+			Error:         attempt.Error,
+			DurationMs:    attempt.DurationMs,
+			AttemptedAt:   attempt.AttemptedAt,
+		})
+	}
+
+	return WebhookDeliveryDetailResponse{
+		WebhookDeliveryResponse: NewWebhookDeliveryResponse(delivery),
+		Payload:                 json.RawMessage(delivery.Payload),
+		Attempts:                attempts,
+	}
+}
@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // ZoneAssignmentRequest representa la solicitud para asignar una zona a una sucursal
 // @Description Solicitud para asignar una zona a una sucursal
 type ZoneAssignmentRequest struct {
@@ -7,3 +12,38 @@ type ZoneAssignmentRequest struct {
 	// @required
 	ZoneID string `json:"zone_id" example:"f8c3e8d7-b6a5-4d3c-9f1e-0a2b4c6d8e0f" binding:"required"`
 }
+
+// ZoneRequest son los datos para crear o actualizar una zona de cobertura
+type ZoneRequest struct {
+	Name string `json:"name" validate:"required" example:"Zona Norte"`
+	Code string `json:"code" validate:"required" example:"ZNORTE"`
+	// Polígono cerrado como objeto GeoJSON o como texto WKT, las coordenadas van en orden longitud latitud
+	Boundaries      json.RawMessage `json:"boundaries" validate:"required" swaggertype:"object"`
+	BaseRate        float64         `json:"base_rate" example:"5.50"`
+	MaxDeliveryTime int             `json:"max_delivery_time" validate:"required" example:"60"`
+	// Prioridad de la zona, 1 si no se indica
+	PriorityLevel int `json:"priority_level,omitempty" example:"1"`
+	// Estado de la zona, al actualizar sin valor se conserva el actual
+	IsActive *bool `json:"is_active,omitempty" example:"true"`
+}
+
+// ZoneCenterPoint es el centroide de los límites de la zona
+type ZoneCenterPoint struct {
+	Latitude  float64 `json:"latitude" example:"4.71"`
+	Longitude float64 `json:"longitude" example:"-74.025"`
+}
+
+// ZoneResponse describe una zona de cobertura, los límites se devuelven en GeoJSON
+type ZoneResponse struct {
+	ID              string           `json:"id"`
+	Name            string           `json:"name" example:"Zona Norte"`
+	Code            string           `json:"code" example:"ZNORTE"`
+	Boundaries      json.RawMessage  `json:"boundaries" swaggertype:"object"`
+	CenterPoint     *ZoneCenterPoint `json:"center_point"`
+	BaseRate        float64          `json:"base_rate" example:"5.50"`
+	MaxDeliveryTime int              `json:"max_delivery_time" example:"60"`
+	PriorityLevel   int              `json:"priority_level" example:"1"`
+	IsActive        bool             `json:"is_active"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
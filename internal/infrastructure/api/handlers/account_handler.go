@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+type AccountHandler struct {
+	accountUseCase ports.AccountUseCase
+	respWriter     *responser.ResponseWriter
+}
+
+func NewAccountHandler(accountUseCase ports.AccountUseCase) *AccountHandler {
+	return &AccountHandler{
+		accountUseCase: accountUseCase,
+		respWriter:     responser.NewResponseWriter(),
+	}
+}
+
+// ForgotPassword godoc
+// @Summary      This endpoint is used to request a password reset link
+// @Description  Send a one-time password reset link by email. The response is the same whether the email exists or not
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.EmailRequest true "User email"
+// @Success      202  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/password/forgot [post]
+func (h *AccountHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el correo
+	var req dto.EmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AccountHandler", "ForgotPassword", err))
+		return
+	}
+
+	// 2. Solicitar la recuperación
+	if err := h.accountUseCase.RequestPasswordReset(r.Context(), req.Email); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusAccepted, map[string]interface{}{
+		"message": "If the email is registered, a password reset link has been sent",
+	})
+}
+
+// ResetPassword godoc
+// @Summary      This endpoint is used to set a new password with a reset token
+// @Description  Consume the one-time reset token, update the password and revoke every open session
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/password/reset [post]
+func (h *AccountHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el token y la nueva contraseña
+	var req dto.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AccountHandler", "ResetPassword", err))
+		return
+	}
+
+	// 2. Restablecer la contraseña
+	if err := h.accountUseCase.ResetPassword(r.Context(), req.Token, req.Password); err != nil {
+		h.handleAccountError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "Password updated successfully",
+	})
+}
+
+// RequestEmailVerification godoc
+// @Summary      This endpoint is used to request an email verification link
+// @Description  Send a one-time verification link if the email is registered and not verified yet
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.EmailRequest true "User email"
+// @Success      202  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/email/verification [post]
+func (h *AccountHandler) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el correo
+	var req dto.EmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AccountHandler", "RequestEmailVerification", err))
+		return
+	}
+
+	// 2. Solicitar la verificación
+	if err := h.accountUseCase.RequestEmailVerification(r.Context(), req.Email); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusAccepted, map[string]interface{}{
+		"message": "If the email is registered and not verified, a verification link has been sent",
+	})
+}
+
+// VerifyEmail godoc
+// @Summary      This endpoint is used to confirm an email address
+// @Description  Consume the one-time verification token and mark the email as verified
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.TokenRequest true "Verification token"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/email/verify [post]
+func (h *AccountHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el token
+	var req dto.TokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AccountHandler", "VerifyEmail", err))
+		return
+	}
+
+	// 2. Verificar el correo
+	if err := h.accountUseCase.VerifyEmail(r.Context(), req.Token); err != nil {
+		h.handleAccountError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "Email verified successfully",
+	})
+}
+
+// handleAccountError responde 400 con el mensaje del token inválido sin exponer detalles internos
+func (h *AccountHandler) handleAccountError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errPackage.ErrInvalidAccountToken) {
+		h.respWriter.Error(w, http.StatusBadRequest, errPackage.ErrInvalidAccountToken.Error(), nil)
+		return
+	}
+	h.respWriter.HandleError(w, err)
+}
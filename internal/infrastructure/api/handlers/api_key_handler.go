@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+type APIKeyHandler struct {
+	apiKeyUseCase ports.APIKeyUseCase
+	respWriter    *responser.ResponseWriter
+}
+
+func NewAPIKeyHandler(apiKeyUseCase ports.APIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+		respWriter:    responser.NewResponseWriter(),
+	}
+}
+
+// CreateAPIKey godoc
+// @Summary      This endpoint is used to create an API key for machine-to-machine integrations
+// @Description  Create a scoped API key for the company of the authenticated user. The key is shown only once, only its hash is stored. Admins may set company_id
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.APIKeyCreateRequest true "API key data"
+// @Success      201  {object}  dto.IssuedAPIKeyResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los datos de la key
+	var req dto.APIKeyCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("APIKeyHandler", "CreateAPIKey", err))
+		return
+	}
+
+	// 2. Crear la key
+	issued, err := h.apiKeyUseCase.CreateAPIKey(r.Context(), &auth.APIKeyInput{
+		CompanyID: req.CompanyID,
+		BranchID:  req.BranchID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusCreated, dto.NewIssuedAPIKeyResponse(issued))
+}
+
+// ListAPIKeys godoc
+// @Summary      This endpoint is used to list the API keys of the company
+// @Description  List the API keys of the company of the authenticated user, including revoked ones. Admins may filter by company_id
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID (admins only)"
+// @Success      200  {array}   dto.APIKeyResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/api-keys [get]
+func (h *APIKeyHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.apiKeyUseCase.ListAPIKeys(r.Context(), r.URL.Query().Get("company_id"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewAPIKeyListResponse(keys))
+}
+
+// RotateAPIKey godoc
+// @Summary      This endpoint is used to rotate an API key
+// @Description  Replace the secret of an active API key. The previous key stops working immediately and the new one is shown only once
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        key_id path string true "API key ID"
+// @Success      200  {object}  dto.IssuedAPIKeyResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/api-keys/{key_id}/rotate [post]
+func (h *APIKeyHandler) RotateAPIKey(w http.ResponseWriter, r *http.Request) {
+	issued, err := h.apiKeyUseCase.RotateAPIKey(r.Context(), mux.Vars(r)["key_id"])
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewIssuedAPIKeyResponse(issued))
+}
+
+// RevokeAPIKey godoc
+// @Summary      This endpoint is used to revoke an API key
+// @Description  Revoke an active API key, requests made with it are rejected immediately
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        key_id path string true "API key ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/api-keys/{key_id} [delete]
+func (h *APIKeyHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	if err := h.apiKeyUseCase.RevokeAPIKey(r.Context(), mux.Vars(r)["key_id"]); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "API key revoked successfully",
+	})
+}
+
+// handleError responde 404 si la key no existe, está revocada o pertenece a otra empresa
+func (h *APIKeyHandler) handleError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errPackage.ErrAPIKeyNotFound) {
+		h.respWriter.Error(w, http.StatusNotFound, errPackage.ErrAPIKeyNotFound.Error(), nil)
+		return
+	}
+
+	h.respWriter.HandleError(w, err)
+}
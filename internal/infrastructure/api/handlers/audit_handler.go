@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type AuditHandler struct {
+	auditUseCase ports.AuditUseCase
+	respWriter   *responser.ResponseWriter
+}
+
+func NewAuditHandler(auditUseCase ports.AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		auditUseCase: auditUseCase,
+		respWriter:   responser.NewResponseWriter(),
+	}
+}
+
+// GetAuditLogs godoc
+// @Summary      This endpoint is used to review the audit log
+// @Description  List the recorded changes on users, companies, branches, orders and roles with the before/after snapshots, the caller and its IP/User-Agent. Non-admin users only see their company. Admins may filter by company_id
+// @Tags         audit
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        entity_type query string false "Entity type (user, company, company_address, branch, order, role, permission)"
+// @Param        entity_id query string false "Entity ID"
+// @Param        user_id query string false "User who made the change"
+// @Param        action query string false "Action (create, update, delete, restore, activate, deactivate, assign, unassign)"
+// @Param        start_date query string false "Start date (RFC3339)"
+// @Param        end_date query string false "End date (RFC3339)"
+// @Param        company_id query string false "Company ID (admins only)"
+// @Param        page query int false "Page number"
+// @Param        page_size query int false "Page size (max 100)"
+// @Param        sort_direction query string false "Sort by date, asc or desc"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/audit-logs [get]
+func (h *AuditHandler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los registros y los parámetros de consulta
+	auditLogs, params, total, err := h.auditUseCase.GetAuditLogs(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapAuditLogsToResponse(auditLogs, params, total))
+}
@@ -1,14 +1,20 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
 	"net"
 	"net/http"
 	"strings"
 
+	"github.com/gorilla/mux"
+
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
 
 	_ "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
@@ -27,14 +33,17 @@ func NewAuthHandler(authUseCase ports.AuthenticatorUseCase) *AuthHandler {
 
 // Login godoc
 // @Summary      This endpoint is used to authenticate a users and return a JWT token to be used in subsequent requests
-// @Description  Authenticate users and return JWT token
+// @Description  Authenticate users and return JWT token. When the user has MFA enabled, or the role requires it, the response is a dto.MFAChallengeResponse with mfa_required=true and the login continues in /auth/mfa/verify
 // @Tags         auth
 // @Accept       json
 // @Produce      json
 // @Param        request body dto.LoginRequest true "Login credentials"
 // @Success      200  {object}  dto.LoginResponse
+// @Success      202  {object}  dto.MFAChallengeResponse
 // @Failure      400  {object}  responser.APIErrorResponse
 // @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      429  {object}  responser.APIErrorResponse "Too many failed attempts, see the Retry-After header"
+// @Header       429  {integer} Retry-After "Seconds to wait before trying again"
 // @Router       /api/v1/auth/login [post]
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	// 1. Obtener credenciales
@@ -45,16 +54,123 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Autenticar
-	token, err := h.authUseCase.Authenticate(r.Context(), req.ParseToCredentialsModel(getClientIP(r)))
+	result, err := h.authUseCase.Authenticate(r.Context(), req.ParseToCredentialsModel(getClientIP(r)))
 	if err != nil {
+		var retryErr *errPackage.RetryAfterError
+		if errors.As(err, &retryErr) {
+			h.respWriter.RetryAfter(w, retryErr.RetryAfter, retryErr.Error())
+			return
+		}
 		h.respWriter.HandleError(w, err)
 		return
 	}
 
+	// 3. Si el usuario tiene MFA, responder con el reto
+	if result.Challenge != nil {
+		h.respWriter.Success(w, http.StatusAccepted, dto.NewMFAChallengeResponse(result.Challenge))
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, dto.NewLoginResponse(result.Tokens))
+}
+
+// VerifyMFA godoc
+// @Summary      This endpoint is used to complete the login of a user with MFA
+// @Description  Verify the TOTP or recovery code against the login challenge and issue the session tokens. Recovery codes are returned only when the MFA enrollment was completed in this step
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.MFAVerifyRequest true "Challenge token and code"
+// @Success      200  {object}  dto.LoginResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      429  {object}  responser.APIErrorResponse "Too many invalid MFA codes, see the Retry-After header"
+// @Header       429  {integer} Retry-After "Seconds to wait before trying again"
+// @Router       /api/v1/auth/mfa/verify [post]
+func (h *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el reto y el código
+	var req dto.MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AuthHandler", "VerifyMFA", err))
+		return
+	}
+
+	// 2. Verificar el código y crear la sesión
+	result, err := h.authUseCase.VerifyMFAChallenge(r.Context(), req.ChallengeToken, req.Code, getClientIP(r))
+	if err != nil {
+		handleMFAError(h.respWriter, w, err)
+		return
+	}
+
 	// 3. Responder
-	h.respWriter.Success(w, http.StatusOK, dto.LoginResponse{
-		Token: token,
-	})
+	response := dto.NewLoginResponse(result.Tokens)
+	response.RecoveryCodes = result.RecoveryCodes
+	h.respWriter.Success(w, http.StatusOK, response)
+}
+
+// EnrollMFAChallenge godoc
+// @Summary      This endpoint is used to enroll MFA during the login when the role requires it
+// @Description  Generate the TOTP secret for a challenge with enrollment_required=true. The enrollment is confirmed by sending a code to /auth/mfa/verify
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.MFAChallengeTokenRequest true "Challenge token"
+// @Success      200  {object}  dto.MFAEnrollmentResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/mfa/challenge/enroll [post]
+func (h *AuthHandler) EnrollMFAChallenge(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el reto
+	var req dto.MFAChallengeTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AuthHandler", "EnrollMFAChallenge", err))
+		return
+	}
+
+	// 2. Generar el secreto
+	enrollment, err := h.authUseCase.BeginMFAChallengeEnrollment(r.Context(), req.ChallengeToken)
+	if err != nil {
+		handleMFAError(h.respWriter, w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, dto.NewMFAEnrollmentResponse(enrollment))
+}
+
+// Refresh godoc
+// @Summary      This endpoint is used to exchange a refresh token for a new access token
+// @Description  Rotate the refresh token and issue a new short-lived access token. Reusing a refresh token revokes the whole session
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.RefreshRequest true "Refresh token"
+// @Success      200  {object}  dto.LoginResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/refresh [post]
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el refresh token
+	var req dto.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("AuthHandler", "Refresh", err))
+		return
+	}
+
+	// 2. Rotar la sesión
+	tokens, err := h.authUseCase.RefreshSession(r.Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrInvalidRefreshToken) || errors.Is(err, errPackage.ErrRefreshTokenReused) || errors.Is(err, errPackage.ErrInactiveUser) {
+			h.respWriter.Error(w, http.StatusUnauthorized, errors.Unwrap(err).Error(), nil)
+			return
+		}
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, dto.NewLoginResponse(tokens))
 }
 
 // Logout godoc
@@ -85,6 +201,64 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetSessions godoc
+// @Summary      This endpoint is used to list the active sessions of the authenticated user
+// @Description  List active sessions with device and IP metadata, the session of the current token is flagged
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.SessionResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/sessions [get]
+func (h *AuthHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener las sesiones activas
+	sessions, err := h.authUseCase.GetSessions(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Marcar la sesión actual y responder
+	var currentSessionID string
+	if claims, ok := r.Context().Value("claims").(*auth.AuthClaims); ok {
+		currentSessionID = claims.SessionID
+	}
+	h.respWriter.Success(w, http.StatusOK, response_mapper.SessionsToResponse(sessions, currentSessionID))
+}
+
+// RevokeSession godoc
+// @Summary      This endpoint is used to revoke one of the sessions of the authenticated user
+// @Description  Revoke a single session, its access token and refresh tokens stop working immediately
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        session_id path string true "Session ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/sessions/{session_id} [delete]
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	sessionID := mux.Vars(r)["session_id"]
+
+	// 1. Revocar la sesión
+	if err := h.authUseCase.RevokeSession(r.Context(), sessionID); err != nil {
+		if errors.Is(err, errPackage.ErrUserSessionNotFound) {
+			h.respWriter.Error(w, http.StatusNotFound, errPackage.ErrUserSessionNotFound.Error(), nil)
+			return
+		}
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "Session revoked successfully",
+	})
+}
+
 // getClientIP obtiene la dirección IP del cliente
 // Se intenta obtener la dirección IP desde los headers X-Forwarded-For y X-Real-IP
 // Si no se encuentra, se obtiene la dirección IP desde RemoteAddr
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+)
+
+type DispatchHandler struct {
+	useCase    ports.DispatchUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewDispatchHandler(useCase ports.DispatchUseCase) *DispatchHandler {
+	return &DispatchHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// DispatchOrder godoc
+// @Summary      This endpoint is used to automatically assign the best available driver to an order
+// @Description  Select a driver for a PENDING or ACCEPTED order using the configured dispatch strategy
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Success      200  {object}  dto.DispatchResultResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/dispatch/{order_id} [post]
+func (h *DispatchHandler) DispatchOrder(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	orderID := mux.Vars(r)["order_id"]
+
+	// 2. Llamar al caso de uso
+	result, err := h.useCase.DispatchOrder(r.Context(), orderID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.DispatchResultToResponseDTO(result))
+}
+
+// DispatchPendingOrders godoc
+// @Summary      This endpoint is used to dispatch every pending order without a driver
+// @Description  Run the dispatch engine over unassigned PENDING and ACCEPTED orders, orders without available drivers are skipped
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.DispatchBatchResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/dispatch [post]
+func (h *DispatchHandler) DispatchPendingOrders(w http.ResponseWriter, r *http.Request) {
+	// 1. Llamar al caso de uso
+	results, err := h.useCase.DispatchPendingOrders(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.DispatchResultsToResponseDTO(results))
+}
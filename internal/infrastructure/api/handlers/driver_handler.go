@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+)
+
+type DriverHandler struct {
+	useCase    ports.DriverUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewDriverHandler(useCase ports.DriverUseCase) *DriverHandler {
+	return &DriverHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetDrivers godoc
+// @Summary      Obtiene los repartidores
+// @Description  Obtiene todos los repartidores con filtros y paginación
+// @Tags         drivers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Número de página"
+// @Param        page_size query int false "Tamaño de página"
+// @Param        name query string false "Nombre del repartidor"
+// @Param        license_number query string false "Número de licencia"
+// @Param        vehicle_plate query string false "Placa del vehículo"
+// @Param        vehicle_type query string false "Tipo de vehículo"
+// @Param        zone_id query string false "ID de una zona asignada"
+// @Param        is_active query string false "Estado (activo/inactivo)"
+// @Param        sort_by query string false "Campo por el cual ordenar"
+// @Param        sort_direction query string false "Dirección de ordenamiento (asc/desc)"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers [get]
+func (h *DriverHandler) GetDrivers(w http.ResponseWriter, r *http.Request) {
+	// Obtener los repartidores
+	drivers, params, total, err := h.useCase.GetDrivers(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Convertir a DTO
+	response := response_mapper.MapDriversToResponse(drivers, params, total)
+	h.respWriter.Success(w, http.StatusOK, response)
+}
+
+// GetDriverByID godoc
+// @Summary      Obtiene un repartidor por su ID
+// @Description  Obtiene el perfil, vehículo y zonas de un repartidor
+// @Tags         drivers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id path string true "ID del usuario repartidor"
+// @Success      200  {object}  dto.DriverResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers/{driver_id} [get]
+func (h *DriverHandler) GetDriverByID(w http.ResponseWriter, r *http.Request) {
+	// Extraer ID del repartidor
+	driverID := mux.Vars(r)["driver_id"]
+
+	// Obtener el repartidor
+	driver, err := h.useCase.GetDriverByID(r.Context(), driverID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Convertir a DTO
+	response := response_mapper.DriverToResponseDTO(driver)
+	h.respWriter.Success(w, http.StatusOK, response)
+}
+
+// CreateDriver godoc
+// @Summary      Registra un repartidor
+// @Description  Crea el perfil de repartidor de un usuario con rol DRIVER
+// @Tags         drivers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver body dto.DriverCreateRequest true "Información del repartidor"
+// @Success      201  {string}  string "Repartidor creado exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers [post]
+func (h *DriverHandler) CreateDriver(w http.ResponseWriter, r *http.Request) {
+	var req dto.DriverCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Mapear a entidad
+	driver, err := request_mapper.DriverRequestToDriver(&req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Crear el repartidor
+	err = h.useCase.CreateDriver(r.Context(), driver)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusCreated, "Repartidor creado exitosamente")
+}
+
+// UpdateDriver godoc
+// @Summary      Actualiza un repartidor
+// @Description  Actualiza los datos de licencia y vehículo de un repartidor
+// @Tags         drivers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id path string true "ID del usuario repartidor"
+// @Param        driver body dto.DriverUpdateRequest true "Información actualizada del repartidor"
+// @Success      200  {string}  string "Repartidor actualizado exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers/{driver_id} [put]
+func (h *DriverHandler) UpdateDriver(w http.ResponseWriter, r *http.Request) {
+	// Extraer ID del repartidor
+	driverID := mux.Vars(r)["driver_id"]
+
+	var req dto.DriverUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Mapear a entidad
+	driver, err := request_mapper.DriverUpdateRequestToDriver(driverID, &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Actualizar el repartidor
+	err = h.useCase.UpdateDriver(r.Context(), driverID, driver)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Repartidor actualizado exitosamente")
+}
+
+// DeactivateDriver godoc
+// @Summary      Desactiva un repartidor
+// @Description  Cambia el estado de un repartidor a inactivo
+// @Tags         drivers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id path string true "ID del usuario repartidor"
+// @Success      200  {string}  string "Repartidor desactivado exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers/deactivate/{driver_id} [post]
+func (h *DriverHandler) DeactivateDriver(w http.ResponseWriter, r *http.Request) {
+	// Extraer ID del repartidor
+	driverID := mux.Vars(r)["driver_id"]
+
+	// Desactivar el repartidor
+	err := h.useCase.DeactivateDriver(r.Context(), driverID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Repartidor desactivado exitosamente")
+}
+
+// ReactivateDriver godoc
+// @Summary      Reactiva un repartidor
+// @Description  Cambia el estado de un repartidor a activo si su licencia está vigente
+// @Tags         drivers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id path string true "ID del usuario repartidor"
+// @Success      200  {string}  string "Repartidor reactivado exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers/reactivate/{driver_id} [post]
+func (h *DriverHandler) ReactivateDriver(w http.ResponseWriter, r *http.Request) {
+	// Extraer ID del repartidor
+	driverID := mux.Vars(r)["driver_id"]
+
+	// Reactivar el repartidor
+	err := h.useCase.ReactivateDriver(r.Context(), driverID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Repartidor reactivado exitosamente")
+}
+
+// AssignZonesToDriver godoc
+// @Summary      Asigna zonas a un repartidor
+// @Description  Asigna la zona primaria y las zonas secundarias de un repartidor, reemplazando las anteriores
+// @Tags         drivers, zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id path string true "ID del usuario repartidor"
+// @Param        request body dto.DriverZonesAssignRequest true "Zonas a asignar"
+// @Success      200  {string}  string "Zonas asignadas exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers/zones/{driver_id} [put]
+func (h *DriverHandler) AssignZonesToDriver(w http.ResponseWriter, r *http.Request) {
+	// Extraer ID del repartidor
+	driverID := mux.Vars(r)["driver_id"]
+
+	// Decodificar la solicitud
+	var req dto.DriverZonesAssignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Asignar las zonas
+	err := h.useCase.AssignZonesToDriver(r.Context(), driverID, req.PrimaryZoneID, req.SecondaryZoneIDs)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Zonas asignadas exitosamente")
+}
+
+// GetDriverZones godoc
+// @Summary      Obtiene las zonas de un repartidor
+// @Description  Retorna la zona primaria y las secundarias activas de un repartidor
+// @Tags         drivers, zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id path string true "ID del usuario repartidor"
+// @Success      200  {array}  dto.DriverZoneResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/drivers/zones/{driver_id} [get]
+func (h *DriverHandler) GetDriverZones(w http.ResponseWriter, r *http.Request) {
+	// Extraer ID del repartidor
+	driverID := mux.Vars(r)["driver_id"]
+
+	// Obtener las zonas
+	zones, err := h.useCase.GetDriverZones(r.Context(), driverID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.DriverZonesToResponseDTO(zones))
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// jwksCacheMaxAge permite a los verificadores guardar las claves sin consultar en cada token
+const jwksCacheMaxAge = "public, max-age=300"
+
+type JWKSHandler struct {
+	keyPublisher ports.KeyPublisher
+}
+
+func NewJWKSHandler(keyPublisher ports.KeyPublisher) *JWKSHandler {
+	return &JWKSHandler{
+		keyPublisher: keyPublisher,
+	}
+}
+
+// GetJWKS godoc
+// @Summary      This endpoint is used to get the public keys that verify the access tokens
+// @Description  Return the JSON Web Key Set (RFC 7517) with the active signing key and the keys still accepted during a rotation. The kid header of each token identifies its key. Empty when tokens are signed with HS256
+// @Tags         auth
+// @Produce      json
+// @Success      200  {object}  auth.JSONWebKeySet
+// @Router       /.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	// El documento se responde sin el envoltorio de la API para que lo lean las librerías JWT estándar
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jwksCacheMaxAge)
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(h.keyPublisher.JWKS()); err != nil {
+		logs.Error("Failed to encode JWKS", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+type MFAHandler struct {
+	mfaUseCase ports.MFAUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewMFAHandler(mfaUseCase ports.MFAUseCase) *MFAHandler {
+	return &MFAHandler{
+		mfaUseCase: mfaUseCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetStatus godoc
+// @Summary      This endpoint is used to get the MFA status of the authenticated user
+// @Description  Get whether MFA is enabled, whether the role requires it and how many recovery codes are left
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.MFAStatusResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/mfa [get]
+func (h *MFAHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.mfaUseCase.GetStatus(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewMFAStatusResponse(status))
+}
+
+// BeginEnrollment godoc
+// @Summary      This endpoint is used to start the TOTP enrollment
+// @Description  Generate a new TOTP secret pending of confirmation. Any previous pending enrollment is replaced
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.MFAEnrollmentResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Router       /api/v1/auth/mfa/enroll [post]
+func (h *MFAHandler) BeginEnrollment(w http.ResponseWriter, r *http.Request) {
+	enrollment, err := h.mfaUseCase.BeginEnrollment(r.Context())
+	if err != nil {
+		handleMFAError(h.respWriter, w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewMFAEnrollmentResponse(enrollment))
+}
+
+// ConfirmEnrollment godoc
+// @Summary      This endpoint is used to confirm the TOTP enrollment
+// @Description  Verify a code generated with the pending secret, enable MFA and return the recovery codes. The recovery codes are shown only once
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.MFACodeRequest true "TOTP code"
+// @Success      200  {object}  dto.RecoveryCodesResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      429  {object}  responser.APIErrorResponse "Too many invalid MFA codes, see the Retry-After header"
+// @Header       429  {integer} Retry-After "Seconds to wait before trying again"
+// @Router       /api/v1/auth/mfa/enroll/confirm [post]
+func (h *MFAHandler) ConfirmEnrollment(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el código
+	var req dto.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("MFAHandler", "ConfirmEnrollment", err))
+		return
+	}
+
+	// 2. Activar el MFA
+	codes, err := h.mfaUseCase.ConfirmEnrollment(r.Context(), req.Code)
+	if err != nil {
+		handleMFAError(h.respWriter, w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary      This endpoint is used to regenerate the MFA recovery codes
+// @Description  Verify a TOTP code, invalidate the previous recovery codes and return new ones
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.MFACodeRequest true "TOTP code"
+// @Success      200  {object}  dto.RecoveryCodesResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      429  {object}  responser.APIErrorResponse "Too many invalid MFA codes, see the Retry-After header"
+// @Header       429  {integer} Retry-After "Seconds to wait before trying again"
+// @Router       /api/v1/auth/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el código
+	var req dto.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("MFAHandler", "RegenerateRecoveryCodes", err))
+		return
+	}
+
+	// 2. Regenerar los códigos
+	codes, err := h.mfaUseCase.RegenerateRecoveryCodes(r.Context(), req.Code)
+	if err != nil {
+		handleMFAError(h.respWriter, w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// Disable godoc
+// @Summary      This endpoint is used to disable MFA
+// @Description  Verify a TOTP or recovery code and remove the MFA secret. Not allowed when the role of the user requires MFA
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.MFACodeRequest true "TOTP or recovery code"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Failure      429  {object}  responser.APIErrorResponse "Too many invalid MFA codes, see the Retry-After header"
+// @Header       429  {integer} Retry-After "Seconds to wait before trying again"
+// @Router       /api/v1/auth/mfa/disable [post]
+func (h *MFAHandler) Disable(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el código
+	var req dto.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("MFAHandler", "Disable", err))
+		return
+	}
+
+	// 2. Desactivar el MFA
+	if err := h.mfaUseCase.Disable(r.Context(), req.Code); err != nil {
+		handleMFAError(h.respWriter, w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "MFA disabled successfully",
+	})
+}
+
+// handleMFAError responde 401 ante retos o códigos inválidos y 403 si el rol exige MFA
+func handleMFAError(respWriter *responser.ResponseWriter, w http.ResponseWriter, err error) {
+	var retryErr *errPackage.RetryAfterError
+	if errors.As(err, &retryErr) {
+		respWriter.RetryAfter(w, retryErr.RetryAfter, retryErr.Error())
+		return
+	}
+	for _, unauthorized := range []error{errPackage.ErrMFAChallengeInvalid, errPackage.ErrInvalidMFACode, errPackage.ErrInactiveUser} {
+		if errors.Is(err, unauthorized) {
+			respWriter.Error(w, http.StatusUnauthorized, unauthorized.Error(), nil)
+			return
+		}
+	}
+	if errors.Is(err, errPackage.ErrMFARequiredByRole) {
+		respWriter.Error(w, http.StatusForbidden, errPackage.ErrMFARequiredByRole.Error(), nil)
+		return
+	}
+
+	respWriter.HandleError(w, err)
+}
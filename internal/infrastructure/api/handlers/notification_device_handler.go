@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type NotificationDeviceHandler struct {
+	deviceUseCase ports.NotificationDeviceUseCase
+	respWriter    *responser.ResponseWriter
+}
+
+func NewNotificationDeviceHandler(deviceUseCase ports.NotificationDeviceUseCase) *NotificationDeviceHandler {
+	return &NotificationDeviceHandler{
+		deviceUseCase: deviceUseCase,
+		respWriter:    responser.NewResponseWriter(),
+	}
+}
+
+// RegisterDevice godoc
+// @Summary      This endpoint is used to register a device for push notifications
+// @Description  Register the FCM or APNs token of the app for the authenticated user. A token that is already registered is reassigned to the user and reactivated instead of duplicated
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.NotificationDeviceRequest true "Device data"
+// @Success      201  {object}  dto.NotificationDeviceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/devices [post]
+func (h *NotificationDeviceHandler) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los datos del dispositivo
+	var req dto.NotificationDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("NotificationDeviceHandler", "RegisterDevice", err))
+		return
+	}
+
+	// 2. Registrar el dispositivo
+	device, err := h.deviceUseCase.RegisterDevice(r.Context(), &entities.NotificationDeviceInput{
+		DeviceToken: req.DeviceToken,
+		DeviceType:  req.DeviceType,
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.MapNotificationDeviceToResponse(device))
+}
+
+// RefreshDevice godoc
+// @Summary      This endpoint is used to refresh the token of a push device
+// @Description  Replace the token of a device of the authenticated user, for example when FCM or APNs rotates it. The device is reactivated and its failure count reset
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        device_id path string true "Device ID"
+// @Param        request body dto.NotificationDeviceRequest true "New device token"
+// @Success      200  {object}  dto.NotificationDeviceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/devices/{device_id} [put]
+func (h *NotificationDeviceHandler) RefreshDevice(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el token nuevo
+	var req dto.NotificationDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("NotificationDeviceHandler", "RefreshDevice", err))
+		return
+	}
+
+	// 2. Refrescar el dispositivo
+	device, err := h.deviceUseCase.RefreshDevice(r.Context(), mux.Vars(r)["device_id"], &entities.NotificationDeviceInput{
+		DeviceToken: req.DeviceToken,
+		DeviceType:  req.DeviceType,
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationDeviceToResponse(device))
+}
+
+// UnregisterDevice godoc
+// @Summary      This endpoint is used to unregister a push device
+// @Description  Remove a device of the authenticated user, for example on logout. The device stops receiving push notifications
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        device_id path string true "Device ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/devices/{device_id} [delete]
+func (h *NotificationDeviceHandler) UnregisterDevice(w http.ResponseWriter, r *http.Request) {
+	if err := h.deviceUseCase.UnregisterDevice(r.Context(), mux.Vars(r)["device_id"]); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "Device unregistered successfully",
+	})
+}
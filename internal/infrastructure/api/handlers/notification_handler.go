@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type NotificationHandler struct {
+	notificationUseCase ports.NotificationUseCase
+	respWriter          *responser.ResponseWriter
+}
+
+func NewNotificationHandler(notificationUseCase ports.NotificationUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUseCase: notificationUseCase,
+		respWriter:          responser.NewResponseWriter(),
+	}
+}
+
+// GetNotifications godoc
+// @Summary      This endpoint is used to list the notifications of the authenticated user
+// @Description  List the in-app notifications of the authenticated user, newest first. New notifications are also pushed over the WebSocket connection as NOTIFICATION messages
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        unread query bool false "Only unread notifications"
+// @Param        type query string false "Notification type (ORDER_CREATED, ORDER_STATUS_CHANGED, ORDER_DRIVER_ASSIGNED, ORDER_ASSIGNED_TO_DRIVER, ORDER_DELIVERED, ORDER_DELETED)"
+// @Param        page query int false "Page number"
+// @Param        page_size query int false "Page size (max 100)"
+// @Param        sort_direction query string false "Sort by date, asc or desc"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications [get]
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener las notificaciones y los parámetros de consulta
+	notifications, params, total, err := h.notificationUseCase.GetNotifications(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationsToResponse(notifications, params, total))
+}
+
+// GetUnreadCount godoc
+// @Summary      This endpoint is used to count the unread notifications
+// @Description  Get the number of unread notifications of the authenticated user, used for the notification badge
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.UnreadNotificationsResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	unread, err := h.notificationUseCase.GetUnreadCount(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.UnreadNotificationsResponse{UnreadCount: unread})
+}
+
+// MarkAsRead godoc
+// @Summary      This endpoint is used to mark a notification as read
+// @Description  Mark a notification of the authenticated user as read. Marking an already read notification keeps its original read date
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        notification_id path string true "Notification ID"
+// @Success      200  {object}  dto.NotificationResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/{notification_id}/read [patch]
+func (h *NotificationHandler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
+	notification, err := h.notificationUseCase.MarkAsRead(r.Context(), mux.Vars(r)["notification_id"])
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationToResponse(notification))
+}
+
+// MarkAllAsRead godoc
+// @Summary      This endpoint is used to mark all the notifications as read
+// @Description  Mark every unread notification of the authenticated user as read
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.MarkAllNotificationsReadResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/read-all [patch]
+func (h *NotificationHandler) MarkAllAsRead(w http.ResponseWriter, r *http.Request) {
+	updated, err := h.notificationUseCase.MarkAllAsRead(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.MarkAllNotificationsReadResponse{Updated: updated})
+}
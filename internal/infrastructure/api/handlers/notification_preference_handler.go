@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type NotificationPreferenceHandler struct {
+	preferenceUseCase ports.NotificationPreferenceUseCase
+	respWriter        *responser.ResponseWriter
+}
+
+func NewNotificationPreferenceHandler(preferenceUseCase ports.NotificationPreferenceUseCase) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{
+		preferenceUseCase: preferenceUseCase,
+		respWriter:        responser.NewResponseWriter(),
+	}
+}
+
+// GetUserPreferences godoc
+// @Summary      This endpoint is used to get the notification preferences of the authenticated user
+// @Description  Get the enabled channels of every notification type. Types the user has not configured use the company default or, without one, the system default. The source field tells which one applies
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.NotificationPreferenceResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/users/profile/notification-preferences [get]
+func (h *NotificationPreferenceHandler) GetUserPreferences(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.preferenceUseCase.GetUserPreferences(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationPreferencesToResponse(settings))
+}
+
+// UpdateUserPreferences godoc
+// @Summary      This endpoint is used to update the notification preferences of the authenticated user
+// @Description  Set the enabled channels of the given notification types. Types that are not sent keep their current values
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.NotificationPreferenceRequest true "Notification preferences"
+// @Success      200  {array}   dto.NotificationPreferenceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/users/profile/notification-preferences [put]
+func (h *NotificationPreferenceHandler) UpdateUserPreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener las preferencias
+	var req dto.NotificationPreferenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("NotificationPreferenceHandler", "UpdateUserPreferences", err))
+		return
+	}
+
+	// 2. Guardar las preferencias
+	settings, err := h.preferenceUseCase.UpdateUserPreferences(r.Context(), request_mapper.NotificationPreferenceRequestToSettings(&req))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationPreferencesToResponse(settings))
+}
+
+// GetCompanyPreferences godoc
+// @Summary      This endpoint is used to get the default notification preferences of the company
+// @Description  Get the default channels of every notification type for the users of the company. Admins can query another company with company_id
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID, only for admins"
+// @Success      200  {array}   dto.NotificationPreferenceResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/notification-preferences [get]
+func (h *NotificationPreferenceHandler) GetCompanyPreferences(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.preferenceUseCase.GetCompanyPreferences(r.Context(), r.URL.Query().Get("company_id"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationPreferencesToResponse(settings))
+}
+
+// UpdateCompanyPreferences godoc
+// @Summary      This endpoint is used to update the default notification preferences of the company
+// @Description  Set the default channels of the given notification types. They apply to the users of the company that have not configured the type. Admins can update another company with company_id
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID, only for admins"
+// @Param        request body dto.NotificationPreferenceRequest true "Default notification preferences"
+// @Success      200  {array}   dto.NotificationPreferenceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/notification-preferences [put]
+func (h *NotificationPreferenceHandler) UpdateCompanyPreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener las preferencias
+	var req dto.NotificationPreferenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("NotificationPreferenceHandler", "UpdateCompanyPreferences", err))
+		return
+	}
+
+	// 2. Guardar los valores por defecto de la empresa
+	settings, err := h.preferenceUseCase.UpdateCompanyPreferences(r.Context(), r.URL.Query().Get("company_id"), request_mapper.NotificationPreferenceRequestToSettings(&req))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapNotificationPreferencesToResponse(settings))
+}
@@ -70,6 +70,50 @@ func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	h.respWriter.Success(w, http.StatusCreated, "Order created successfully")
 }
 
+// QuoteOrder godoc
+// @Summary      This endpoint is used to quote the delivery price of an order before creating it
+// @Description  Calculate the delivery price with an itemized breakdown
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        quote body dto.OrderQuoteRequest true "Quote information"
+// @Success      200  {object}  dto.PriceQuoteResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/quote [post]
+func (h *OrderHandler) QuoteOrder(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los claims del contexto
+	claims, ok := r.Context().Value("claims").(*auth.AuthClaims)
+	if !ok {
+		logs.Error("Failed to retrieve claims from context", nil)
+		h.respWriter.Error(w, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.OrderQuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Llamar al caso de uso
+	quote, err := h.useCase.QuoteOrder(r.Context(), claims.UserID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 5. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.PriceQuoteToResponseDTO(quote))
+}
+
 // UpdateOrder godoc
 // @Summary      This endpoint is used to update an order by ID
 // @Description  Update order by ID
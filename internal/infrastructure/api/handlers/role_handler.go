@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"encoding/json"
 	"github.com/gorilla/mux"
 	"net/http"
 
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
 
 	_ "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
@@ -69,3 +72,295 @@ func (h *RoleHandler) GetRole(w http.ResponseWriter, r *http.Request) {
 
 	h.respWriter.Success(w, http.StatusOK, role)
 }
+
+// CreateRole godoc
+// @Summary Create a role
+// @Description Create a custom role, the name must be uppercase and cannot be changed later
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role body dto.RoleCreateRequest true "Role information"
+// @Success 201 {object} entities.Role
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 403 {object} responser.APIErrorResponse
+// @Router /api/v1/roles [post]
+func (h *RoleHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req dto.RoleCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Crear el rol
+	role := request_mapper.RoleRequestToRole(&req)
+	if err := h.roleUseCase.CreateRole(r.Context(), role); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusCreated, role)
+}
+
+// UpdateRole godoc
+// @Summary Update a role
+// @Description Update the description and status of a role
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role_id path string true "Role ID"
+// @Param role body dto.RoleUpdateRequest true "Role information"
+// @Success 200 {object} entities.Role
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/roles/{role_id} [put]
+func (h *RoleHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del rol y decodificar la solicitud
+	roleID := mux.Vars(r)["role_id"]
+	var req dto.RoleUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Actualizar el rol
+	role := request_mapper.RoleUpdateRequestToRole(roleID, &req)
+	if err := h.roleUseCase.UpdateRole(r.Context(), role); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, role)
+}
+
+// DeleteRole godoc
+// @Summary Delete a role
+// @Description Deactivate a custom role, system roles cannot be deleted
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role_id path string true "Role ID"
+// @Success 200 {string} string "Role deleted successfully"
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/roles/{role_id} [delete]
+func (h *RoleHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	roleID := mux.Vars(r)["role_id"]
+
+	if err := h.roleUseCase.DeleteRole(r.Context(), roleID); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Role deleted successfully")
+}
+
+// GetRolePermissions godoc
+// @Summary Get role permissions
+// @Description Get the permissions assigned to a role
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role_id path string true "Role ID"
+// @Success 200 {array} entities.Permission
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/roles/{role_id}/permissions [get]
+func (h *RoleHandler) GetRolePermissions(w http.ResponseWriter, r *http.Request) {
+	roleID := mux.Vars(r)["role_id"]
+
+	permissions, err := h.roleUseCase.GetRolePermissions(r.Context(), roleID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, permissions)
+}
+
+// AssignPermissionsToRole godoc
+// @Summary Assign permissions to a role
+// @Description Assign one or more permissions to a role
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role_id path string true "Role ID"
+// @Param permissions body dto.RolePermissionsRequest true "Permission IDs"
+// @Success 200 {string} string "Permissions assigned successfully"
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/roles/{role_id}/permissions [post]
+func (h *RoleHandler) AssignPermissionsToRole(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del rol y decodificar la solicitud
+	roleID := mux.Vars(r)["role_id"]
+	var req dto.RolePermissionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Asignar los permisos
+	if err := h.roleUseCase.AssignPermissionsToRole(r.Context(), roleID, req.PermissionIDs); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Permissions assigned successfully")
+}
+
+// RemovePermissionFromRole godoc
+// @Summary Remove a permission from a role
+// @Description Remove a permission assigned to a role
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role_id path string true "Role ID"
+// @Param permission_id path string true "Permission ID"
+// @Success 200 {string} string "Permission removed successfully"
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/roles/{role_id}/permissions/{permission_id} [delete]
+func (h *RoleHandler) RemovePermissionFromRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	if err := h.roleUseCase.RemovePermissionFromRole(r.Context(), vars["role_id"], vars["permission_id"]); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Permission removed successfully")
+}
+
+// GetPermissions godoc
+// @Summary Get all permissions
+// @Description Get all permissions
+// @Tags permissions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} entities.Permission
+// @Failure 400 {object} responser.APIErrorResponse
+// @Router /api/v1/permissions [get]
+func (h *RoleHandler) GetPermissions(w http.ResponseWriter, r *http.Request) {
+	permissions, err := h.roleUseCase.GetPermissions(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, permissions)
+}
+
+// GetPermission godoc
+// @Summary Get permission by ID
+// @Description Get permission by ID
+// @Tags permissions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param permission_id path string true "Permission ID"
+// @Success 200 {object} entities.Permission
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/permissions/{permission_id} [get]
+func (h *RoleHandler) GetPermission(w http.ResponseWriter, r *http.Request) {
+	permissionID := mux.Vars(r)["permission_id"]
+
+	permission, err := h.roleUseCase.GetPermissionByID(r.Context(), permissionID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, permission)
+}
+
+// CreatePermission godoc
+// @Summary Create a permission
+// @Description Create a permission identified by resource and action
+// @Tags permissions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param permission body dto.PermissionRequest true "Permission information"
+// @Success 201 {object} entities.Permission
+// @Failure 400 {object} responser.APIErrorResponse
+// @Router /api/v1/permissions [post]
+func (h *RoleHandler) CreatePermission(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req dto.PermissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Crear el permiso
+	permission := request_mapper.PermissionRequestToPermission("", &req)
+	if err := h.roleUseCase.CreatePermission(r.Context(), permission); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusCreated, permission)
+}
+
+// UpdatePermission godoc
+// @Summary Update a permission
+// @Description Update a permission, roles that have it assigned are refreshed
+// @Tags permissions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param permission_id path string true "Permission ID"
+// @Param permission body dto.PermissionRequest true "Permission information"
+// @Success 200 {object} entities.Permission
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/permissions/{permission_id} [put]
+func (h *RoleHandler) UpdatePermission(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del permiso y decodificar la solicitud
+	permissionID := mux.Vars(r)["permission_id"]
+	var req dto.PermissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Actualizar el permiso
+	permission := request_mapper.PermissionRequestToPermission(permissionID, &req)
+	if err := h.roleUseCase.UpdatePermission(r.Context(), permission); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, permission)
+}
+
+// DeletePermission godoc
+// @Summary Delete a permission
+// @Description Delete a permission and remove it from every role
+// @Tags permissions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param permission_id path string true "Permission ID"
+// @Success 200 {string} string "Permission deleted successfully"
+// @Failure 400 {object} responser.APIErrorResponse
+// @Failure 404 {object} responser.APIErrorResponse
+// @Router /api/v1/permissions/{permission_id} [delete]
+func (h *RoleHandler) DeletePermission(w http.ResponseWriter, r *http.Request) {
+	permissionID := mux.Vars(r)["permission_id"]
+
+	if err := h.roleUseCase.DeletePermission(r.Context(), permissionID); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Permission deleted successfully")
+}
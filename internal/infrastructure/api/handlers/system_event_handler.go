@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type SystemEventHandler struct {
+	systemEventUseCase ports.SystemEventUseCase
+	respWriter         *responser.ResponseWriter
+}
+
+func NewSystemEventHandler(systemEventUseCase ports.SystemEventUseCase) *SystemEventHandler {
+	return &SystemEventHandler{
+		systemEventUseCase: systemEventUseCase,
+		respWriter:         responser.NewResponseWriter(),
+	}
+}
+
+// GetSystemEvents godoc
+// @Summary      This endpoint is used to search the system events
+// @Description  List the events recorded by the platform components (failed logins, lockouts, dispatch failures, WebSocket disconnect storms, Redis outages) with their attached logs. Admins only
+// @Tags         system-events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        event_type query string false "Event type (LOGIN_FAILED, LOGIN_LOCKOUT, DISPATCH_FAILED, WEBSOCKET_DISCONNECT_STORM, CACHE_UNAVAILABLE, CACHE_RECOVERED)"
+// @Param        source query string false "Source (AUTH, DISPATCH, WEBSOCKET, CACHE)"
+// @Param        source_id query string false "ID of the user, order or resource related to the event"
+// @Param        severity query string false "Severity (INFO, WARNING, ERROR, CRITICAL)"
+// @Param        start_date query string false "Start date (RFC3339)"
+// @Param        end_date query string false "End date (RFC3339)"
+// @Param        page query int false "Page number"
+// @Param        page_size query int false "Page size (max 100)"
+// @Param        sort_direction query string false "Sort by date, asc or desc"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/system-events [get]
+func (h *SystemEventHandler) GetSystemEvents(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los eventos y los parámetros de consulta
+	events, params, total, err := h.systemEventUseCase.GetSystemEvents(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapSystemEventsToResponse(events, params, total))
+}
+
+// AggregateSystemEvents godoc
+// @Summary      This endpoint is used to count the system events by type and severity
+// @Description  Count the events that match the same filters as the search, grouped by event type and severity and sorted by count. Admins only
+// @Tags         system-events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        event_type query string false "Event type"
+// @Param        source query string false "Source (AUTH, DISPATCH, WEBSOCKET, CACHE)"
+// @Param        source_id query string false "ID of the user, order or resource related to the event"
+// @Param        severity query string false "Severity (INFO, WARNING, ERROR, CRITICAL)"
+// @Param        start_date query string false "Start date (RFC3339)"
+// @Param        end_date query string false "End date (RFC3339)"
+// @Success      200  {array}   dto.SystemEventSummaryResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/system-events/aggregate [get]
+func (h *SystemEventHandler) AggregateSystemEvents(w http.ResponseWriter, r *http.Request) {
+	// 1. Contar los eventos
+	summaries, err := h.systemEventUseCase.AggregateSystemEvents(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapSystemEventSummariesToResponse(summaries))
+}
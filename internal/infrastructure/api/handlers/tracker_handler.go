@@ -7,6 +7,7 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
 	"github.com/gorilla/mux"
 
 	_ "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
@@ -86,6 +87,32 @@ func (h *TrackerHandler) UpdateDriverLocation(w http.ResponseWriter, r *http.Req
 	h.respWriter.Success(w, http.StatusOK, "Ubicación actualizada correctamente")
 }
 
+// GetLocationHistory godoc
+// @Summary      Obtiene el recorrido del repartidor de un pedido
+// @Description  Retorna los puntos GPS registrados del pedido como un Feature GeoJSON con geometría LineString
+// @Tags         tracking
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "ID del pedido"
+// @Success      200  {object}  dto.LocationHistoryResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/tracking/history/{order_id} [get]
+func (h *TrackerHandler) GetLocationHistory(w http.ResponseWriter, r *http.Request) {
+	// Extraer el ID del pedido
+	orderID := mux.Vars(r)["order_id"]
+
+	// Obtener el recorrido
+	order, pings, err := h.useCase.GetLocationHistory(r.Context(), orderID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.LocationHistoryToGeoJSON(order, pings))
+}
+
 // LocationUpdateRequest representa la solicitud para actualizar ubicación
 type LocationUpdateRequest struct {
 	Latitude  float64 `json:"latitude"`
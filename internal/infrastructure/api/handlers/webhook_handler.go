@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+type WebhookHandler struct {
+	webhookUseCase ports.WebhookUseCase
+	respWriter     *responser.ResponseWriter
+}
+
+func NewWebhookHandler(webhookUseCase ports.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+		respWriter:     responser.NewResponseWriter(),
+	}
+}
+
+// CreateWebhook godoc
+// @Summary      This endpoint is used to register a webhook for order events
+// @Description  Register a URL that receives the selected order events of the company. Each delivery is signed with HMAC-SHA256 in the X-Delivery-Signature header (t=<unix>,v1=<hex of "<t>.<body>">). The secret is shown only once. Admins may set company_id
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.WebhookCreateRequest true "Webhook data"
+// @Success      201  {object}  dto.IssuedWebhookResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los datos del webhook
+	var req dto.WebhookCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("WebhookHandler", "CreateWebhook", err))
+		return
+	}
+
+	// 2. Registrar el webhook
+	issued, err := h.webhookUseCase.CreateSubscription(r.Context(), &entities.WebhookSubscriptionInput{
+		CompanyID: req.CompanyID,
+		URL:       req.URL,
+		Events:    req.Events,
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusCreated, dto.NewIssuedWebhookResponse(issued))
+}
+
+// ListWebhooks godoc
+// @Summary      This endpoint is used to list the webhooks of the company
+// @Description  List the webhooks of the company of the authenticated user. Admins may filter by company_id
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID (admins only)"
+// @Success      200  {array}   dto.WebhookResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	subscriptions, err := h.webhookUseCase.ListSubscriptions(r.Context(), r.URL.Query().Get("company_id"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewWebhookListResponse(subscriptions))
+}
+
+// DeleteWebhook godoc
+// @Summary      This endpoint is used to delete a webhook
+// @Description  Delete a webhook together with its delivery history, pending deliveries are discarded
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        subscription_id path string true "Webhook ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/webhooks/{subscription_id} [delete]
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	if err := h.webhookUseCase.DeleteSubscription(r.Context(), mux.Vars(r)["subscription_id"]); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "Webhook deleted successfully",
+	})
+}
+
+// ListWebhookDeliveries godoc
+// @Summary      This endpoint is used to list the latest deliveries of a webhook
+// @Description  List the latest 100 deliveries of a webhook with their status and number of attempts
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        subscription_id path string true "Webhook ID"
+// @Success      200  {array}   dto.WebhookDeliveryResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/webhooks/{subscription_id}/deliveries [get]
+func (h *WebhookHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	deliveries, err := h.webhookUseCase.ListDeliveries(r.Context(), mux.Vars(r)["subscription_id"])
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewWebhookDeliveryListResponse(deliveries))
+}
+
+// GetWebhookDelivery godoc
+// @Summary      This endpoint is used to get a webhook delivery
+// @Description  Get a delivery with the payload that was sent and the log of every attempt
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        delivery_id path string true "Delivery ID"
+// @Success      200  {object}  dto.WebhookDeliveryDetailResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/webhooks/deliveries/{delivery_id} [get]
+func (h *WebhookHandler) GetWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	delivery, err := h.webhookUseCase.GetDelivery(r.Context(), mux.Vars(r)["delivery_id"])
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, dto.NewWebhookDeliveryDetailResponse(delivery))
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary      This endpoint is used to replay a webhook delivery
+// @Description  Send the same event again in a new delivery. The event ID is kept so the receiver can discard duplicates
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        delivery_id path string true "Delivery ID"
+// @Success      202  {object}  dto.WebhookDeliveryResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/webhooks/deliveries/{delivery_id}/replay [post]
+func (h *WebhookHandler) ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	delivery, err := h.webhookUseCase.ReplayDelivery(r.Context(), mux.Vars(r)["delivery_id"])
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusAccepted, dto.NewWebhookDeliveryResponse(delivery))
+}
+
+// handleError responde 404 si el webhook o la entrega no existen o pertenecen a otra empresa
+func (h *WebhookHandler) handleError(w http.ResponseWriter, err error) {
+	for _, notFound := range []error{errPackage.ErrWebhookNotFound, errPackage.ErrWebhookDeliveryNotFound} {
+		if errors.Is(err, notFound) {
+			h.respWriter.Error(w, http.StatusNotFound, notFound.Error(), nil)
+			return
+		}
+	}
+
+	h.respWriter.HandleError(w, err)
+}
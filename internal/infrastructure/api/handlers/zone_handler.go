@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type ZoneHandler struct {
+	zoneUseCase ports.ZoneUseCase
+	respWriter  *responser.ResponseWriter
+}
+
+func NewZoneHandler(zoneUseCase ports.ZoneUseCase) *ZoneHandler {
+	return &ZoneHandler{
+		zoneUseCase: zoneUseCase,
+		respWriter:  responser.NewResponseWriter(),
+	}
+}
+
+// CreateZone godoc
+// @Summary      This endpoint is used to create a coverage zone
+// @Description  Create a zone from a closed polygon given as a GeoJSON object or a WKT string. The polygon must not intersect itself and its centroid is stored as the center point
+// @Tags         zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.ZoneRequest true "Zone data"
+// @Success      201  {object}  dto.ZoneResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/zones [post]
+func (h *ZoneHandler) CreateZone(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los datos de la zona
+	var req dto.ZoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("ZoneHandler", "CreateZone", err))
+		return
+	}
+
+	// 2. Crear la zona
+	zone, err := h.zoneUseCase.CreateZone(r.Context(), request_mapper.ZoneRequestToInput(&req))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.MapZoneToResponse(zone))
+}
+
+// GetZones godoc
+// @Summary      This endpoint is used to list the coverage zones
+// @Description  List the zones from the highest to the lowest priority. Inactive zones are only included when include_inactive is true
+// @Tags         zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        include_inactive query bool false "Include inactive zones"
+// @Success      200  {array}   dto.ZoneResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      403  {object}  responser.APIErrorResponse
+// @Router       /api/v1/zones [get]
+func (h *ZoneHandler) GetZones(w http.ResponseWriter, r *http.Request) {
+	includeInactive, _ := strconv.ParseBool(r.URL.Query().Get("include_inactive"))
+
+	zones, err := h.zoneUseCase.GetZones(r.Context(), includeInactive)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapZonesToResponse(zones))
+}
+
+// GetZone godoc
+// @Summary      This endpoint is used to get a coverage zone
+// @Description  Get a zone with its boundaries as GeoJSON and its center point
+// @Tags         zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        zone_id path string true "Zone ID"
+// @Success      200  {object}  dto.ZoneResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/zones/{zone_id} [get]
+func (h *ZoneHandler) GetZone(w http.ResponseWriter, r *http.Request) {
+	zone, err := h.zoneUseCase.GetZone(r.Context(), mux.Vars(r)["zone_id"])
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapZoneToResponse(zone))
+}
+
+// UpdateZone godoc
+// @Summary      This endpoint is used to update a coverage zone
+// @Description  Replace the data and boundaries of a zone, the center point is recalculated. Without is_active the current status is kept
+// @Tags         zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        zone_id path string true "Zone ID"
+// @Param        request body dto.ZoneRequest true "Zone data"
+// @Success      200  {object}  dto.ZoneResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/zones/{zone_id} [put]
+func (h *ZoneHandler) UpdateZone(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los datos de la zona
+	var req dto.ZoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respWriter.HandleError(w, errPackage.NewGeneralServiceError("ZoneHandler", "UpdateZone", err))
+		return
+	}
+
+	// 2. Actualizar la zona
+	zone, err := h.zoneUseCase.UpdateZone(r.Context(), mux.Vars(r)["zone_id"], request_mapper.ZoneRequestToInput(&req))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.MapZoneToResponse(zone))
+}
+
+// DeleteZone godoc
+// @Summary      This endpoint is used to delete a coverage zone
+// @Description  Deactivate a zone. Branches and drivers keep their reference to it but it no longer covers new orders
+// @Tags         zones
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        zone_id path string true "Zone ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/zones/{zone_id} [delete]
+func (h *ZoneHandler) DeleteZone(w http.ResponseWriter, r *http.Request) {
+	if err := h.zoneUseCase.DeleteZone(r.Context(), mux.Vars(r)["zone_id"]); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{
+		"message": "Zone deactivated successfully",
+	})
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/audit"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// EntityIDResolver obtiene de la petición el ID de la entidad que se va a modificar
+type EntityIDResolver func(r *http.Request) string
+
+// AuditSpec describe cómo se audita una ruta. Sin EntityID el ID se toma del registro que crea la petición.
+type AuditSpec struct {
+	Action     string
+	EntityType string
+	EntityID   EntityIDResolver
+}
+
+// Audit crea la especificación de auditoría de una ruta
+func Audit(action, entityType string, entityID EntityIDResolver) AuditSpec {
+	return AuditSpec{Action: action, EntityType: entityType, EntityID: entityID}
+}
+
+// PathParam toma el ID de la entidad de una variable de la ruta
+func PathParam(name string) EntityIDResolver {
+	return func(r *http.Request) string {
+		return mux.Vars(r)[name]
+	}
+}
+
+// ClaimsCompany toma el ID de la empresa del usuario autenticado, para las rutas de la empresa propia
+func ClaimsCompany(r *http.Request) string {
+	claims, ok := r.Context().Value("claims").(*auth.AuthClaims)
+	if !ok || claims == nil {
+		return ""
+	}
+	return claims.CompanyID
+}
+
+type AuditMiddleware struct {
+	auditLogger interfaces.AuditLogger
+}
+
+func NewAuditMiddleware(auditLogger interfaces.AuditLogger) *AuditMiddleware {
+	return &AuditMiddleware{
+		auditLogger: auditLogger,
+	}
+}
+
+// Record guarda el estado de la entidad antes y después del handler. Solo se registran las respuestas 2xx,
+// por eso debe ir dentro de Require: las peticiones rechazadas no generan lecturas ni registros.
+func (m *AuditMiddleware) Record(spec AuditSpec, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value("claims").(*auth.AuthClaims)
+		if !ok || claims == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// 1. Guardar el estado previo de la entidad
+		var entityID string
+		if spec.EntityID != nil {
+			entityID = spec.EntityID(r)
+		}
+		oldValues := m.auditLogger.Snapshot(r.Context(), spec.EntityType, entityID)
+
+		// 2. Ejecutar el handler, las creaciones completan el ID del objetivo al insertar el registro
+		target := audit.NewTarget(spec.EntityType, entityID)
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r.WithContext(audit.WithTarget(r.Context(), target)))
+
+		status := sw.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if status < 200 || status >= 300 {
+			return
+		}
+
+		// 3. Guardar el estado posterior, aunque el cliente ya haya cerrado la conexión
+		ctx := context.WithoutCancel(r.Context())
+		entityID = target.EntityID()
+		if entityID == "" {
+			logs.Warn("Audited request did not identify the entity", map[string]interface{}{
+				"action":     spec.Action,
+				"entityType": spec.EntityType,
+				"path":       r.URL.Path,
+			})
+			return
+		}
+
+		log := &entities.AuditLog{
+			CompanyID:  claims.CompanyID,
+			Action:     spec.Action,
+			EntityType: spec.EntityType,
+			EntityID:   entityID,
+			OldValues:  oldValues,
+			NewValues:  m.auditLogger.Snapshot(ctx, spec.EntityType, entityID),
+			IPAddress:  clientIP(r),
+			UserAgent:  r.UserAgent(),
+		}
+		if claims.IsAPIKey() {
+			log.APIKeyID = &claims.APIKeyID
+		} else {
+			log.UserID = &claims.UserID
+		}
+
+		if err := m.auditLogger.Record(ctx, log); err != nil {
+			logs.Error("Failed to record audit log", map[string]interface{}{
+				"action":     spec.Action,
+				"entityType": spec.EntityType,
+				"entityID":   entityID,
+				"error":      err.Error(),
+			})
+		}
+	}
+}
+
+// clientIP obtiene la IP del cliente, primero de los headers del proxy y luego de RemoteAddr
+func clientIP(r *http.Request) string {
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		return strings.TrimSpace(strings.Split(forwardedFor, ",")[0])
+	}
+
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return ip
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return ip
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gorilla/websocket"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
@@ -12,24 +13,41 @@ import (
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
+// sessionTouchTimeout limita el tiempo de la actualización de actividad, que se ejecuta fuera de la petición
+const sessionTouchTimeout = 5 * time.Second
+
+// APIKeyHeader es el header con el que las integraciones envían su API key
+const APIKeyHeader = "X-API-Key"
+
 type AuthMiddleware struct {
-	tokenService ports.TokenProvider
-	respWriter   *responser.ResponseWriter
+	tokenService  ports.TokenProvider
+	authService   ports.Authenticator
+	apiKeyService ports.APIKeyManager
+	respWriter    *responser.ResponseWriter
 }
 
-func NewAuthMiddleware(tokenService ports.TokenProvider) *AuthMiddleware {
+func NewAuthMiddleware(tokenService ports.TokenProvider, authService ports.Authenticator, apiKeyService ports.APIKeyManager) *AuthMiddleware {
 	return &AuthMiddleware{
-		tokenService: tokenService,
-		respWriter:   responser.NewResponseWriter(),
+		tokenService:  tokenService,
+		authService:   authService,
+		apiKeyService: apiKeyService,
+		respWriter:    responser.NewResponseWriter(),
 	}
 }
 
 // Handle del middleware permite validar el token de autorización.
 // Si el token es válido, se agrega al contexto de la petición.
+// Las integraciones pueden autenticarse con una API key en lugar del token.
 func (m *AuthMiddleware) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var tokenString string
 
+		// Las API keys no se aceptan en conexiones WebSocket
+		if apiKey := extractAPIKey(r); apiKey != "" && !websocket.IsWebSocketUpgrade(r) {
+			m.handleAPIKey(w, r, next, apiKey)
+			return
+		}
+
 		// Verificar si es una conexión WebSocket
 		if websocket.IsWebSocketUpgrade(r) {
 			// Para WebSocket, obtener el token del query string
@@ -79,7 +97,56 @@ func (m *AuthMiddleware) Handle(next http.Handler) http.Handler {
 			return
 		}
 
+		// Registrar la actividad de la sesión sin retrasar la petición
+		if claims.SessionID != "" {
+			go m.touchSession(claims.SessionID)
+		}
+
 		ctx := context.WithValue(r.Context(), "claims", claims)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// handleAPIKey valida la API key y agrega al contexto los claims de la identidad de integración
+func (m *AuthMiddleware) handleAPIKey(w http.ResponseWriter, r *http.Request, next http.Handler, apiKey string) {
+	claims, err := m.apiKeyService.Authenticate(r.Context(), apiKey)
+	if err != nil {
+		logs.Warn("Invalid API key", map[string]interface{}{
+			"error":  err.Error(),
+			"path":   r.URL.Path,
+			"method": r.Method,
+		})
+		m.respWriter.Error(w, http.StatusUnauthorized, errPackage.ErrInvalidAPIKey.Error(), nil)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), "claims", claims)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// extractAPIKey obtiene la API key del header X-API-Key o del header Authorization con el esquema ApiKey
+func extractAPIKey(r *http.Request) string {
+	if apiKey := strings.TrimSpace(r.Header.Get(APIKeyHeader)); apiKey != "" {
+		return apiKey
+	}
+
+	parts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(parts) == 2 && parts[0] == "ApiKey" {
+		return parts[1]
+	}
+
+	return ""
+}
+
+// touchSession actualiza la última actividad de la sesión asociada al token
+func (m *AuthMiddleware) touchSession(sessionID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), sessionTouchTimeout)
+	defer cancel()
+
+	if err := m.authService.TouchSession(ctx, sessionID); err != nil {
+		logs.Warn("Failed to update session activity", map[string]interface{}{
+			"session_id": sessionID,
+			"error":      err.Error(),
+		})
+	}
+}
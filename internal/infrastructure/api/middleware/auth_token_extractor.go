@@ -20,9 +20,12 @@ func (te *TokenExtractor) ExtractToken(next http.Handler) http.Handler {
 		if websocket.IsWebSocketUpgrade(r) {
 			tokenString = r.URL.Query().Get("token")
 		} else {
+			// Las peticiones con API key no tienen un token Bearer
 			authHeader := r.Header.Get("Authorization")
 			parts := strings.Split(authHeader, " ")
-			tokenString = parts[1]
+			if len(parts) == 2 && parts[0] == "Bearer" {
+				tokenString = parts[1]
+			}
 		}
 
 		// Almacenar el token en el contexto
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// Policy describe los requisitos de acceso de una ruta.
+// Basta con cumplir uno de ellos: tener alguno de los roles o alguno de los permisos.
+type Policy struct {
+	Roles       []string
+	Permissions []string
+}
+
+// AnyRole crea una política que permite el acceso a cualquiera de los roles indicados
+func AnyRole(roles ...string) Policy {
+	return Policy{Roles: roles}
+}
+
+// AnyPermission crea una política que permite el acceso con cualquiera de los permisos indicados
+func AnyPermission(permissions ...string) Policy {
+	return Policy{Permissions: permissions}
+}
+
+// AdminOr crea una política que permite el acceso a administradores o con cualquiera de los permisos indicados
+func AdminOr(permissions ...string) Policy {
+	return Policy{Roles: []string{constants.AdminRole}, Permissions: permissions}
+}
+
+type AuthorizationMiddleware struct {
+	permissionResolver ports.PermissionResolver
+	respWriter         *responser.ResponseWriter
+}
+
+func NewAuthorizationMiddleware(permissionResolver ports.PermissionResolver) *AuthorizationMiddleware {
+	return &AuthorizationMiddleware{
+		permissionResolver: permissionResolver,
+		respWriter:         responser.NewResponseWriter(),
+	}
+}
+
+// Require envuelve el handler y solo lo ejecuta si los claims de la petición cumplen la política.
+// Debe registrarse en rutas protegidas por AuthMiddleware, que es quien agrega los claims al contexto.
+func (m *AuthorizationMiddleware) Require(policy Policy, next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 1. Obtener los claims del contexto
+		claims, ok := r.Context().Value("claims").(*auth.AuthClaims)
+		if !ok || claims == nil {
+			logs.Warn("Missing claims in authorization middleware", map[string]interface{}{
+				"path":   r.URL.Path,
+				"method": r.Method,
+			})
+			m.respWriter.Error(w, http.StatusUnauthorized, errPackage.ErrClaimsNotFound.Error(), nil)
+			return
+		}
+
+		// 2. Verificar si el rol satisface la política directamente
+		for _, role := range policy.Roles {
+			if claims.Role == role {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		// 3. Verificar los permisos del rol, las API keys solo tienen los scopes que se les concedieron
+		if len(policy.Permissions) > 0 {
+			permissions, err := m.resolvePermissions(r, claims)
+			if err != nil {
+				logs.Error("Failed to resolve role permissions", map[string]interface{}{
+					"role":  claims.Role,
+					"error": err.Error(),
+				})
+				m.respWriter.Error(w, http.StatusInternalServerError, errPackage.ErrFailedToResolvePermissions.Error(), nil)
+				return
+			}
+
+			for _, permission := range policy.Permissions {
+				if permissions[permission] {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+		}
+
+		logs.Warn("Access denied by authorization policy", map[string]interface{}{
+			"userID":      claims.UserID,
+			"role":        claims.Role,
+			"path":        r.URL.Path,
+			"method":      r.Method,
+			"roles":       policy.Roles,
+			"permissions": policy.Permissions,
+		})
+		m.respWriter.Error(w, http.StatusForbidden, errPackage.ErrInsufficientPermissions.Error(), nil)
+	})
+}
+
+// UsersOnly rechaza las peticiones autenticadas con API key, se usa en las rutas de autoservicio de los usuarios
+func (m *AuthorizationMiddleware) UsersOnly(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value("claims").(*auth.AuthClaims)
+		if ok && claims != nil && claims.IsAPIKey() {
+			logs.Warn("API key used on a user-only route", map[string]interface{}{
+				"apiKeyID": claims.APIKeyID,
+				"path":     r.URL.Path,
+				"method":   r.Method,
+			})
+			m.respWriter.Error(w, http.StatusForbidden, errPackage.ErrAPIKeyNotAllowed.Error(), nil)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *AuthorizationMiddleware) resolvePermissions(r *http.Request, claims *auth.AuthClaims) (map[string]bool, error) {
+	if claims.IsAPIKey() {
+		permissions := make(map[string]bool, len(claims.Scopes))
+		for _, scope := range claims.Scopes {
+			permissions[scope] = true
+		}
+		return permissions, nil
+	}
+
+	return m.permissionResolver.GetRolePermissions(r.Context(), claims.Role)
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
@@ -51,6 +54,17 @@ func (w *ResponseWriter) Error(rw http.ResponseWriter, status int, message strin
 	})
 }
 
+// RetryAfter envía una respuesta 429 con el header Retry-After en segundos
+func (w *ResponseWriter) RetryAfter(rw http.ResponseWriter, retryAfter time.Duration, message string) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	rw.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.Error(rw, http.StatusTooManyRequests, message, nil)
+}
+
 // HandleError maneja los diferentes tipos de errores y envía una respuesta de error con el código de estado y el mensaje correspondiente.
 func (w *ResponseWriter) HandleError(rw http.ResponseWriter, err error) {
 	rw.Header().Set("Content-Type", "application/json")
@@ -141,6 +155,8 @@ func deriveErrorCode(status int) string {
 		return "NOT_FOUND"
 	case http.StatusMethodNotAllowed:
 		return "METHOD_NOT_ALLOWED"
+	case http.StatusTooManyRequests:
+		return "TOO_MANY_REQUESTS"
 	case http.StatusInternalServerError:
 		return "INTERNAL_SERVER_ERROR"
 	default:
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterAPIKeyRoutes registra las rutas de gestión de las API keys de integración
+func RegisterAPIKeyRoutes(router *mux.Router, handler *handlers.APIKeyHandler, authz *middleware.AuthorizationMiddleware) {
+	canManage := middleware.AdminOr(constants.PermissionAPIKeysManage)
+
+	router.Handle("/api-keys", authz.Require(canManage, handler.ListAPIKeys)).Methods(http.MethodGet)
+	router.Handle("/api-keys", authz.Require(canManage, handler.CreateAPIKey)).Methods(http.MethodPost)
+	router.Handle("/api-keys/{key_id}/rotate", authz.Require(canManage, handler.RotateAPIKey)).Methods(http.MethodPost)
+	router.Handle("/api-keys/{key_id}", authz.Require(canManage, handler.RevokeAPIKey)).Methods(http.MethodDelete)
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterAuditRoutes registra la consulta de la auditoría
+func RegisterAuditRoutes(router *mux.Router, handler *handlers.AuditHandler, authz *middleware.AuthorizationMiddleware) {
+	canRead := middleware.AdminOr(constants.PermissionAuditLogsRead)
+
+	router.Handle("/audit-logs", authz.Require(canRead, handler.GetAuditLogs)).Methods(http.MethodGet)
+}
@@ -2,14 +2,32 @@ package routes
 
 import (
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterPublicAuthRoutes(router *mux.Router, authHandler *handlers.AuthHandler) {
+func RegisterPublicAuthRoutes(router *mux.Router, authHandler *handlers.AuthHandler, accountHandler *handlers.AccountHandler) {
 	router.HandleFunc("/auth/login", authHandler.Login).Methods(http.MethodPost)
+	router.HandleFunc("/auth/refresh", authHandler.Refresh).Methods(http.MethodPost)
+	router.HandleFunc("/auth/mfa/verify", authHandler.VerifyMFA).Methods(http.MethodPost)
+	router.HandleFunc("/auth/mfa/challenge/enroll", authHandler.EnrollMFAChallenge).Methods(http.MethodPost)
+
+	router.HandleFunc("/auth/password/forgot", accountHandler.ForgotPassword).Methods(http.MethodPost)
+	router.HandleFunc("/auth/password/reset", accountHandler.ResetPassword).Methods(http.MethodPost)
+	router.HandleFunc("/auth/email/verification", accountHandler.RequestEmailVerification).Methods(http.MethodPost)
+	router.HandleFunc("/auth/email/verify", accountHandler.VerifyEmail).Methods(http.MethodPost)
 }
 
-func RegisterProtectedAuthRoutes(router *mux.Router, authHandler *handlers.AuthHandler) {
-	router.HandleFunc("/auth/logout", authHandler.Logout).Methods(http.MethodGet)
+func RegisterProtectedAuthRoutes(router *mux.Router, authHandler *handlers.AuthHandler, mfaHandler *handlers.MFAHandler, authz *middleware.AuthorizationMiddleware) {
+	// Las rutas de sesión y MFA son de autoservicio, no se permiten con API key
+	router.Handle("/auth/logout", authz.UsersOnly(authHandler.Logout)).Methods(http.MethodGet)
+	router.Handle("/auth/sessions", authz.UsersOnly(authHandler.GetSessions)).Methods(http.MethodGet)
+	router.Handle("/auth/sessions/{session_id}", authz.UsersOnly(authHandler.RevokeSession)).Methods(http.MethodDelete)
+
+	router.Handle("/auth/mfa", authz.UsersOnly(mfaHandler.GetStatus)).Methods(http.MethodGet)
+	router.Handle("/auth/mfa/enroll", authz.UsersOnly(mfaHandler.BeginEnrollment)).Methods(http.MethodPost)
+	router.Handle("/auth/mfa/enroll/confirm", authz.UsersOnly(mfaHandler.ConfirmEnrollment)).Methods(http.MethodPost)
+	router.Handle("/auth/mfa/recovery-codes", authz.UsersOnly(mfaHandler.RegenerateRecoveryCodes)).Methods(http.MethodPost)
+	router.Handle("/auth/mfa/disable", authz.UsersOnly(mfaHandler.Disable)).Methods(http.MethodPost)
 }
@@ -1,21 +1,27 @@
 package routes
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterBranchRoutes(router *mux.Router, branchHandler *handlers.BranchHandler) {
-	router.HandleFunc("/branches", branchHandler.GetBranches).Methods(http.MethodGet)
-	router.HandleFunc("/branches", branchHandler.CreateBranch).Methods(http.MethodPost)
-	router.HandleFunc("/branches/{branch_id}", branchHandler.GetBranchByID).Methods(http.MethodGet)
-	router.HandleFunc("/branches/{branch_id}", branchHandler.UpdateBranch).Methods(http.MethodPut)
-	router.HandleFunc("/branches/reactivate/{branch_id}", branchHandler.ReactivateBranch).Methods(http.MethodGet)
-	router.HandleFunc("/branches/deactivate/{branch_id}", branchHandler.DeactivateBranch).Methods(http.MethodGet)
+func RegisterBranchRoutes(router *mux.Router, branchHandler *handlers.BranchHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canRead := middleware.AdminOr(constants.PermissionBranchesRead)
+	canManage := middleware.AdminOr(constants.PermissionBranchesManage)
+	branchID := middleware.PathParam("branch_id")
 
-	router.HandleFunc("/branches/zones/{branch_id}", branchHandler.AssignZoneToBranch).Methods(http.MethodPost)
-	router.HandleFunc("/branches/available-zones/{branch_id}", branchHandler.GetAvailableZonesForBranch).Methods(http.MethodGet)
+	router.Handle("/branches", authz.Require(canRead, branchHandler.GetBranches)).Methods(http.MethodGet)
+	router.Handle("/branches", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityBranch, nil), branchHandler.CreateBranch))).Methods(http.MethodPost)
+	router.Handle("/branches/{branch_id}", authz.Require(canRead, branchHandler.GetBranchByID)).Methods(http.MethodGet)
+	router.Handle("/branches/{branch_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityBranch, branchID), branchHandler.UpdateBranch))).Methods(http.MethodPut)
+	router.Handle("/branches/reactivate/{branch_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionActivate, constants.AuditEntityBranch, branchID), branchHandler.ReactivateBranch))).Methods(http.MethodGet)
+	router.Handle("/branches/deactivate/{branch_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionDeactivate, constants.AuditEntityBranch, branchID), branchHandler.DeactivateBranch))).Methods(http.MethodGet)
 
-	router.HandleFunc("/branches/metrics/{branch_id}", branchHandler.GetBranchMetrics).Methods(http.MethodGet)
+	router.Handle("/branches/zones/{branch_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityBranch, branchID), branchHandler.AssignZoneToBranch))).Methods(http.MethodPost)
+	router.Handle("/branches/available-zones/{branch_id}", authz.Require(canRead, branchHandler.GetAvailableZonesForBranch)).Methods(http.MethodGet)
+
+	router.Handle("/branches/metrics/{branch_id}", authz.Require(canRead, branchHandler.GetBranchMetrics)).Methods(http.MethodGet)
 }
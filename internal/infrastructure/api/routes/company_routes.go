@@ -1,25 +1,31 @@
 package routes
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterCompanyRoutes(router *mux.Router, companyHandler *handlers.CompanyHandler) {
-	router.HandleFunc("/companies/profile", companyHandler.GetCompanyProfile).Methods(http.MethodGet)
-	router.HandleFunc("/companies/profile", companyHandler.UpdateCompany).Methods(http.MethodPut)
+func RegisterCompanyRoutes(router *mux.Router, companyHandler *handlers.CompanyHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canRead := middleware.AdminOr(constants.PermissionCompaniesRead)
+	canUpdate := middleware.AdminOr(constants.PermissionCompaniesUpdate)
+	addressID := middleware.PathParam("address_id")
 
-	router.HandleFunc("/companies/addresses", companyHandler.GetCompanyAddresses).Methods(http.MethodGet)
-	router.HandleFunc("/companies/addresses", companyHandler.AddCompanyAddress).Methods(http.MethodPost)
-	router.HandleFunc("/companies/addresses/{address_id}", companyHandler.UpdateCompanyAddress).Methods(http.MethodPut)
-	router.HandleFunc("/companies/addresses/{address_id}", companyHandler.DeleteCompanyAddress).Methods(http.MethodDelete)
+	router.Handle("/companies/profile", authz.Require(canRead, companyHandler.GetCompanyProfile)).Methods(http.MethodGet)
+	router.Handle("/companies/profile", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityCompany, middleware.ClaimsCompany), companyHandler.UpdateCompany))).Methods(http.MethodPut)
 
-	router.HandleFunc("/companies/deactivate", companyHandler.DeactivateCompany).Methods(http.MethodPost)
-	router.HandleFunc("/companies/reactivate", companyHandler.ReactivateCompany).Methods(http.MethodPost)
+	router.Handle("/companies/addresses", authz.Require(canRead, companyHandler.GetCompanyAddresses)).Methods(http.MethodGet)
+	router.Handle("/companies/addresses", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityCompanyAddress, nil), companyHandler.AddCompanyAddress))).Methods(http.MethodPost)
+	router.Handle("/companies/addresses/{address_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityCompanyAddress, addressID), companyHandler.UpdateCompanyAddress))).Methods(http.MethodPut)
+	router.Handle("/companies/addresses/{address_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionDelete, constants.AuditEntityCompanyAddress, addressID), companyHandler.DeleteCompanyAddress))).Methods(http.MethodDelete)
 
-	router.HandleFunc("/companies/metrics", companyHandler.GetCompanyMetrics).Methods(http.MethodGet)
+	router.Handle("/companies/deactivate", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionDeactivate, constants.AuditEntityCompany, middleware.ClaimsCompany), companyHandler.DeactivateCompany))).Methods(http.MethodPost)
+	router.Handle("/companies/reactivate", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionActivate, constants.AuditEntityCompany, middleware.ClaimsCompany), companyHandler.ReactivateCompany))).Methods(http.MethodPost)
 
-	router.HandleFunc("/companies", companyHandler.CreateCompany).Methods(http.MethodPost)
-	router.HandleFunc("/companies", companyHandler.GetCompanies).Methods(http.MethodGet)
+	router.Handle("/companies/metrics", authz.Require(canRead, companyHandler.GetCompanyMetrics)).Methods(http.MethodGet)
+
+	router.Handle("/companies", authz.Require(middleware.AdminOr(constants.PermissionCompaniesCreate), audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityCompany, nil), companyHandler.CreateCompany))).Methods(http.MethodPost)
+	router.Handle("/companies", authz.Require(middleware.AdminOr(constants.PermissionCompaniesList), companyHandler.GetCompanies)).Methods(http.MethodGet)
 }
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterDispatchRoutes(router *mux.Router, dispatchHandler *handlers.DispatchHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canDispatch := middleware.AdminOr(constants.PermissionOrdersDispatch)
+
+	router.Handle("/orders/dispatch", authz.Require(canDispatch, dispatchHandler.DispatchPendingOrders)).Methods(http.MethodPost)
+	router.Handle("/orders/dispatch/{order_id}", authz.Require(canDispatch, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityOrder, middleware.PathParam("order_id")), dispatchHandler.DispatchOrder))).Methods(http.MethodPost)
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterDriverRoutes(router *mux.Router, driverHandler *handlers.DriverHandler, authz *middleware.AuthorizationMiddleware) {
+	canRead := middleware.AdminOr(constants.PermissionDriversRead)
+	canManage := middleware.AdminOr(constants.PermissionDriversManage)
+
+	router.Handle("/drivers", authz.Require(canRead, driverHandler.GetDrivers)).Methods(http.MethodGet)
+	router.Handle("/drivers", authz.Require(canManage, driverHandler.CreateDriver)).Methods(http.MethodPost)
+	router.Handle("/drivers/{driver_id}", authz.Require(canRead, driverHandler.GetDriverByID)).Methods(http.MethodGet)
+	router.Handle("/drivers/{driver_id}", authz.Require(canManage, driverHandler.UpdateDriver)).Methods(http.MethodPut)
+	router.Handle("/drivers/reactivate/{driver_id}", authz.Require(canManage, driverHandler.ReactivateDriver)).Methods(http.MethodPost)
+	router.Handle("/drivers/deactivate/{driver_id}", authz.Require(canManage, driverHandler.DeactivateDriver)).Methods(http.MethodPost)
+
+	router.Handle("/drivers/zones/{driver_id}", authz.Require(canRead, driverHandler.GetDriverZones)).Methods(http.MethodGet)
+	router.Handle("/drivers/zones/{driver_id}", authz.Require(canManage, driverHandler.AssignZonesToDriver)).Methods(http.MethodPut)
+}
@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterJWKSRoutes registra la ruta pública con las claves de verificación de los tokens
+func RegisterJWKSRoutes(router *mux.Router, handler *handlers.JWKSHandler) {
+	router.HandleFunc("/.well-known/jwks.json", handler.GetJWKS).Methods(http.MethodGet)
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterNotificationRoutes registra la bandeja de notificaciones, los dispositivos push y las preferencias,
+// cada usuario accede solo a los suyos y las API keys no tienen bandeja
+func RegisterNotificationRoutes(router *mux.Router, handler *handlers.NotificationHandler, deviceHandler *handlers.NotificationDeviceHandler, preferenceHandler *handlers.NotificationPreferenceHandler, authz *middleware.AuthorizationMiddleware) {
+	canManage := middleware.AdminOr(constants.PermissionNotificationsManage)
+
+	router.Handle("/notifications", authz.UsersOnly(handler.GetNotifications)).Methods(http.MethodGet)
+	router.Handle("/notifications/unread-count", authz.UsersOnly(handler.GetUnreadCount)).Methods(http.MethodGet)
+	router.Handle("/notifications/read-all", authz.UsersOnly(handler.MarkAllAsRead)).Methods(http.MethodPatch)
+	router.Handle("/notifications/{notification_id}/read", authz.UsersOnly(handler.MarkAsRead)).Methods(http.MethodPatch)
+
+	router.Handle("/notifications/devices", authz.UsersOnly(deviceHandler.RegisterDevice)).Methods(http.MethodPost)
+	router.Handle("/notifications/devices/{device_id}", authz.UsersOnly(deviceHandler.RefreshDevice)).Methods(http.MethodPut)
+	router.Handle("/notifications/devices/{device_id}", authz.UsersOnly(deviceHandler.UnregisterDevice)).Methods(http.MethodDelete)
+
+	router.Handle("/users/profile/notification-preferences", authz.UsersOnly(preferenceHandler.GetUserPreferences)).Methods(http.MethodGet)
+	router.Handle("/users/profile/notification-preferences", authz.UsersOnly(preferenceHandler.UpdateUserPreferences)).Methods(http.MethodPut)
+	router.Handle("/companies/notification-preferences", authz.Require(canManage, preferenceHandler.GetCompanyPreferences)).Methods(http.MethodGet)
+	router.Handle("/companies/notification-preferences", authz.Require(canManage, preferenceHandler.UpdateCompanyPreferences)).Methods(http.MethodPut)
+}
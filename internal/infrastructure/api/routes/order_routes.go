@@ -1,17 +1,26 @@
 package routes
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterOrderRoutes(router *mux.Router, orderHandler *handlers.OrderHandler) {
-	router.HandleFunc("/orders", orderHandler.CreateOrder).Methods(http.MethodPost)
-	router.HandleFunc("/orders", orderHandler.GetOrdersByCompany).Methods(http.MethodGet)
-	router.HandleFunc("/orders/{order_id}", orderHandler.GetOrderByID).Methods(http.MethodGet)
-	router.HandleFunc("/orders/{order_id}", orderHandler.DeleteOrder).Methods(http.MethodDelete)
-	router.HandleFunc("/orders/{order_id}", orderHandler.ChangeOrderStatus).Methods(http.MethodPatch)
-	router.HandleFunc("/orders/{order_id}", orderHandler.UpdateOrder).Methods(http.MethodPut)
-	router.HandleFunc("/orders/recovery/{order_id}", orderHandler.RestoreOrder).Methods(http.MethodGet)
+func RegisterOrderRoutes(router *mux.Router, orderHandler *handlers.OrderHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canCreate := middleware.AdminOr(constants.PermissionOrdersCreate)
+	canRead := middleware.AdminOr(constants.PermissionOrdersRead)
+	canUpdate := middleware.AdminOr(constants.PermissionOrdersUpdate)
+	canDelete := middleware.AdminOr(constants.PermissionOrdersDelete)
+	orderID := middleware.PathParam("order_id")
+
+	router.Handle("/orders", authz.Require(canCreate, audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityOrder, nil), orderHandler.CreateOrder))).Methods(http.MethodPost)
+	router.Handle("/orders", authz.Require(canRead, orderHandler.GetOrdersByCompany)).Methods(http.MethodGet)
+	router.Handle("/orders/quote", authz.Require(canCreate, orderHandler.QuoteOrder)).Methods(http.MethodPost)
+	router.Handle("/orders/{order_id}", authz.Require(canRead, orderHandler.GetOrderByID)).Methods(http.MethodGet)
+	router.Handle("/orders/{order_id}", authz.Require(canDelete, audit.Record(middleware.Audit(constants.AuditActionDelete, constants.AuditEntityOrder, orderID), orderHandler.DeleteOrder))).Methods(http.MethodDelete)
+	router.Handle("/orders/{order_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityOrder, orderID), orderHandler.ChangeOrderStatus))).Methods(http.MethodPatch)
+	router.Handle("/orders/{order_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityOrder, orderID), orderHandler.UpdateOrder))).Methods(http.MethodPut)
+	router.Handle("/orders/recovery/{order_id}", authz.Require(canDelete, audit.Record(middleware.Audit(constants.AuditActionRestore, constants.AuditEntityOrder, orderID), orderHandler.RestoreOrder))).Methods(http.MethodGet)
 }
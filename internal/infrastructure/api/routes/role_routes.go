@@ -1,12 +1,32 @@
 package routes
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterRoleRoutes(router *mux.Router, roleHandler *handlers.RoleHandler) {
-	router.HandleFunc("/roles", roleHandler.GetRoles).Methods(http.MethodGet)
-	router.HandleFunc("/roles/{role}", roleHandler.GetRole).Methods(http.MethodGet)
+func RegisterRoleRoutes(router *mux.Router, roleHandler *handlers.RoleHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canRead := middleware.AdminOr(constants.PermissionRolesRead)
+	canManage := middleware.AdminOr(constants.PermissionRolesManage)
+	roleID := middleware.PathParam("role_id")
+	permissionID := middleware.PathParam("permission_id")
+
+	router.Handle("/roles", authz.Require(canRead, roleHandler.GetRoles)).Methods(http.MethodGet)
+	router.Handle("/roles", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityRole, nil), roleHandler.CreateRole))).Methods(http.MethodPost)
+	router.Handle("/roles/{role}", authz.Require(canRead, roleHandler.GetRole)).Methods(http.MethodGet)
+	router.Handle("/roles/{role_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityRole, roleID), roleHandler.UpdateRole))).Methods(http.MethodPut)
+	router.Handle("/roles/{role_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionDelete, constants.AuditEntityRole, roleID), roleHandler.DeleteRole))).Methods(http.MethodDelete)
+
+	router.Handle("/roles/{role_id}/permissions", authz.Require(canRead, roleHandler.GetRolePermissions)).Methods(http.MethodGet)
+	router.Handle("/roles/{role_id}/permissions", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionAssign, constants.AuditEntityRole, roleID), roleHandler.AssignPermissionsToRole))).Methods(http.MethodPost)
+	router.Handle("/roles/{role_id}/permissions/{permission_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionUnassign, constants.AuditEntityRole, roleID), roleHandler.RemovePermissionFromRole))).Methods(http.MethodDelete)
+
+	router.Handle("/permissions", authz.Require(canRead, roleHandler.GetPermissions)).Methods(http.MethodGet)
+	router.Handle("/permissions", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityPermission, nil), roleHandler.CreatePermission))).Methods(http.MethodPost)
+	router.Handle("/permissions/{permission_id}", authz.Require(canRead, roleHandler.GetPermission)).Methods(http.MethodGet)
+	router.Handle("/permissions/{permission_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityPermission, permissionID), roleHandler.UpdatePermission))).Methods(http.MethodPut)
+	router.Handle("/permissions/{permission_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionDelete, constants.AuditEntityPermission, permissionID), roleHandler.DeletePermission))).Methods(http.MethodDelete)
 }
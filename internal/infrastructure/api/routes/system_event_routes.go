@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterSystemEventRoutes registra la consulta de los eventos del sistema, son de toda la plataforma
+// y no de una empresa, por eso solo los administradores pueden consultarlos
+func RegisterSystemEventRoutes(router *mux.Router, handler *handlers.SystemEventHandler, authz *middleware.AuthorizationMiddleware) {
+	adminOnly := middleware.AnyRole(constants.AdminRole)
+
+	router.Handle("/system-events", authz.Require(adminOnly, handler.GetSystemEvents)).Methods(http.MethodGet)
+	router.Handle("/system-events/aggregate", authz.Require(adminOnly, handler.AggregateSystemEvents)).Methods(http.MethodGet)
+}
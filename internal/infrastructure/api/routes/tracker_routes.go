@@ -1,12 +1,17 @@
 package routes
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
+	"net/http"
 )
 
 // RegisterTrackerRoutes registra las rutas relacionadas con el tracking de pedidos
-func RegisterTrackerRoutes(router *mux.Router, handler *handlers.TrackerHandler) {
-	router.HandleFunc("/tracking/ws", handler.HandleWebSocket).Methods("GET")
-	router.HandleFunc("/tracking/location/{order_id}", handler.UpdateDriverLocation).Methods("POST")
+func RegisterTrackerRoutes(router *mux.Router, handler *handlers.TrackerHandler, authz *middleware.AuthorizationMiddleware) {
+	// Las suscripciones por WebSocket se autorizan por pedido dentro del hub
+	router.HandleFunc("/tracking/ws", handler.HandleWebSocket).Methods(http.MethodGet)
+	router.Handle("/tracking/location/{order_id}", authz.Require(middleware.AdminOr(constants.PermissionTrackingUpdate), handler.UpdateDriverLocation)).Methods(http.MethodPost)
+	router.Handle("/tracking/history/{order_id}", authz.Require(middleware.AdminOr(constants.PermissionTrackingRead), handler.GetLocationHistory)).Methods(http.MethodGet)
 }
@@ -1,25 +1,32 @@
 package routes
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterUserRoutes(router *mux.Router, userHandler *handlers.UserHandler) {
-	router.HandleFunc("/users/roles/{user_id}", userHandler.GetUserRoles).Methods(http.MethodGet)
-	router.HandleFunc("/users/roles/{user_id}", userHandler.AssignRoleToUser).Methods(http.MethodPost)
-	router.HandleFunc("/users/roles/{user_id}", userHandler.UnassignRole).Methods(http.MethodDelete)
+func RegisterUserRoutes(router *mux.Router, userHandler *handlers.UserHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canManageRoles := middleware.AdminOr(constants.PermissionUsersRoles)
+	canRead := middleware.AdminOr(constants.PermissionUsersRead)
+	canUpdate := middleware.AdminOr(constants.PermissionUsersUpdate)
+	userID := middleware.PathParam("user_id")
 
-	router.HandleFunc("/users/recover/{user_id}", userHandler.RecoverUser).Methods(http.MethodGet)
-	router.HandleFunc("/users/sessions/{user_id}", userHandler.CleanAllSessions).Methods(http.MethodDelete)
+	router.Handle("/users/roles/{user_id}", authz.Require(canManageRoles, userHandler.GetUserRoles)).Methods(http.MethodGet)
+	router.Handle("/users/roles/{user_id}", authz.Require(canManageRoles, audit.Record(middleware.Audit(constants.AuditActionAssign, constants.AuditEntityUser, userID), userHandler.AssignRoleToUser))).Methods(http.MethodPost)
+	router.Handle("/users/roles/{user_id}", authz.Require(canManageRoles, audit.Record(middleware.Audit(constants.AuditActionUnassign, constants.AuditEntityUser, userID), userHandler.UnassignRole))).Methods(http.MethodDelete)
 
-	router.HandleFunc("/users", userHandler.CreateUser).Methods(http.MethodPost)
-	router.HandleFunc("/users", userHandler.GetAllUsers).Methods(http.MethodGet)
-	router.HandleFunc("/users/profile", userHandler.GetUserProfile).Methods(http.MethodGet)
+	router.Handle("/users/recover/{user_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionRestore, constants.AuditEntityUser, userID), userHandler.RecoverUser))).Methods(http.MethodGet)
+	router.Handle("/users/sessions/{user_id}", authz.Require(canUpdate, userHandler.CleanAllSessions)).Methods(http.MethodDelete)
 
-	router.HandleFunc("/users/{user_id}", userHandler.GetUserByID).Methods(http.MethodGet)
-	router.HandleFunc("/users/{user_id}", userHandler.UpdateUser).Methods(http.MethodPut)
-	router.HandleFunc("/users/{user_id}", userHandler.ActivateOrDeactivateUser).Methods(http.MethodPatch)
-	router.HandleFunc("/users/{user_id}", userHandler.DeleteUser).Methods(http.MethodDelete)
+	router.Handle("/users", authz.Require(middleware.AdminOr(constants.PermissionUsersCreate), audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityUser, nil), userHandler.CreateUser))).Methods(http.MethodPost)
+	router.Handle("/users", authz.Require(canRead, userHandler.GetAllUsers)).Methods(http.MethodGet)
+	router.Handle("/users/profile", authz.UsersOnly(userHandler.GetUserProfile)).Methods(http.MethodGet)
+
+	router.Handle("/users/{user_id}", authz.Require(canRead, userHandler.GetUserByID)).Methods(http.MethodGet)
+	router.Handle("/users/{user_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityUser, userID), userHandler.UpdateUser))).Methods(http.MethodPut)
+	router.Handle("/users/{user_id}", authz.Require(canUpdate, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityUser, userID), userHandler.ActivateOrDeactivateUser))).Methods(http.MethodPatch)
+	router.Handle("/users/{user_id}", authz.Require(middleware.AdminOr(constants.PermissionUsersDelete), audit.Record(middleware.Audit(constants.AuditActionDelete, constants.AuditEntityUser, userID), userHandler.DeleteUser))).Methods(http.MethodDelete)
 }
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterWebhookRoutes registra las rutas de gestión de los webhooks de pedidos
+func RegisterWebhookRoutes(router *mux.Router, handler *handlers.WebhookHandler, authz *middleware.AuthorizationMiddleware) {
+	canManage := middleware.AdminOr(constants.PermissionWebhooksManage)
+
+	router.Handle("/webhooks", authz.Require(canManage, handler.ListWebhooks)).Methods(http.MethodGet)
+	router.Handle("/webhooks", authz.Require(canManage, handler.CreateWebhook)).Methods(http.MethodPost)
+	router.Handle("/webhooks/deliveries/{delivery_id}", authz.Require(canManage, handler.GetWebhookDelivery)).Methods(http.MethodGet)
+	router.Handle("/webhooks/deliveries/{delivery_id}/replay", authz.Require(canManage, handler.ReplayWebhookDelivery)).Methods(http.MethodPost)
+	router.Handle("/webhooks/{subscription_id}", authz.Require(canManage, handler.DeleteWebhook)).Methods(http.MethodDelete)
+	router.Handle("/webhooks/{subscription_id}/deliveries", authz.Require(canManage, handler.ListWebhookDeliveries)).Methods(http.MethodGet)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterZoneRoutes registra las rutas de gestión de las zonas de cobertura
+func RegisterZoneRoutes(router *mux.Router, handler *handlers.ZoneHandler, authz *middleware.AuthorizationMiddleware, audit *middleware.AuditMiddleware) {
+	canRead := middleware.AdminOr(constants.PermissionZonesRead)
+	canManage := middleware.AdminOr(constants.PermissionZonesManage)
+	zoneID := middleware.PathParam("zone_id")
+
+	router.Handle("/zones", authz.Require(canRead, handler.GetZones)).Methods(http.MethodGet)
+	router.Handle("/zones", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionCreate, constants.AuditEntityZone, nil), handler.CreateZone))).Methods(http.MethodPost)
+	router.Handle("/zones/{zone_id}", authz.Require(canRead, handler.GetZone)).Methods(http.MethodGet)
+	router.Handle("/zones/{zone_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityZone, zoneID), handler.UpdateZone))).Methods(http.MethodPut)
+	router.Handle("/zones/{zone_id}", authz.Require(canManage, audit.Record(middleware.Audit(constants.AuditActionDeactivate, constants.AuditEntityZone, zoneID), handler.DeleteZone))).Methods(http.MethodDelete)
+}
@@ -1,9 +1,11 @@
 package server
 
 import (
+	"context"
 	"github.com/MarlonG1/delivery-backend/configs"
 	"github.com/MarlonG1/delivery-backend/internal/bootstrap"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/routes"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/workers"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 	"github.com/gorilla/mux"
 	"net/http"
@@ -36,6 +38,7 @@ func (s *Server) Start() error {
 	}
 
 	s.configureRoutes()
+	s.startWorkers()
 	server := &http.Server{
 		Handler:      s.router,
 		Addr:         ":" + s.config.Server.Port,
@@ -57,6 +60,7 @@ func (s *Server) Start() error {
 func (s *Server) configureRoutes() {
 	s.configureGlobalMiddlewares()
 	routes.RegisterSwaggerRoutes(s.router)
+	routes.RegisterJWKSRoutes(s.router, s.container.GetHandlerContainer().GetJWKSHandler())
 	s.configureGlobalOptions()
 
 	public := s.router.PathPrefix(s.publicPath).Subrouter()
@@ -72,19 +76,51 @@ func (s *Server) configureRoutes() {
 }
 
 func (s *Server) configurePublicRoutes(router *mux.Router) {
-	routes.RegisterPublicAuthRoutes(router, s.container.GetHandlerContainer().GetAuthHandler())
+	routes.RegisterPublicAuthRoutes(router, s.container.GetHandlerContainer().GetAuthHandler(), s.container.GetHandlerContainer().GetAccountHandler())
 }
 
 func (s *Server) configureProtectedRoutes(router *mux.Router) {
 	s.configureProtectedMiddlewares(router)
 
-	routes.RegisterProtectedAuthRoutes(router, s.container.GetHandlerContainer().GetAuthHandler())
-	routes.RegisterUserRoutes(router, s.container.GetHandlerContainer().GetUserHandler())
-	routes.RegisterOrderRoutes(router, s.container.GetHandlerContainer().GetOrderHandler())
-	routes.RegisterRoleRoutes(router, s.container.GetHandlerContainer().GetRoleHandler())
-	routes.RegisterCompanyRoutes(router, s.container.GetHandlerContainer().GetCompanyHandler())
-	routes.RegisterBranchRoutes(router, s.container.GetHandlerContainer().GetBranchHandler())
-	routes.RegisterTrackerRoutes(router, s.container.GetHandlerContainer().GetTrackerHandler())
+	authz := s.container.GetMiddlewareContainer().GetAuthorizationMiddleware()
+	audit := s.container.GetMiddlewareContainer().GetAuditMiddleware()
+
+	routes.RegisterProtectedAuthRoutes(router, s.container.GetHandlerContainer().GetAuthHandler(), s.container.GetHandlerContainer().GetMFAHandler(), authz)
+	routes.RegisterUserRoutes(router, s.container.GetHandlerContainer().GetUserHandler(), authz, audit)
+	routes.RegisterOrderRoutes(router, s.container.GetHandlerContainer().GetOrderHandler(), authz, audit)
+	routes.RegisterRoleRoutes(router, s.container.GetHandlerContainer().GetRoleHandler(), authz, audit)
+	routes.RegisterCompanyRoutes(router, s.container.GetHandlerContainer().GetCompanyHandler(), authz, audit)
+	routes.RegisterBranchRoutes(router, s.container.GetHandlerContainer().GetBranchHandler(), authz, audit)
+	routes.RegisterZoneRoutes(router, s.container.GetHandlerContainer().GetZoneHandler(), authz, audit)
+	routes.RegisterTrackerRoutes(router, s.container.GetHandlerContainer().GetTrackerHandler(), authz)
+	routes.RegisterDriverRoutes(router, s.container.GetHandlerContainer().GetDriverHandler(), authz)
+	routes.RegisterDispatchRoutes(router, s.container.GetHandlerContainer().GetDispatchHandler(), authz, audit)
+	routes.RegisterAPIKeyRoutes(router, s.container.GetHandlerContainer().GetAPIKeyHandler(), authz)
+	routes.RegisterWebhookRoutes(router, s.container.GetHandlerContainer().GetWebhookHandler(), authz)
+	routes.RegisterAuditRoutes(router, s.container.GetHandlerContainer().GetAuditHandler(), authz)
+	routes.RegisterSystemEventRoutes(router, s.container.GetHandlerContainer().GetSystemEventHandler(), authz)
+	routes.RegisterNotificationRoutes(router, s.container.GetHandlerContainer().GetNotificationHandler(), s.container.GetHandlerContainer().GetNotificationDeviceHandler(), s.container.GetHandlerContainer().GetNotificationPreferenceHandler(), authz)
+}
+
+// startWorkers inicia los procesos en segundo plano que dependen del contenedor
+func (s *Server) startWorkers() {
+	dispatchWorker := workers.NewDispatchWorker(
+		s.container.GetServiceContainer().GetDispatcher(),
+		time.Duration(s.config.Dispatch.IntervalSeconds)*time.Second,
+	)
+	go dispatchWorker.Run(context.Background())
+
+	webhookWorker := workers.NewWebhookWorker(
+		s.container.GetServiceContainer().GetWebhookService(),
+		time.Duration(s.config.Webhook.WorkerIntervalSeconds)*time.Second,
+	)
+	go webhookWorker.Run(context.Background())
+
+	outboxWorker := workers.NewOutboxRelayWorker(
+		s.container.GetServiceContainer().GetOutboxRelay(),
+		time.Duration(s.config.Outbox.RelayIntervalMs)*time.Millisecond,
+	)
+	go outboxWorker.Run(context.Background())
 }
 
 func (s *Server) configureGlobalOptions() {
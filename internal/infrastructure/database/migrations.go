@@ -8,11 +8,17 @@ import (
 	"gorm.io/gorm/schema"
 )
 
-// RunMigrations ejecuta todas las migraciones de la base de datos
+// RunMigrations ejecuta todas las migraciones de la base de datos y crea los permisos que falten
 func RunMigrations(db *gorm.DB) error {
-	return HandleCircularDependencies(db, func() error {
+	err := HandleCircularDependencies(db, func() error {
 		return migrateAllEntities(db)
 	})
+	if err != nil {
+		return err
+	}
+
+	logs.Info("Sembrando permisos por defecto...")
+	return SeedPermissions(db)
 }
 
 // migrateAllEntities migra todas las entidades usando un enfoque por fases
@@ -34,6 +40,9 @@ func migrateAllEntities(db *gorm.DB) error {
 		&entities.RolePermission{},
 		&entities.UserRole{},
 		&entities.UserSession{},
+		&entities.SessionRefreshToken{},
+		&entities.UserMFA{},
+		&entities.MFARecoveryCode{},
 
 		// Modelos base geográficos
 		&entities.Zone{},
@@ -45,6 +54,7 @@ func migrateAllEntities(db *gorm.DB) error {
 		&entities.CompanyAddress{},
 		&entities.Branch{},
 		&entities.CompanyUser{},
+		&entities.CompanyAPIKey{},
 	}
 
 	if err := migrateModels(db, baseModels, "base"); err != nil {
@@ -84,6 +94,7 @@ func migrateAllEntities(db *gorm.DB) error {
 		&entities.Tracking{},
 		&entities.QRCode{},
 		&entities.StatusHistory{},
+		&entities.LocationPing{},
 	}
 
 	if err := migrateModels(db, orderModels, "órdenes"); err != nil {
@@ -108,9 +119,14 @@ func migrateAllEntities(db *gorm.DB) error {
 		&entities.NotificationTemplate{},
 		&entities.NotificationDevice{},
 		&entities.NotificationPreference{},
+		&entities.CompanyNotificationPreference{},
 		&entities.AuditLog{},
 		&entities.SystemEvent{},
 		&entities.EventLog{},
+		&entities.WebhookSubscription{},
+		&entities.WebhookDelivery{},
+		&entities.WebhookDeliveryAttempt{},
+		&entities.OutboxEvent{},
 	}
 
 	return migrateModels(db, notificationModels, "notificaciones")
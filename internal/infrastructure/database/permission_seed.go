@@ -0,0 +1,111 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// permissionSeed describe un permiso del sistema y los roles que lo reciben por defecto
+type permissionSeed struct {
+	ID          string
+	Name        string
+	Description string
+	Roles       []string
+}
+
+// permissionSeeds son los permisos que usan las políticas de las rutas, con los mismos IDs que scripts/database_script.sql.
+// El rol ADMIN tiene acceso completo sin necesidad de permisos
+var permissionSeeds = []permissionSeed{
+	{"972a7f19-2157-5a13-900a-88991e556b6b", constants.PermissionCompaniesCreate, "Crear empresas", nil},
+	{"86d25571-6aae-5774-a85a-e976a013eb45", constants.PermissionCompaniesList, "Listar empresas", nil},
+	{"dbb79c37-4e50-57c7-b004-3a6176b162d4", constants.PermissionCompaniesRead, "Consultar la empresa propia", []string{constants.CompanyUser}},
+	{"762e53c8-be76-55ba-bff4-8b5cfce98138", constants.PermissionCompaniesUpdate, "Actualizar la empresa propia", []string{constants.CompanyUser}},
+	{"ab3cd2fe-59b9-53ec-832c-d877457e01ff", constants.PermissionBranchesRead, "Consultar sucursales", []string{constants.CompanyUser}},
+	{"899c42d3-8d15-5d1b-8c5b-a33fba35a134", constants.PermissionBranchesManage, "Gestionar sucursales", []string{constants.CompanyUser}},
+	{"eb3c6663-35b6-5c3b-b62f-6b1629d83cd4", constants.PermissionOrdersCreate, "Crear y cotizar pedidos", []string{constants.CompanyUser}},
+	{"4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f", constants.PermissionOrdersRead, "Consultar pedidos", []string{constants.CompanyUser, constants.Driver, constants.WarehouseStaff, constants.Collector, constants.FinalUser}},
+	{"91778558-50ab-588a-925f-7412dd78b65a", constants.PermissionOrdersUpdate, "Actualizar pedidos y su estado", []string{constants.CompanyUser, constants.Driver, constants.WarehouseStaff, constants.Collector}},
+	{"56899b47-dff3-5b4e-849f-e84e4be10ae5", constants.PermissionOrdersDelete, "Eliminar y restaurar pedidos", []string{constants.CompanyUser}},
+	{"07b3e371-50d6-5c22-b1d2-5495ce390f1f", constants.PermissionOrdersDispatch, "Asignar repartidores a pedidos", nil},
+	{"6cd8977f-cc2d-517a-a3de-57e5226d7f4d", constants.PermissionDriversRead, "Consultar repartidores", []string{constants.CompanyUser}},
+	{"2a809578-d8ee-5987-b8c5-a317045a54d6", constants.PermissionDriversManage, "Gestionar repartidores", nil},
+	{"22a3989e-cb66-53d3-8da4-dc4c8a88892a", constants.PermissionTrackingRead, "Consultar el seguimiento de pedidos", []string{constants.CompanyUser, constants.Driver, constants.WarehouseStaff, constants.Collector, constants.FinalUser}},
+	{"66bbcbad-f1d8-56e9-8f30-6f9cd70322bc", constants.PermissionTrackingUpdate, "Reportar la ubicación del repartidor", []string{constants.Driver, constants.Collector}},
+	{"98f926fc-c168-5aed-98b9-e37e3148628b", constants.PermissionUsersCreate, "Crear usuarios", []string{constants.CompanyUser}},
+	{"439606ce-e48b-5708-be19-7831971b0681", constants.PermissionUsersRead, "Consultar usuarios", []string{constants.CompanyUser}},
+	{"ab21c518-7fe2-56a8-8c09-d2a526cc2e0a", constants.PermissionUsersUpdate, "Actualizar usuarios", []string{constants.CompanyUser}},
+	{"ec59c47b-910a-539b-bc72-0f41743f0054", constants.PermissionUsersDelete, "Eliminar usuarios", nil},
+	{"6a44d09e-b225-512d-8753-767cbaf6b354", constants.PermissionUsersRoles, "Gestionar los roles de usuarios", nil},
+	{"5fbd4b65-43e3-53e6-8a1a-3317b08dd8b7", constants.PermissionRolesRead, "Consultar roles", []string{constants.CompanyUser}},
+	{"81dc7366-3464-5c1b-976d-3ac57f07157b", constants.PermissionRolesManage, "Gestionar roles y permisos", nil},
+	{"616b427b-7011-5393-a9e4-040ecbfa660d", constants.PermissionAPIKeysManage, "Gestionar las API keys de la empresa", []string{constants.CompanyUser}},
+	{"9b722bf7-9d25-500a-a211-afb17b3bc53e", constants.PermissionWebhooksManage, "Gestionar los webhooks de la empresa", []string{constants.CompanyUser}},
+	{"150f6e27-77ca-521c-b744-3a7f3da7d348", constants.PermissionAuditLogsRead, "Consultar la auditoría de la empresa", []string{constants.CompanyUser}},
+	{"7ce48cd9-4604-5241-8854-46d7b2a96dad", constants.PermissionNotificationsManage, "Gestionar las preferencias de notificación de la empresa", []string{constants.CompanyUser}},
+	{"4dc9e284-9d8a-5bc6-bd48-66eb191b0206", constants.PermissionZonesRead, "Consultar las zonas de cobertura", []string{constants.CompanyUser}},
+	{"aa395c24-0b0f-567c-87fc-e81549c600e2", constants.PermissionZonesManage, "Gestionar las zonas de cobertura", nil},
+}
+
+// SeedPermissions crea los permisos que aún no existen y los asigna a sus roles por defecto. Es idempotente:
+// los permisos existentes no se modifican y sus asignaciones no se vuelven a crear, así un permiso retirado
+// de un rol no reaparece al reiniciar
+func SeedPermissions(db *gorm.DB) error {
+	// 1. Obtener los roles existentes por nombre
+	var roles []entities.Role
+	if err := db.Find(&roles).Error; err != nil {
+		return fmt.Errorf("error loading roles: %w", err)
+	}
+	roleIDs := make(map[string]string, len(roles))
+	for _, role := range roles {
+		roleIDs[role.Name] = role.ID
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, seed := range permissionSeeds {
+			// 2. Crear el permiso solo si no existe uno con el mismo nombre
+			var count int64
+			if err := tx.Model(&entities.Permission{}).Where("name = ?", seed.Name).Count(&count).Error; err != nil {
+				return fmt.Errorf("error checking permission %s: %w", seed.Name, err)
+			}
+			if count > 0 {
+				continue
+			}
+
+			resource, action, _ := strings.Cut(seed.Name, ":")
+			permission := entities.Permission{ID: seed.ID, Name: seed.Name, Description: seed.Description, Resource: resource, Action: action}
+			if err := tx.Create(&permission).Error; err != nil {
+				return fmt.Errorf("error seeding permission %s: %w", seed.Name, err)
+			}
+
+			logs.Info(fmt.Sprintf("Permiso creado: %s", seed.Name))
+
+			// 3. Asignar el permiso nuevo a sus roles por defecto
+			for _, roleName := range seed.Roles {
+				roleID, ok := roleIDs[roleName]
+				if !ok {
+					logs.Warn("Rol no encontrado, no se asignó el permiso por defecto", map[string]interface{}{
+						"role":       roleName,
+						"permission": seed.Name,
+					})
+					continue
+				}
+
+				err := tx.Clauses(clause.OnConflict{DoNothing: true}).Omit("Role", "Permission").Create(&entities.RolePermission{
+					RoleID:       roleID,
+					PermissionID: permission.ID,
+				}).Error
+				if err != nil {
+					return fmt.Errorf("error assigning permission %s to role %s: %w", seed.Name, roleName, err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
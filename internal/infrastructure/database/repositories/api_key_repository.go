@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) ports.APIKeyRepository {
+	return &apiKeyRepository{
+		db: db,
+	}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *entities.CompanyAPIKey) error {
+	return r.db.WithContext(ctx).Create(key).Error
+}
+
+func (r *apiKeyRepository) GetByID(ctx context.Context, id string) (*entities.CompanyAPIKey, error) {
+	var key entities.CompanyAPIKey
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&key).Error; err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*entities.CompanyAPIKey, error) {
+	var key entities.CompanyAPIKey
+	if err := r.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// ListByCompany obtiene las keys de la empresa, incluidas las revocadas, de la más reciente a la más antigua
+func (r *apiKeyRepository) ListByCompany(ctx context.Context, companyID string) ([]entities.CompanyAPIKey, error) {
+	var keys []entities.CompanyAPIKey
+	err := r.db.WithContext(ctx).
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&keys).Error
+
+	return keys, err
+}
+
+// Rotate reemplaza el secreto de una key activa, la key anterior deja de funcionar de inmediato
+func (r *apiKeyRepository) Rotate(ctx context.Context, id, prefix, keyHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.CompanyAPIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"prefix":       prefix,
+			"key_hash":     keyHash,
+			"last_used_at": nil,
+			"updated_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.CompanyAPIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at": at,
+			"updated_at": at,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time, minInterval time.Duration) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.CompanyAPIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, at.Add(-minInterval)).
+		Update("last_used_at", at).Error
+}
@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/audit"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+// auditedEntity indica la tabla de cada tipo de entidad auditada y las columnas que no se guardan
+type auditedEntity struct {
+	table   string
+	omit    []string
+	related func(tx *gorm.DB, id string) (string, interface{}, error)
+}
+
+var auditedEntities = map[string]auditedEntity{
+	constants.AuditEntityUser:           {table: "users", omit: []string{"password_hash"}, related: userRoleIDs},
+	constants.AuditEntityCompany:        {table: "companies"},
+	constants.AuditEntityCompanyAddress: {table: "company_addresses", omit: []string{"location"}},
+	constants.AuditEntityBranch:         {table: "company_branches"},
+	constants.AuditEntityOrder:          {table: "orders"},
+	constants.AuditEntityRole:           {table: "roles", related: rolePermissionIDs},
+	constants.AuditEntityPermission:     {table: "permissions"},
+	constants.AuditEntityZone:           {table: "zones", omit: []string{"boundaries", "center_point"}},
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) ports.AuditLogRepository {
+	return &auditLogRepository{
+		db: db,
+	}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, log *entities.AuditLog) error {
+	return r.db.WithContext(ctx).Omit("User").Create(log).Error
+}
+
+func (r *auditLogRepository) GetAuditLogs(ctx context.Context, params *entities.AuditLogQueryParams) ([]entities.AuditLog, int64, error) {
+	var auditLogs []entities.AuditLog
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.AuditLog{})
+	if params.CompanyID != "" {
+		query = query.Where("company_id = ?", params.CompanyID)
+	}
+	if params.UserID != "" {
+		query = query.Where("user_id = ?", params.UserID)
+	}
+	if params.EntityType != "" {
+		query = query.Where("entity_type = ?", params.EntityType)
+	}
+	if params.EntityID != "" {
+		query = query.Where("entity_id = ?", params.EntityID)
+	}
+	if params.Action != "" {
+		query = query.Where("action = ?", params.Action)
+	}
+	if params.StartDate != nil {
+		query = query.Where("created_at >= ?", params.StartDate)
+	}
+	if params.EndDate != nil {
+		query = query.Where("created_at <= ?", params.EndDate)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params.Page > 0 && params.PageSize > 0 {
+		query = query.Offset((params.Page - 1) * params.PageSize).Limit(params.PageSize)
+	}
+
+	direction := "DESC"
+	if params.SortDirection == "asc" {
+		direction = "ASC"
+	}
+
+	err := query.Preload("User").Order("created_at " + direction).Find(&auditLogs).Error
+	return auditLogs, total, err
+}
+
+// GetEntitySnapshot lee la fila de la entidad directamente de su tabla, sin relaciones,
+// y le agrega los IDs relacionados que cambian desde las mismas rutas (roles de usuario, permisos de rol)
+func (r *auditLogRepository) GetEntitySnapshot(ctx context.Context, entityType, entityID string) (*string, error) {
+	// 1. Obtener la tabla de la entidad
+	entity, ok := auditedEntities[entityType]
+	if !ok {
+		return nil, fmt.Errorf("entity type %s is not audited", entityType)
+	}
+
+	// 2. Leer la fila actual
+	var rows []map[string]interface{}
+	tx := r.db.WithContext(ctx)
+	if err := tx.Table(entity.table).Where("id = ?", entityID).Limit(1).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	for _, column := range entity.omit {
+		delete(row, column)
+	}
+
+	// 3. Agregar las relaciones de la entidad
+	if entity.related != nil {
+		key, value, err := entity.related(tx, entityID)
+		if err != nil {
+			return nil, err
+		}
+		row[key] = value
+	}
+
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := string(data)
+	return &snapshot, nil
+}
+
+func userRoleIDs(tx *gorm.DB, userID string) (string, interface{}, error) {
+	var roleIDs []string
+	err := tx.Table("user_roles").Where("user_id = ? AND is_active = ?", userID, true).Order("role_id").Pluck("role_id", &roleIDs).Error
+	return "role_ids", roleIDs, err
+}
+
+func rolePermissionIDs(tx *gorm.DB, roleID string) (string, interface{}, error) {
+	var permissionIDs []string
+	err := tx.Table("role_permissions").Where("role_id = ?", roleID).Order("permission_id").Pluck("permission_id", &permissionIDs).Error
+	return "permission_ids", permissionIDs, err
+}
+
+// RegisterAuditCallbacks registra el callback que captura el ID de las entidades creadas en peticiones auditadas.
+// Los handlers de creación no devuelven el ID, así la auditoría no depende de cada respuesta.
+func RegisterAuditCallbacks(db *gorm.DB) error {
+	return db.Callback().Create().After("gorm:create").Register("audit:capture_created_id", captureCreatedID)
+}
+
+func captureCreatedID(db *gorm.DB) {
+	// 1. Verificar que la petición se audite y aún no tenga el ID
+	if db.Error != nil || db.Statement.Schema == nil {
+		return
+	}
+	target := audit.TargetFromContext(db.Statement.Context)
+	if target == nil || target.EntityID() != "" {
+		return
+	}
+
+	// 2. Solo se toma el registro de la tabla de la entidad auditada
+	entity, ok := auditedEntities[target.EntityType()]
+	if !ok || db.Statement.Schema.Table != entity.table || db.Statement.ReflectValue.Kind() != reflect.Struct {
+		return
+	}
+
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	if field == nil {
+		return
+	}
+	if value, isZero := field.ValueOf(db.Statement.Context, db.Statement.ReflectValue); !isZero {
+		target.CaptureID(fmt.Sprint(value))
+	}
+}
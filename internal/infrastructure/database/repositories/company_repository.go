@@ -31,13 +31,28 @@ func (r *CompanyRepository) GetCompanyAddresses(ctx context.Context, companyID s
 	return companyAddresses, nil
 }
 
-func (r *CompanyRepository) GetCompanyAddressByID(ctx context.Context, companyID, id string) (*entities.CompanyAddress, error) {
+func (r *CompanyRepository) GetCompanyAddressByID(ctx context.Context, id, companyID string) (*entities.CompanyAddress, error) {
 	var companyAddress entities.CompanyAddress
 	err := r.db.WithContext(ctx).First(&companyAddress, "id = ? AND company_id = ?", id, companyID).Error
 	if err != nil {
 		return nil, err
 	}
 
+	// Las coordenadas no se mapean directamente desde la columna espacial
+	var coordinates struct {
+		Lat float64
+		Lng float64
+	}
+	err = r.db.WithContext(ctx).Raw(
+		"SELECT ST_Y(location) as lat, ST_X(location) as lng FROM company_addresses WHERE id = ? AND location IS NOT NULL",
+		id,
+	).Scan(&coordinates).Error
+	if err != nil {
+		return nil, err
+	}
+	companyAddress.Latitude = coordinates.Lat
+	companyAddress.Longitude = coordinates.Lng
+
 	return &companyAddress, nil
 }
 
@@ -216,6 +231,17 @@ func (r *CompanyRepository) GetBranchesByZone(ctx context.Context, zoneID string
 	return branches, nil
 }
 
+func (r *CompanyRepository) GetZoneCoverage(ctx context.Context, zoneID string) (*entities.Coverage, error) {
+	var coverage entities.Coverage
+	err := r.db.WithContext(ctx).
+		First(&coverage, "zone_id = ?", zoneID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &coverage, nil
+}
+
 func (r *CompanyRepository) AddCompanyAddress(ctx context.Context, address *entities.CompanyAddress) error {
 	return r.db.WithContext(ctx).Create(address).Error
 }
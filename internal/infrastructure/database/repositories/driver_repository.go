@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type DriverRepository struct {
+	db *gorm.DB
+}
+
+func NewDriverRepository(db *gorm.DB) ports.DriverRepository {
+	return &DriverRepository{
+		db: db,
+	}
+}
+
+func (r *DriverRepository) CreateDriver(ctx context.Context, driver *entities.Driver) error {
+	return r.db.WithContext(ctx).Omit("User", "DriverZones", "Availability", "Orders").Create(driver).Error
+}
+
+func (r *DriverRepository) GetDriverByID(ctx context.Context, driverID string) (*entities.Driver, error) {
+	var driver entities.Driver
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("DriverZones", "is_active = ?", true).
+		Preload("DriverZones.Zone").
+		First(&driver, "user_id = ?", driverID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &driver, nil
+}
+
+func (r *DriverRepository) GetDrivers(ctx context.Context, params *entities.DriverQueryParams) ([]entities.Driver, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Driver{})
+
+	// Aplicar filtros
+	if params.Name != "" {
+		query = query.Joins("JOIN users ON users.id = drivers.user_id").
+			Where("users.full_name LIKE ?", "%"+params.Name+"%")
+	}
+	if params.LicenseNumber != "" {
+		query = query.Where("drivers.license_number = ?", params.LicenseNumber)
+	}
+	if params.VehiclePlate != "" {
+		query = query.Where("drivers.vehicle_plate = ?", params.VehiclePlate)
+	}
+	if params.VehicleType != "" {
+		query = query.Where("drivers.vehicle_type = ?", params.VehicleType)
+	}
+	if params.ZoneID != "" {
+		query = query.Where("drivers.user_id IN (?)",
+			r.db.Model(&entities.DriverZone{}).Select("driver_id").Where("zone_id = ? AND is_active = ?", params.ZoneID, true))
+	}
+	if params.IsActive != nil {
+		query = query.Where("drivers.is_active = ?", *params.IsActive)
+	}
+
+	// Contar total de items antes de la paginación
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Aplicar ordenamiento
+	if params.SortBy != "" {
+		order := "DESC"
+		if params.SortDirection == "asc" {
+			order = "ASC"
+		}
+		query = query.Order("drivers." + params.SortBy + " " + order)
+	} else {
+		query = query.Order("drivers.created_at DESC") // Ordenamiento por defecto
+	}
+
+	// Aplicar paginación
+	query = query.Offset((params.Page - 1) * params.PageSize).Limit(params.PageSize)
+
+	// Cargar relaciones
+	query = query.Preload("User").
+		Preload("DriverZones", "is_active = ?", true).
+		Preload("DriverZones.Zone")
+
+	var drivers []entities.Driver
+	if err := query.Find(&drivers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return drivers, total, nil
+}
+
+func (r *DriverRepository) UpdateDriver(ctx context.Context, driver *entities.Driver) error {
+	return r.db.WithContext(ctx).Model(&entities.Driver{}).
+		Where("user_id = ?", driver.UserID).
+		Omit("User", "DriverZones", "Availability", "Orders").
+		Updates(driver).Error
+}
+
+func (r *DriverRepository) ActivateOrDeactivate(ctx context.Context, driverID string, active bool) error {
+	return r.db.WithContext(ctx).Model(&entities.Driver{}).
+		Where("user_id = ?", driverID).
+		Updates(map[string]interface{}{
+			"is_active":  active,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// Métodos para verificaciones
+func (r *DriverRepository) ExistsDriver(ctx context.Context, driverID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.Driver{}).
+		Where("user_id = ?", driverID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *DriverRepository) ExistsLicenseNumber(ctx context.Context, licenseNumber string, excludeID string) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&entities.Driver{}).
+		Where("license_number = ?", licenseNumber)
+
+	if excludeID != "" {
+		query = query.Where("user_id != ?", excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *DriverRepository) ExistsVehiclePlate(ctx context.Context, vehiclePlate string, excludeID string) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&entities.Driver{}).
+		Where("vehicle_plate = ?", vehiclePlate)
+
+	if excludeID != "" {
+		query = query.Where("user_id != ?", excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Métodos para zonas
+func (r *DriverRepository) GetDriverZones(ctx context.Context, driverID string) ([]entities.DriverZone, error) {
+	var zones []entities.DriverZone
+	err := r.db.WithContext(ctx).
+		Preload("Zone").
+		Where("driver_id = ? AND is_active = ?", driverID, true).
+		Order("is_primary DESC").
+		Find(&zones).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// ReplaceDriverZones reemplaza las zonas activas del repartidor conservando el historial de las existentes
+func (r *DriverRepository) ReplaceDriverZones(ctx context.Context, driverID string, zones []entities.DriverZone) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Desactivar todas las zonas actuales
+		if err := tx.Model(&entities.DriverZone{}).
+			Where("driver_id = ?", driverID).
+			Updates(map[string]interface{}{
+				"is_active":  false,
+				"is_primary": false,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 2. Activar o crear las zonas solicitadas
+		for _, zone := range zones {
+			result := tx.Model(&entities.DriverZone{}).
+				Where("driver_id = ? AND zone_id = ?", driverID, zone.ZoneID).
+				Updates(map[string]interface{}{
+					"is_active":  true,
+					"is_primary": zone.IsPrimary,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected == 0 {
+				if err := tx.Omit("Driver", "Zone").Create(&zone).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetDispatchCandidates obtiene los repartidores disponibles en su turno que cubren la zona indicada,
+// ya sea porque se encuentran en ella o porque la tienen asignada
+func (r *DriverRepository) GetDispatchCandidates(ctx context.Context, zoneID string, maxActiveOrders int, at time.Time) ([]entities.DispatchCandidate, error) {
+	var candidates []entities.DispatchCandidate
+	err := r.db.WithContext(ctx).
+		Table("driver_availability AS da").
+		Select(`da.driver_id, da.current_zone_id,
+			ST_Y(da.current_location) AS latitude, ST_X(da.current_location) AS longitude,
+			da.active_orders, d.rating,
+			COALESCE(dz.is_primary, false) AS is_primary_zone,
+			COALESCE(dz.efficiency_rating, 0) AS efficiency_rating`).
+		Joins("JOIN drivers d ON d.user_id = da.driver_id").
+		Joins("LEFT JOIN driver_zones dz ON dz.driver_id = da.driver_id AND dz.zone_id = ? AND dz.is_active = ?", zoneID, true).
+		Where("d.is_active = ?", true).
+		Where("da.status = ? AND da.can_take_orders = ?", constants.DriverStatusAvailable, true).
+		Where("da.active_orders < ?", maxActiveOrders).
+		Where("da.shift_start <= ? AND da.shift_end >= ?", at, at).
+		Where("(da.current_zone_id = ? OR dz.zone_id IS NOT NULL)", zoneID).
+		Scan(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range candidates {
+		candidates[i].ZoneID = zoneID
+	}
+
+	return candidates, nil
+}
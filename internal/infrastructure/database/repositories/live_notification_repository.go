@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	wsModels "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/websocket"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// LiveNotificationRepository implementa el puerto LiveNotificationRepository utilizando el hub de WebSocket
+type LiveNotificationRepository struct {
+	hub *websocket.Hub
+}
+
+// NewLiveNotificationRepository crea una nueva instancia del repositorio de notificaciones en vivo
+func NewLiveNotificationRepository(hub *websocket.Hub) ports.LiveNotificationRepository {
+	return &LiveNotificationRepository{
+		hub: hub,
+	}
+}
+
+// SendNotification envía la notificación a las conexiones del usuario en cualquier réplica
+func (r *LiveNotificationRepository) SendNotification(userID string, data *wsModels.NotificationData) error {
+	logs.Info("Sending notification through live notification repository", map[string]interface{}{
+		"user_id":         userID,
+		"notification_id": data.ID,
+	})
+
+	r.hub.SendNotification(userID, data)
+	return nil
+}
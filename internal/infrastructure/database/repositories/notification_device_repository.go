@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+type notificationDeviceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationDeviceRepository(db *gorm.DB) ports.NotificationDeviceRepository {
+	return &notificationDeviceRepository{
+		db: db,
+	}
+}
+
+func (r *notificationDeviceRepository) Create(ctx context.Context, device *entities.NotificationDevice) error {
+	return r.db.WithContext(ctx).Omit("User").Create(device).Error
+}
+
+func (r *notificationDeviceRepository) Update(ctx context.Context, device *entities.NotificationDevice) error {
+	return r.db.WithContext(ctx).Omit("User").Save(device).Error
+}
+
+func (r *notificationDeviceRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.NotificationDevice{}).Error
+}
+
+func (r *notificationDeviceRepository) GetByID(ctx context.Context, id string) (*entities.NotificationDevice, error) {
+	var device entities.NotificationDevice
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&device).Error; err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+func (r *notificationDeviceRepository) GetByToken(ctx context.Context, token string) (*entities.NotificationDevice, error) {
+	var device entities.NotificationDevice
+	if err := r.db.WithContext(ctx).Where("device_token = ?", token).First(&device).Error; err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+func (r *notificationDeviceRepository) GetActiveByUser(ctx context.Context, userID string) ([]entities.NotificationDevice, error) {
+	var devices []entities.NotificationDevice
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND is_active = ?", userID, true).
+		Order("created_at ASC").
+		Find(&devices).Error
+	return devices, err
+}
+
+func (r *notificationDeviceRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.NotificationDevice{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_used_at":  usedAt,
+			"failure_count": 0,
+		}).Error
+}
+
+func (r *notificationDeviceRepository) IncrementFailures(ctx context.Context, id string) (int, error) {
+	var failures int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entities.NotificationDevice{}).
+			Where("id = ?", id).
+			Update("failure_count", gorm.Expr("failure_count + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&entities.NotificationDevice{}).
+			Where("id = ?", id).
+			Select("failure_count").
+			Scan(&failures).Error
+	})
+	return failures, err
+}
+
+func (r *notificationDeviceRepository) Deactivate(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.NotificationDevice{}).
+		Where("id = ?", id).
+		Update("is_active", false).Error
+}
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) ports.NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+func (r *notificationRepository) GetActiveTemplateByType(ctx context.Context, notificationType string) (*entities.NotificationTemplate, error) {
+	var template entities.NotificationTemplate
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND is_active = ?", notificationType, true).
+		Order("updated_at DESC").
+		First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (r *notificationRepository) GetPreference(ctx context.Context, userID, notificationType string) (*entities.NotificationPreference, error) {
+	var preference entities.NotificationPreference
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND notification_type = ?", userID, notificationType).
+		First(&preference).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+func (r *notificationRepository) GetCompanyPreference(ctx context.Context, companyID, notificationType string) (*entities.CompanyNotificationPreference, error) {
+	var preference entities.CompanyNotificationPreference
+	err := r.db.WithContext(ctx).
+		Where("company_id = ? AND notification_type = ?", companyID, notificationType).
+		First(&preference).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+func (r *notificationRepository) GetUserPreferences(ctx context.Context, userID string) ([]entities.NotificationPreference, error) {
+	var preferences []entities.NotificationPreference
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&preferences).Error
+	return preferences, err
+}
+
+func (r *notificationRepository) GetCompanyPreferences(ctx context.Context, companyID string) ([]entities.CompanyNotificationPreference, error) {
+	var preferences []entities.CompanyNotificationPreference
+	err := r.db.WithContext(ctx).Where("company_id = ?", companyID).Find(&preferences).Error
+	return preferences, err
+}
+
+func (r *notificationRepository) SaveUserPreferences(ctx context.Context, preferences []entities.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Omit("User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "notification_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email_enabled", "push_enabled", "sms_enabled", "updated_at"}),
+	}).Create(&preferences).Error
+}
+
+func (r *notificationRepository) SaveCompanyPreferences(ctx context.Context, preferences []entities.CompanyNotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Omit("Company").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "company_id"}, {Name: "notification_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email_enabled", "push_enabled", "sms_enabled", "updated_at"}),
+	}).Create(&preferences).Error
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	return r.db.WithContext(ctx).Omit("User", "Template").Create(notification).Error
+}
+
+func (r *notificationRepository) GetByID(ctx context.Context, id string) (*entities.Notification, error) {
+	var notification entities.Notification
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&notification).Error; err != nil {
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (r *notificationRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Notification{}).
+		Where("id = ?", id).
+		Update("sent_at", sentAt).Error
+}
+
+func (r *notificationRepository) GetUserNotifications(ctx context.Context, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error) {
+	var notifications []entities.Notification
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.Notification{}).Where("user_id = ?", params.UserID)
+	if params.Type != "" {
+		query = query.Where("type = ?", params.Type)
+	}
+	if params.UnreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params.Page > 0 && params.PageSize > 0 {
+		query = query.Offset((params.Page - 1) * params.PageSize).Limit(params.PageSize)
+	}
+
+	direction := "DESC"
+	if params.SortDirection == "asc" {
+		direction = "ASC"
+	}
+
+	err := query.Order("created_at " + direction).Find(&notifications).Error
+	return notifications, total, err
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var total int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Count(&total).Error
+	return total, err
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id string, readAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Notification{}).
+		Where("id = ? AND user_id = ? AND is_read = ?", id, userID, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": readAt,
+		}).Error
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID string, readAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": readAt,
+		})
+	return result.RowsAffected, result.Error
+}
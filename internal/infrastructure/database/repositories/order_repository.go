@@ -7,10 +7,12 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -25,7 +27,7 @@ func NewOrderRepository(db *gorm.DB) ports.OrdererRepository {
 }
 
 // CreateOrder crea un nuevo pedido
-func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order) error {
+func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order, events ...*entities.OutboxEvent) error {
 	if order == nil {
 		return errPackage.ErrNilOrder
 	}
@@ -46,7 +48,8 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order
 		if order.DeliveryAddress != nil {
 			if err := tx.Model(&entities.DeliveryAddress{}).
 				Where("order_id = ?", order.ID).
-				Update("location", gorm.Expr("ST_PointFromText(?)", "POINT(-90.5091 14.6234)")).
+				Update("location", gorm.Expr("ST_PointFromText(?)",
+					value_objects.NewGeoPoint(order.DeliveryAddress.Latitude, order.DeliveryAddress.Longitude).ToWKT())).
 				Error; err != nil {
 				return err
 			}
@@ -56,12 +59,15 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order
 		if order.PickupAddress != nil {
 			if err := tx.Model(&entities.PickupAddress{}).
 				Where("order_id = ?", order.ID).
-				Update("location", gorm.Expr("ST_PointFromText(?)", "POINT(-90.5191 14.6334)")).
+				Update("location", gorm.Expr("ST_PointFromText(?)",
+					value_objects.NewGeoPoint(order.PickupAddress.Latitude, order.PickupAddress.Longitude).ToWKT())).
 				Error; err != nil {
 				return err
 			}
 		}
-		return nil
+
+		// 3. Guardar los eventos del pedido
+		return saveOutboxEvents(tx, events)
 	})
 
 	return err
@@ -277,13 +283,22 @@ func (r *orderRepository) DeleteOrder(ctx context.Context, id string) error {
 	return err
 }
 
-// ChangeStatus cambia el estado de un pedido
-func (r *orderRepository) ChangeStatus(ctx context.Context, id string, status string) error {
+// ChangeStatus cambia el estado de un pedido, al pasar a un estado final libera la carga del repartidor
+func (r *orderRepository) ChangeStatus(ctx context.Context, id string, status string, events ...*entities.OutboxEvent) error {
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := lockOrderAssignment(tx, id)
+		if err != nil {
+			return err
+		}
+
 		if err := tx.Model(&entities.Order{}).Where("id = ?", id).Update("status", status).Error; err != nil {
 			return err
 		}
 
+		if err := releaseDriverLoad(tx, current, status); err != nil {
+			return err
+		}
+
 		// Guardar historial de estado
 		statusHistory := entities.StatusHistory{
 			ID:      uuid.NewString(),
@@ -291,23 +306,172 @@ func (r *orderRepository) ChangeStatus(ctx context.Context, id string, status st
 			Status:  status,
 		}
 
-		return tx.Create(&statusHistory).Error
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
+
+		return saveOutboxEvents(tx, events)
 	})
 
 	return err
 }
 
-func (r *orderRepository) AssignDriverToOrder(ctx context.Context, orderID, driverID string) error {
+// AssignDriverToOrder asigna el repartidor solo si el pedido sigue sin repartidor y el repartidor no alcanzó
+// maxActiveOrders. Ambas condiciones se evalúan en la actualización para que dos réplicas no asignen el mismo
+// pedido ni superen la carga, si alguna no se cumple retorna gorm.ErrRecordNotFound sin cambiar nada
+func (r *orderRepository) AssignDriverToOrder(ctx context.Context, orderID, driverID string, maxActiveOrders int, events ...*entities.OutboxEvent) error {
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Model(&entities.Order{}).Where("id = ?", orderID).Update("driver_id", driverID).Error; err != nil {
-			return err
+		// 1. Asignar el repartidor si el pedido no tiene uno
+		result := tx.Model(&entities.Order{}).
+			Where("id = ? AND driver_id IS NULL AND deleted_at IS NULL", orderID).
+			Update("driver_id", driverID)
+		if result.Error != nil {
+			return result.Error
 		}
-		return nil
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 2. Sumar el pedido a la carga del repartidor si aún tiene capacidad
+		result = tx.Model(&entities.Availability{}).
+			Where("driver_id = ? AND active_orders < ?", driverID, maxActiveOrders).
+			Updates(map[string]interface{}{
+				"active_orders": gorm.Expr("active_orders + 1"),
+				"last_update":   time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 3. Guardar los eventos de la asignación
+		return saveOutboxEvents(tx, events)
 	})
 
 	return err
 }
 
+// lockOrderAssignment obtiene el estado y el repartidor del pedido bloqueando la fila hasta el fin de la transacción,
+// así dos cambios concurrentes no liberan dos veces la carga del mismo pedido
+func lockOrderAssignment(tx *gorm.DB, orderID string) (*entities.Order, error) {
+	var current entities.Order
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "driver_id", "status").
+		First(&current, "id = ?", orderID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &current, nil
+}
+
+// releaseDriverLoad descuenta el pedido de la carga del repartidor cuando pasa de un estado activo a uno final
+func releaseDriverLoad(tx *gorm.DB, current *entities.Order, status string) error {
+	if current.DriverID == nil || *current.DriverID == "" {
+		return nil
+	}
+	if constants.DriverReleasingOrderStatuses[current.Status] || !constants.DriverReleasingOrderStatuses[status] {
+		return nil
+	}
+
+	return tx.Model(&entities.Availability{}).
+		Where("driver_id = ? AND active_orders > 0", *current.DriverID).
+		Updates(map[string]interface{}{
+			"active_orders": gorm.Expr("active_orders - 1"),
+			"last_update":   time.Now(),
+		}).Error
+}
+
+// GetPendingDispatchOrderIDs obtiene los pedidos sin repartidor que pueden despacharse, del más antiguo al más reciente
+func (r *orderRepository) GetPendingDispatchOrderIDs(ctx context.Context, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Model(&entities.Order{}).
+		Where("driver_id IS NULL AND deleted_at IS NULL").
+		Where("status IN ?", []string{constants.OrderStatusPending, constants.OrderStatusAccepted}).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// SaveLocationPing guarda un punto del recorrido y actualiza la ubicación actual del pedido y del repartidor
+func (r *orderRepository) SaveLocationPing(ctx context.Context, ping *entities.LocationPing, orderStatus string, events ...*entities.OutboxEvent) error {
+	wkt := value_objects.NewGeoPoint(ping.Latitude, ping.Longitude).ToWKT()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Guardar el punto en el historial
+		if err := tx.Exec(
+			"INSERT INTO order_location_pings (id, order_id, driver_id, location, recorded_at) VALUES (?, ?, ?, ST_PointFromText(?), ?)",
+			ping.ID, ping.OrderID, ping.DriverID, wkt, ping.RecordedAt,
+		).Error; err != nil {
+			return err
+		}
+
+		// 2. Actualizar la ubicación actual del pedido, creando el registro de tracking si no existe. Se usa un upsert
+		// porque MySQL no cuenta como afectada una fila que no cambia y un ping repetido intentaría insertarla de nuevo
+		if err := tx.Exec(
+			"INSERT INTO order_tracking (order_id, current_location, current_status, last_updated, created_at) VALUES (?, ST_PointFromText(?), ?, ?, ?) "+
+				"ON DUPLICATE KEY UPDATE current_location = VALUES(current_location), current_status = VALUES(current_status), last_updated = VALUES(last_updated)",
+			ping.OrderID, wkt, orderStatus, ping.RecordedAt, ping.RecordedAt,
+		).Error; err != nil {
+			return err
+		}
+
+		// 3. Actualizar la ubicación actual del repartidor
+		if err := tx.Model(&entities.Availability{}).
+			Where("driver_id = ?", ping.DriverID).
+			Updates(map[string]interface{}{
+				"current_location": gorm.Expr("ST_PointFromText(?)", wkt),
+				"last_update":      ping.RecordedAt,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 4. Guardar los eventos de la ubicación
+		return saveOutboxEvents(tx, events)
+	})
+}
+
+// GetLocationHistory obtiene el recorrido registrado de un pedido en orden cronológico
+func (r *orderRepository) GetLocationHistory(ctx context.Context, orderID string) ([]entities.LocationPing, error) {
+	var rows []struct {
+		ID         string
+		OrderID    string
+		DriverID   string
+		RecordedAt time.Time
+		Lat        float64
+		Lng        float64
+	}
+
+	err := r.db.WithContext(ctx).Raw(
+		"SELECT id, order_id, driver_id, recorded_at, ST_Y(location) AS lat, ST_X(location) AS lng FROM order_location_pings WHERE order_id = ? ORDER BY recorded_at ASC",
+		orderID,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pings := make([]entities.LocationPing, 0, len(rows))
+	for _, row := range rows {
+		pings = append(pings, entities.LocationPing{
+			ID:         row.ID,
+			OrderID:    row.OrderID,
+			DriverID:   row.DriverID,
+			RecordedAt: row.RecordedAt,
+			Latitude:   row.Lat,
+			Longitude:  row.Lng,
+		})
+	}
+
+	return pings, nil
+}
+
 func (r *orderRepository) CreateQRData(ctx context.Context, qr *entities.QRCode) error {
 	if qr == nil {
 		return errPackage.ErrNilQR
@@ -348,12 +512,16 @@ func (r *orderRepository) GetLocationCoordinates(ctx context.Context, orderID st
 }
 
 // SoftDeleteOrder realiza una eliminación lógica del pedido
-func (r *orderRepository) SoftDeleteOrder(ctx context.Context, id string) error {
+func (r *orderRepository) SoftDeleteOrder(ctx context.Context, id string, events ...*entities.OutboxEvent) error {
 	now := time.Now()
 
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := lockOrderAssignment(tx, id)
+		if err != nil {
+			return err
+		}
 
-		// 1. Marcar el pedido como eliminado
+		// 1. Marcar el pedido como eliminado y liberar la carga del repartidor
 		if err := tx.Model(&entities.Order{}).
 			Where("id = ?", id).
 			Updates(map[string]interface{}{
@@ -362,6 +530,9 @@ func (r *orderRepository) SoftDeleteOrder(ctx context.Context, id string) error
 			}).Error; err != nil {
 			return err
 		}
+		if err := releaseDriverLoad(tx, current, constants.OrderStatusDeleted); err != nil {
+			return err
+		}
 
 		// 2. Crear un registro en el historial de estados
 		statusHistory := entities.StatusHistory{
@@ -376,12 +547,13 @@ func (r *orderRepository) SoftDeleteOrder(ctx context.Context, id string) error
 			return err
 		}
 
-		return nil
+		// 3. Guardar los eventos de la eliminación
+		return saveOutboxEvents(tx, events)
 	})
 }
 
 // RestoreOrder restaura un pedido previamente eliminado lógicamente
-func (r *orderRepository) RestoreOrder(ctx context.Context, id string) error {
+func (r *orderRepository) RestoreOrder(ctx context.Context, id string, events ...*entities.OutboxEvent) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 1. Restaurar el pedido
 		if err := tx.Model(&entities.Order{}).
@@ -402,7 +574,12 @@ func (r *orderRepository) RestoreOrder(ctx context.Context, id string) error {
 			CreatedAt:   time.Now(),
 		}
 
-		return tx.Create(&statusHistory).Error
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
+
+		// 3. Guardar los eventos de la restauración
+		return saveOutboxEvents(tx, events)
 	})
 }
 
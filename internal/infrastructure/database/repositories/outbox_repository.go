@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) ports.OutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
+
+// GetDueEventIDs obtiene los eventos pendientes disponibles, del más antiguo al más reciente
+func (r *outboxRepository) GetDueEventIDs(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&entities.OutboxEvent{}).
+		Where("status = ? AND available_at <= ?", constants.OutboxEventPending, now).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+
+	return ids, err
+}
+
+// ClaimEvent reserva el evento hasta leaseUntil para que solo una instancia lo publique.
+// Retorna gorm.ErrRecordNotFound si el evento ya fue publicado o reservado.
+func (r *outboxRepository) ClaimEvent(ctx context.Context, id string, now, leaseUntil time.Time) (*entities.OutboxEvent, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.OutboxEvent{}).
+		Where("id = ? AND status = ? AND available_at <= ?", id, constants.OutboxEventPending, now).
+		Update("available_at", leaseUntil)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var event entities.OutboxEvent
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// MarkPublished marca el evento como publicado
+func (r *outboxRepository) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       constants.OutboxEventPublished,
+			"published_at": at,
+			"last_error":   "",
+		}).Error
+}
+
+// RecordFailure guarda el intento fallido con el estado y la fecha del siguiente intento
+func (r *outboxRepository) RecordFailure(ctx context.Context, event *entities.OutboxEvent) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.OutboxEvent{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"status":       event.Status,
+			"attempts":     event.Attempts,
+			"last_error":   event.LastError,
+			"available_at": event.AvailableAt,
+		}).Error
+}
+
+// saveOutboxEvents guarda los eventos dentro de la transacción del cambio que los origina
+func saveOutboxEvents(tx *gorm.DB, events []*entities.OutboxEvent) error {
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,11 @@ func NewRoleRepository(db *gorm.DB) ports.RolerRepository {
 	}
 }
 
+// CreateRole crea un nuevo rol
+func (r *roleRepository) CreateRole(ctx context.Context, role *entities.Role) error {
+	return r.db.WithContext(ctx).Omit("Permissions").Create(role).Error
+}
+
 // GetRoleByID obtiene un rol por su ID incluyendo sus permisos
 func (r *roleRepository) GetRoleByID(ctx context.Context, id string) (*entities.Role, error) {
 	var role entities.Role
@@ -124,14 +129,30 @@ func (r *roleRepository) GetPermissionByID(ctx context.Context, id string) (*ent
 	return &permission, nil
 }
 
+// GetPermissionByResourceAction obtiene un permiso por su recurso y acción
+func (r *roleRepository) GetPermissionByResourceAction(ctx context.Context, resource, action string) (*entities.Permission, error) {
+	var permission entities.Permission
+	err := r.db.WithContext(ctx).
+		First(&permission, "resource = ? AND action = ?", resource, action).Error
+	if err != nil {
+		return nil, err
+	}
+	return &permission, nil
+}
+
 // UpdatePermission actualiza un permiso
 func (r *roleRepository) UpdatePermission(ctx context.Context, permission *entities.Permission) error {
 	return r.db.WithContext(ctx).Save(permission).Error
 }
 
-// DeletePermission elimina un permiso
+// DeletePermission elimina un permiso junto con sus asignaciones a roles
 func (r *roleRepository) DeletePermission(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&entities.Permission{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM role_permissions WHERE permission_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.Permission{}, "id = ?", id).Error
+	})
 }
 
 // ListPermissions lista todos los permisos
@@ -173,3 +194,17 @@ func (r *roleRepository) GetRolePermissions(ctx context.Context, roleID string)
 	}
 	return permissions, nil
 }
+
+// GetPermissionRoles obtiene todos los roles que tienen asignado un permiso
+func (r *roleRepository) GetPermissionRoles(ctx context.Context, permissionID string) ([]entities.Role, error) {
+	var roles []entities.Role
+	err := r.db.WithContext(ctx).
+		Table("roles").
+		Joins("JOIN role_permissions ON role_permissions.role_id = roles.id").
+		Where("role_permissions.permission_id = ?", permissionID).
+		Find(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
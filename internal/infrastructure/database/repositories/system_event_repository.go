@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type systemEventRepository struct {
+	db *gorm.DB
+}
+
+func NewSystemEventRepository(db *gorm.DB) ports.SystemEventRepository {
+	return &systemEventRepository{
+		db: db,
+	}
+}
+
+func (r *systemEventRepository) Create(ctx context.Context, event *entities.SystemEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+func (r *systemEventRepository) CreateLogs(ctx context.Context, eventLogs []entities.EventLog) error {
+	return r.db.WithContext(ctx).Omit("Event").Create(&eventLogs).Error
+}
+
+func (r *systemEventRepository) GetSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEvent, int64, error) {
+	var events []entities.SystemEvent
+	var total int64
+
+	query := r.filterSystemEvents(r.db.WithContext(ctx).Model(&entities.SystemEvent{}), params)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params.Page > 0 && params.PageSize > 0 {
+		query = query.Offset((params.Page - 1) * params.PageSize).Limit(params.PageSize)
+	}
+
+	direction := "DESC"
+	if params.SortDirection == "asc" {
+		direction = "ASC"
+	}
+
+	err := query.Preload("Logs", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Order("occurred_at " + direction).Find(&events).Error
+	return events, total, err
+}
+
+func (r *systemEventRepository) AggregateSystemEvents(ctx context.Context, params *entities.SystemEventQueryParams) ([]entities.SystemEventSummary, error) {
+	var summaries []entities.SystemEventSummary
+
+	query := r.filterSystemEvents(r.db.WithContext(ctx).Model(&entities.SystemEvent{}), params)
+	err := query.Select("event_type, severity, COUNT(*) AS count").
+		Group("event_type, severity").
+		Order("count DESC, event_type, severity").
+		Scan(&summaries).Error
+	return summaries, err
+}
+
+// filterSystemEvents aplica los filtros comunes a la búsqueda y a la agregación
+func (r *systemEventRepository) filterSystemEvents(query *gorm.DB, params *entities.SystemEventQueryParams) *gorm.DB {
+	if params.EventType != "" {
+		query = query.Where("event_type = ?", params.EventType)
+	}
+	if params.Source != "" {
+		query = query.Where("source = ?", params.Source)
+	}
+	if params.SourceID != "" {
+		query = query.Where("source_id = ?", params.SourceID)
+	}
+	if params.Severity != "" {
+		query = query.Where("severity = ?", params.Severity)
+	}
+	if params.StartDate != nil {
+		query = query.Where("occurred_at >= ?", params.StartDate)
+	}
+	if params.EndDate != nil {
+		query = query.Where("occurred_at <= ?", params.EndDate)
+	}
+
+	return query
+}
@@ -170,11 +170,32 @@ func (r *userRepository) GetSessionByToken(ctx context.Context, token string) (*
 	return &session, nil
 }
 
+// GetSessionByID obtiene una sesión activa por su ID
+func (r *userRepository) GetSessionByID(ctx context.Context, sessionID string) (*entities.UserSession, error) {
+	var session entities.UserSession
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND expires_at > NOW()", sessionID).
+		First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// TouchSession actualiza la última actividad de la sesión si no se actualizó en el intervalo indicado
+func (r *userRepository) TouchSession(ctx context.Context, sessionID string, at time.Time, minInterval time.Duration) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.UserSession{}).
+		Where("id = ? AND (last_activity IS NULL OR last_activity < ?)", sessionID, at.Add(-minInterval)).
+		Update("last_activity", at).Error
+}
+
 // GetActiveSessionsByUserID obtiene todas las sesiones activas de un usuario
 func (r *userRepository) GetActiveSessionsByUserID(ctx context.Context, userID string) ([]entities.UserSession, error) {
 	var sessions []entities.UserSession
 	err := r.db.WithContext(ctx).
 		Where("user_id = ? AND expires_at > NOW()", userID).
+		Order("last_activity DESC").
 		Find(&sessions).Error
 	if err != nil {
 		return nil, err
@@ -182,10 +203,22 @@ func (r *userRepository) GetActiveSessionsByUserID(ctx context.Context, userID s
 	return sessions, nil
 }
 
-// DeleteSession elimina una sesión específica
+// DeleteSession elimina una sesión específica junto con sus refresh tokens
 func (r *userRepository) DeleteSession(ctx context.Context, sessionID string) error {
-	return r.db.WithContext(ctx).
-		Delete(&entities.UserSession{}, "id = ?", sessionID).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entities.SessionRefreshToken{}, "session_id = ?", sessionID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&entities.UserSession{}, "id = ?", sessionID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
 }
 
 // CleanExpiredSessions elimina todas las sesiones expiradas
@@ -196,6 +229,160 @@ func (r *userRepository) CleanExpiredSessions(ctx context.Context, id string) er
 		Update("expires_at", time.Now()).Error
 }
 
+// CreateSessionWithRefreshToken crea una sesión y su primer refresh token en una misma transacción
+func (r *userRepository) CreateSessionWithRefreshToken(ctx context.Context, session *entities.UserSession, refreshToken *entities.SessionRefreshToken) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+		return tx.Create(refreshToken).Error
+	})
+}
+
+// GetRefreshTokenByHash obtiene un refresh token por su hash incluyendo la sesión a la que pertenece
+func (r *userRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entities.SessionRefreshToken, error) {
+	var refreshToken entities.SessionRefreshToken
+	err := r.db.WithContext(ctx).
+		Preload("Session").
+		First(&refreshToken, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		return nil, err
+	}
+	return &refreshToken, nil
+}
+
+// RotateRefreshToken marca el refresh token como usado, actualiza la sesión y registra el nuevo token.
+// Si el token ya fue usado por otra petición concurrente retorna gorm.ErrRecordNotFound.
+func (r *userRepository) RotateRefreshToken(ctx context.Context, usedTokenID string, session *entities.UserSession, newToken *entities.SessionRefreshToken) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Marcar el token como usado solo si no se había usado antes
+		result := tx.Model(&entities.SessionRefreshToken{}).
+			Where("id = ? AND used_at IS NULL", usedTokenID).
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 2. Actualizar el access token y la actividad de la sesión
+		err := tx.Model(&entities.UserSession{}).
+			Where("id = ?", session.ID).
+			Updates(map[string]interface{}{
+				"token":         session.Token,
+				"last_activity": session.LastActivity,
+				"expires_at":    session.ExpiresAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		// 3. Registrar el nuevo refresh token
+		return tx.Create(newToken).Error
+	})
+}
+
+func (r *userRepository) GetMFA(ctx context.Context, userID string) (*entities.UserMFA, error) {
+	var mfa entities.UserMFA
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&mfa).Error; err != nil {
+		return nil, err
+	}
+
+	return &mfa, nil
+}
+
+// SaveMFA crea o reemplaza la configuración MFA del usuario
+func (r *userRepository) SaveMFA(ctx context.Context, mfa *entities.UserMFA) error {
+	return r.db.WithContext(ctx).Save(mfa).Error
+}
+
+// EnableMFA activa el MFA del usuario y reemplaza sus códigos de recuperación
+func (r *userRepository) EnableMFA(ctx context.Context, userID string, enabledAt time.Time, codes []entities.MFARecoveryCode) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.UserMFA{}).
+			Where("user_id = ?", userID).
+			Update("enabled_at", enabledAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return replaceRecoveryCodes(tx, userID, codes)
+	})
+}
+
+// AdvanceMFAStep registra el último intervalo TOTP usado, un código no puede usarse dos veces
+func (r *userRepository) AdvanceMFAStep(ctx context.Context, userID string, step int64) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.UserMFA{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *userRepository) DeleteMFA(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entities.MFARecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("user_id = ?", userID).Delete(&entities.UserMFA{}).Error
+	})
+}
+
+func (r *userRepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codes []entities.MFARecoveryCode) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codes)
+	})
+}
+
+// UseRecoveryCode marca el código como usado solo si no se había usado antes
+func (r *userRepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.MFARecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *userRepository) CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.MFARecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+
+	return count, err
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID string, codes []entities.MFARecoveryCode) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&entities.MFARecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+
+	return tx.Create(&codes).Error
+}
+
 // AssignRoleToUser asigna un rol a un usuario
 func (r *userRepository) AssignRoleToUser(ctx context.Context, userID string, roleID string, assignedBy string) error {
 	userRole := entities.UserRole{
@@ -0,0 +1,178 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) ports.WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (r *webhookRepository) CreateSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error {
+	return r.db.WithContext(ctx).Create(subscription).Error
+}
+
+func (r *webhookRepository) GetSubscriptionByID(ctx context.Context, id string) (*entities.WebhookSubscription, error) {
+	var subscription entities.WebhookSubscription
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&subscription).Error; err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *webhookRepository) ListSubscriptionsByCompany(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error) {
+	var subscriptions []entities.WebhookSubscription
+	err := r.db.WithContext(ctx).
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&subscriptions).Error
+
+	return subscriptions, err
+}
+
+func (r *webhookRepository) GetActiveSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error) {
+	var subscriptions []entities.WebhookSubscription
+	err := r.db.WithContext(ctx).
+		Where("company_id = ? AND is_active = ?", companyID, true).
+		Find(&subscriptions).Error
+
+	return subscriptions, err
+}
+
+// DeleteSubscription elimina la suscripción junto con sus entregas y el registro de intentos
+func (r *webhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		deliveryIDs := tx.Model(&entities.WebhookDelivery{}).Select("id").Where("subscription_id = ?", id)
+		if err := tx.Where("delivery_id IN (?)", deliveryIDs).Delete(&entities.WebhookDeliveryAttempt{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("subscription_id = ?", id).Delete(&entities.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&entities.WebhookSubscription{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []entities.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Create(&deliveries).Error
+}
+
+func (r *webhookRepository) GetDeliveryByID(ctx context.Context, id string) (*entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Preload("AttemptLog", func(db *gorm.DB) *gorm.DB {
+			return db.Order("attempt_number ASC")
+		}).
+		Where("id = ?", id).
+		First(&delivery).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// GetSubscriptionIDsForEvent obtiene las suscripciones que ya tienen una entrega original del evento
+func (r *webhookRepository) GetSubscriptionIDsForEvent(ctx context.Context, eventID string) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&entities.WebhookDelivery{}).
+		Where("event_id = ? AND replay_of IS NULL", eventID).
+		Pluck("subscription_id", &ids).Error
+
+	return ids, err
+}
+
+// ListDeliveriesBySubscription obtiene las entregas más recientes de la suscripción
+func (r *webhookRepository) ListDeliveriesBySubscription(ctx context.Context, subscriptionID string, limit int) ([]entities.WebhookDelivery, error) {
+	var deliveries []entities.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+
+	return deliveries, err
+}
+
+// GetDueDeliveryIDs obtiene las entregas pendientes cuyo siguiente intento ya venció
+func (r *webhookRepository) GetDueDeliveryIDs(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&entities.WebhookDelivery{}).
+		Where("status = ? AND next_attempt_at <= ?", constants.WebhookDeliveryPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+
+	return ids, err
+}
+
+// ClaimDelivery reserva la entrega hasta leaseUntil para que solo un proceso la envíe.
+// Retorna gorm.ErrRecordNotFound si la entrega no está pendiente o ya fue reservada.
+func (r *webhookRepository) ClaimDelivery(ctx context.Context, id string, now, leaseUntil time.Time) (*entities.WebhookDelivery, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", id, constants.WebhookDeliveryPending, now).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var delivery entities.WebhookDelivery
+	if err := r.db.WithContext(ctx).Preload("Subscription").Where("id = ?", id).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// RecordAttempt guarda el intento y el nuevo estado de la entrega en una transacción
+func (r *webhookRepository) RecordAttempt(ctx context.Context, delivery *entities.WebhookDelivery, attempt *entities.WebhookDeliveryAttempt) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entities.WebhookDelivery{}).
+			Where("id = ?", delivery.ID).
+			Updates(map[string]interface{}{
+				"status":           delivery.Status,
+				"attempts":         delivery.Attempts,
+				"last_status_code": delivery.LastStatusCode,
+				"last_error":       delivery.LastError,
+				"next_attempt_at":  delivery.NextAttemptAt,
+				"delivered_at":     delivery.DeliveredAt,
+				"updated_at":       time.Now(),
+			}).Error
+	})
+}
@@ -0,0 +1,24 @@
+package error
+
+import "time"
+
+// RetryAfterError indica que la operación fue rechazada temporalmente y puede reintentarse pasado RetryAfter
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+func NewRetryAfterError(err error, retryAfter time.Duration) *RetryAfterError {
+	return &RetryAfterError{
+		Err:        err,
+		RetryAfter: retryAfter,
+	}
+}
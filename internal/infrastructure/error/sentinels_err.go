@@ -15,25 +15,68 @@ var (
 	ErrFailedLRange         = errors.New("failed to execute LRange command in redis")
 	ErrFailedLLen           = errors.New("failed to execute LLen command in redis")
 	ErrFailedLTrim          = errors.New("failed to execute LTrim command in redis")
+	ErrFailedIncrement      = errors.New("failed to increment counter in redis")
 
 	ErrInvalidCredentials     = errors.New("invalid email or password")
+	ErrTooManyLoginAttempts   = errors.New("too many failed login attempts, please try again later")
 	ErrInactiveUser           = errors.New("users is inactive")
 	ErrInvalidUser            = errors.New("email, firstName, lastName, phone and password are required, please fill them")
 	ErrInvalidProfileUser     = errors.New("document type, document number, birth date, emergency contact and phone in profile section are required, please fill them")
 	ErrMissingProfileSection  = errors.New("profile section is required, please fill it")
 	ErrRoleMissing            = errors.New("role_id is required, provide them")
-	ErrInvalidRole            = errors.New("role is invalid, please provide a valid role")
 	ErrReasonToDeactivateUser = errors.New("when you want deactivate user reason field must be provide")
 	ErrMissingRoles           = errors.New("at least one role is required, please provide them")
 
 	ErrNilOrder = errors.New("order cannot be nil, please provide a valid order")
 	ErrNilQR    = errors.New("qr code cannot be nil")
 
-	ErrSessionNotFound   = errors.New("the session assigned to the token was not found, probably was deleted or expired")
-	ErrSessionDBNotFound = errors.New("the session assigned to the token was not found")
-	ErrGenericDBError    = errors.New("an error occurred while trying to execute the operation in the database")
+	ErrSessionNotFound     = errors.New("the session assigned to the token was not found, probably was deleted or expired")
+	ErrSessionDBNotFound   = errors.New("the session assigned to the token was not found")
+	ErrUserSessionNotFound = errors.New("session not found or already revoked")
+	ErrGenericDBError      = errors.New("an error occurred while trying to execute the operation in the database")
 
 	ErrAuthorizationHeaderNotFound = errors.New("authorization header not found, please provide a valid token")
 	ErrInvalidAuthorizationFormat  = errors.New("invalid authorization format, the format should be 'Bearer <token>'")
 	ErrTokenExpiredOrTampered      = errors.New("token is expired or has been tampered with, please provide a valid token")
+
+	ErrRefreshTokenRequired = errors.New("refresh token is required")
+	ErrInvalidRefreshToken  = errors.New("refresh token is invalid or expired, please log in again")
+	ErrRefreshTokenReused   = errors.New("refresh token has already been used, the session has been revoked for security reasons")
+
+	ErrInvalidAccountToken = errors.New("the token is invalid, expired or has already been used")
+	ErrFailedToSendMail    = errors.New("failed to send mail")
+	ErrEmailRequired       = errors.New("email is required")
+
+	ErrMFAChallengeInvalid   = errors.New("mfa challenge is invalid or expired, please log in again")
+	ErrMFACodeRequired       = errors.New("mfa code is required")
+	ErrInvalidMFACode        = errors.New("mfa code is invalid or has already been used")
+	ErrTooManyMFAAttempts    = errors.New("too many invalid mfa codes, please try again later")
+	ErrMFAAlreadyEnabled     = errors.New("mfa is already enabled for this user")
+	ErrMFANotEnabled         = errors.New("mfa is not enabled for this user")
+	ErrMFAEnrollmentNotFound = errors.New("mfa enrollment was not started, request a new secret first")
+	ErrMFARequiredByRole     = errors.New("mfa is required for your role and cannot be disabled")
+
+	ErrInvalidAPIKey       = errors.New("api key is invalid, expired or revoked")
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrAPIKeyNameRequired  = errors.New("api key name is required")
+	ErrInvalidAPIKeyScopes = errors.New("at least one scope is required and every scope must be one of orders:create, orders:read or tracking:read")
+	ErrAPIKeyBranchInvalid = errors.New("branch not found, inactive or not part of the company")
+	ErrAPIKeyNotAllowed    = errors.New("this endpoint is not available for api keys")
+
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhookURL       = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookURLNotAllowed    = errors.New("webhook url must point to a public address")
+	ErrInvalidWebhookEvents    = errors.New("at least one event is required and every event must be a valid order event")
+
+	ErrInvalidAuditDateFilter = errors.New("start_date and end_date must be RFC3339 dates and start_date must not be after end_date")
+
+	ErrInvalidSystemEventFilter = errors.New("severity must be INFO, WARNING, ERROR or CRITICAL, and start_date and end_date must be RFC3339 dates with start_date not after end_date")
+
+	ErrInvalidNotificationFilter = errors.New("unread must be true or false and type must be a valid notification type")
+
+	ErrClaimsNotFound             = errors.New("authentication claims not found in the request context")
+	ErrCompanyRequired            = errors.New("company_id is required for users without a company")
+	ErrInsufficientPermissions    = errors.New("you do not have the required role or permissions to access this resource")
+	ErrFailedToResolvePermissions = errors.New("failed to resolve the permissions of the role")
 )
@@ -25,6 +25,11 @@ func (e *ServiceError) Error() string {
 	return fmt.Sprintf("%s", e.Err.Error())
 }
 
+// Unwrap permite usar errors.Is y errors.As sobre la causa del error
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
 // NewGeneralServiceError crea un nuevo error de servicio general con el tipo de servicio, la operación, el mensaje y el error.
 func NewGeneralServiceError(serviceType, op string, err error) *ServiceError {
 	err = IsGormError(err)
@@ -0,0 +1,80 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+)
+
+// Canales en los que las instancias del hub publican las actualizaciones para el resto de réplicas
+const (
+	OrderUpdatesChannel    = "delivery:ws:order_updates"
+	LocationUpdatesChannel = "delivery:ws:location_updates"
+	NotificationsChannel   = "delivery:ws:notifications"
+)
+
+// BrokerHandler procesa un mensaje recibido en un canal del broker
+type BrokerHandler func(channel string, payload []byte)
+
+// Broker distribuye las actualizaciones entre todas las instancias del hub
+type Broker interface {
+	// Publish envía el mensaje a todas las instancias suscritas al canal, incluida la propia
+	Publish(ctx context.Context, channel string, payload []byte) error
+
+	// Subscribe registra el handler para los canales indicados hasta que el contexto se cancela
+	Subscribe(ctx context.Context, channels []string, handler BrokerHandler) error
+}
+
+// InMemoryBroker implementa Broker dentro del proceso, útil para pruebas y despliegues de una sola instancia
+type InMemoryBroker struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[string]map[int]BrokerHandler
+}
+
+func NewInMemoryBroker() *InMemoryBroker {
+	return &InMemoryBroker{
+		subscribers: make(map[string]map[int]BrokerHandler),
+	}
+}
+
+// Publish entrega el mensaje de forma síncrona a los handlers suscritos al canal
+func (b *InMemoryBroker) Publish(_ context.Context, channel string, payload []byte) error {
+	b.mu.RLock()
+	handlers := make([]BrokerHandler, 0, len(b.subscribers[channel]))
+	for _, handler := range b.subscribers[channel] {
+		handlers = append(handlers, handler)
+	}
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(channel, payload)
+	}
+
+	return nil
+}
+
+// Subscribe registra el handler y lo elimina cuando el contexto se cancela
+func (b *InMemoryBroker) Subscribe(ctx context.Context, channels []string, handler BrokerHandler) error {
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	for _, channel := range channels {
+		if _, ok := b.subscribers[channel]; !ok {
+			b.subscribers[channel] = make(map[int]BrokerHandler)
+		}
+		b.subscribers[channel][id] = handler
+	}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for _, channel := range channels {
+			delete(b.subscribers[channel], id)
+		}
+	}()
+
+	return nil
+}
@@ -3,19 +3,30 @@ package websocket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	ws "github.com/gorilla/websocket"
 	"sync"
 	"time"
 
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 )
 
+const (
+	defaultDisconnectStormThreshold = 50
+	defaultDisconnectStormWindow    = time.Minute
+)
+
 // Client representa una conexión WebSocket con un cliente
 type Client struct {
 	hub      *Hub
 	conn     *ws.Conn
 	userID   string
+	claims   *auth.AuthClaims // Identidad y rol con los que se autorizan las suscripciones
 	orderIDs map[string]bool  // Pedidos a los que está suscrito
 	send     chan interface{} // Canal para enviar mensajes al cliente
 	mu       sync.Mutex       // Mutex para proteger el mapa de orderIDs
@@ -43,6 +54,23 @@ type Hub struct {
 	// Canal para enviar actualizaciones de ubicación
 	locationUpdates chan *LocationUpdate
 
+	// Canal para enviar notificaciones a los usuarios
+	notifications chan *UserNotification
+
+	// Política que decide si un cliente puede suscribirse a un pedido
+	authorizer interfaces.OrderAccessAuthorizer
+
+	// Broker para distribuir las actualizaciones entre réplicas, nil entrega solo a los clientes locales
+	broker       Broker
+	brokerCancel context.CancelFunc
+
+	// Registro de eventos y desconexiones recientes para detectar desconexiones masivas
+	events         interfaces.EventRecorder
+	disconnects    []time.Time
+	stormThreshold int
+	stormWindow    time.Duration
+	lastStormAt    time.Time
+
 	// Mutex para proteger los mapas
 	mu sync.Mutex
 }
@@ -59,6 +87,21 @@ type LocationUpdate struct {
 	Data    *websocket.LocationUpdateData
 }
 
+// UserNotification representa una notificación dirigida a todas las conexiones de un usuario
+type UserNotification struct {
+	UserID string
+	Data   *websocket.NotificationData
+}
+
+// brokerEnvelope es el mensaje que viaja por el broker entre instancias del hub
+type brokerEnvelope struct {
+	OrderID      string                        `json:"order_id,omitempty"`
+	UserID       string                        `json:"user_id,omitempty"`
+	Order        *websocket.OrderUpdateData    `json:"order,omitempty"`
+	Location     *websocket.LocationUpdateData `json:"location,omitempty"`
+	Notification *websocket.NotificationData   `json:"notification,omitempty"`
+}
+
 // NewHub crea una nueva instancia del Hub
 func NewHub() *Hub {
 	return &Hub{
@@ -68,6 +111,7 @@ func NewHub() *Hub {
 		unregister:      make(chan *Client),
 		orderUpdates:    make(chan *OrderUpdate),
 		locationUpdates: make(chan *LocationUpdate),
+		notifications:   make(chan *UserNotification),
 	}
 }
 
@@ -87,8 +131,150 @@ func (h *Hub) Run() {
 
 		case update := <-h.locationUpdates:
 			h.broadcastLocationUpdate(update)
+
+		case notification := <-h.notifications:
+			h.broadcastNotification(notification)
+		}
+	}
+}
+
+// SetSubscriptionAuthorizer configura la política de acceso usada para autorizar suscripciones.
+// Mientras no se configure, todas las suscripciones son rechazadas
+func (h *Hub) SetSubscriptionAuthorizer(authorizer interfaces.OrderAccessAuthorizer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.authorizer = authorizer
+}
+
+// SetEventRecorder configura el registro de eventos del hub. Se registra una desconexión masiva cuando
+// se desconectan stormThreshold clientes dentro de stormWindow, como máximo un evento por ventana
+func (h *Hub) SetEventRecorder(events interfaces.EventRecorder, stormThreshold int, stormWindow time.Duration) {
+	if stormThreshold <= 0 {
+		stormThreshold = defaultDisconnectStormThreshold
+	}
+	if stormWindow <= 0 {
+		stormWindow = defaultDisconnectStormWindow
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.events = events
+	h.stormThreshold = stormThreshold
+	h.stormWindow = stormWindow
+}
+
+// UseBroker conecta el hub a un broker para recibir las actualizaciones publicadas por cualquier réplica.
+// Cada instancia entrega los mensajes recibidos solo a sus clientes locales
+func (h *Hub) UseBroker(broker Broker) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	channels := []string{OrderUpdatesChannel, LocationUpdatesChannel, NotificationsChannel}
+	if err := broker.Subscribe(ctx, channels, h.handleBrokerMessage); err != nil {
+		cancel()
+		return err
+	}
+
+	h.mu.Lock()
+	if h.brokerCancel != nil {
+		h.brokerCancel()
+	}
+	h.broker = broker
+	h.brokerCancel = cancel
+	h.mu.Unlock()
+
+	logs.Info("WebSocket hub connected to broker", map[string]interface{}{
+		"channels": channels,
+	})
+	return nil
+}
+
+// handleBrokerMessage reenvía al ciclo principal las actualizaciones recibidas desde el broker
+func (h *Hub) handleBrokerMessage(channel string, payload []byte) {
+	var envelope brokerEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		logs.Error("Failed to unmarshal broker message", map[string]interface{}{
+			"channel": channel,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	switch {
+	case channel == OrderUpdatesChannel && envelope.Order != nil:
+		h.orderUpdates <- &OrderUpdate{OrderID: envelope.OrderID, Data: envelope.Order}
+	case channel == LocationUpdatesChannel && envelope.Location != nil:
+		h.locationUpdates <- &LocationUpdate{OrderID: envelope.OrderID, Data: envelope.Location}
+	case channel == NotificationsChannel && envelope.Notification != nil:
+		h.notifications <- &UserNotification{UserID: envelope.UserID, Data: envelope.Notification}
+	}
+}
+
+// publish envía la actualización por el broker, retorna false si debe entregarse localmente
+func (h *Hub) publish(channel string, envelope *brokerEnvelope) bool {
+	h.mu.Lock()
+	broker := h.broker
+	h.mu.Unlock()
+
+	if broker == nil {
+		return false
+	}
+
+	payload, err := json.Marshal(envelope)
+	if err == nil {
+		err = broker.Publish(context.Background(), channel, payload)
+	}
+
+	if err != nil {
+		logs.Error("Failed to publish update to broker, delivering locally", map[string]interface{}{
+			"channel":  channel,
+			"order_id": envelope.OrderID,
+			"user_id":  envelope.UserID,
+			"error":    err.Error(),
+		})
+		return false
+	}
+
+	return true
+}
+
+// SubscriberCount retorna cuántos clientes locales observan un pedido
+func (h *Hub) SubscriberCount(orderID string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.orders[orderID])
+}
+
+// ClientCount retorna cuántas conexiones locales tiene abiertas un usuario
+func (h *Hub) ClientCount(userID string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	count := 0
+	for client := range h.clients {
+		if client.userID == userID {
+			count++
 		}
 	}
+	return count
+}
+
+// authorizeSubscription verifica que el cliente pueda observar el pedido
+func (h *Hub) authorizeSubscription(client *Client, orderID string) error {
+	h.mu.Lock()
+	authorizer := h.authorizer
+	h.mu.Unlock()
+
+	if authorizer == nil {
+		return errors.New("subscription authorizer is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(client.ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := authorizer.AuthorizeOrderAccess(ctx, client.claims, orderID)
+	return err
 }
 
 // RegisterClient registra un nuevo cliente
@@ -126,9 +312,49 @@ func (h *Hub) unregisterClient(client *Client) {
 		logs.Info("Client unregistered", map[string]interface{}{
 			"user_id": client.userID,
 		})
+
+		h.trackDisconnect(time.Now())
 	}
 }
 
+// trackDisconnect cuenta la desconexión en la ventana y registra el evento al superar el umbral.
+// Debe llamarse con el mutex tomado, el evento se guarda fuera del ciclo principal para no bloquearlo
+func (h *Hub) trackDisconnect(now time.Time) {
+	if h.events == nil {
+		return
+	}
+
+	// 1. Descartar las desconexiones que quedaron fuera de la ventana
+	cutoff := now.Add(-h.stormWindow)
+	recent := h.disconnects[:0]
+	for _, disconnectedAt := range h.disconnects {
+		if disconnectedAt.After(cutoff) {
+			recent = append(recent, disconnectedAt)
+		}
+	}
+	h.disconnects = append(recent, now)
+
+	// 2. Registrar el evento una sola vez por ventana
+	if len(h.disconnects) < h.stormThreshold || now.Sub(h.lastStormAt) < h.stormWindow {
+		return
+	}
+	h.lastStormAt = now
+
+	data := map[string]interface{}{
+		"disconnects":       len(h.disconnects),
+		"window_seconds":    h.stormWindow.Seconds(),
+		"connected_clients": len(h.clients),
+	}
+	logs.Warn("WebSocket disconnect storm detected", data)
+
+	go h.events.Record(context.Background(), &entities.SystemEventInput{
+		EventType: constants.SystemEventWebSocketDisconnectStorm,
+		Source:    constants.SystemEventSourceWebSocket,
+		Severity:  constants.SystemEventSeverityWarning,
+		Data:      data,
+	})
+}
+
 // SubscribeToOrder suscribe un cliente a las actualizaciones de un pedido
 func (h *Hub) SubscribeToOrder(client *Client, orderID string) {
 	h.mu.Lock()
@@ -272,15 +498,69 @@ func (h *Hub) broadcastLocationUpdate(update *LocationUpdate) {
 	})
 }
 
-// SendOrderUpdate envía una actualización de pedido
+// broadcastNotification envía la notificación a todas las conexiones locales del usuario. Si el buffer de un
+// cliente está lleno se descarta el mensaje, la notificación sigue disponible en la bandeja del usuario
+func (h *Hub) broadcastNotification(notification *UserNotification) {
+	h.mu.Lock()
+	var clients []*Client
+	for client := range h.clients {
+		if client.userID == notification.UserID {
+			clients = append(clients, client)
+		}
+	}
+	h.mu.Unlock()
+
+	if len(clients) == 0 {
+		return // El usuario no tiene conexiones en esta instancia
+	}
+
+	message := websocket.Message{
+		Type:      websocket.ServerNotification,
+		Timestamp: time.Now(),
+		Data:      notification.Data,
+	}
+
+	msgJSON, err := json.Marshal(message)
+	if err != nil {
+		logs.Error("Failed to marshal notification message", map[string]interface{}{
+			"error":           err.Error(),
+			"user_id":         notification.UserID,
+			"notification_id": notification.Data.ID,
+		})
+		return
+	}
+
+	for _, client := range clients {
+		select {
+		case client.send <- msgJSON:
+		default:
+			logs.Warn("Client send buffer full, dropping notification", map[string]interface{}{
+				"user_id":         notification.UserID,
+				"notification_id": notification.Data.ID,
+			})
+		}
+	}
+
+	logs.Info("Notification broadcasted", map[string]interface{}{
+		"user_id":         notification.UserID,
+		"notification_id": notification.Data.ID,
+		"clients":         len(clients),
+	})
+}
+
+// SendOrderUpdate envía una actualización de pedido a través del broker o directamente a los clientes locales
 func (h *Hub) SendOrderUpdate(orderID string, data *websocket.OrderUpdateData) {
+	if h.publish(OrderUpdatesChannel, &brokerEnvelope{OrderID: orderID, Order: data}) {
+		return
+	}
+
 	h.orderUpdates <- &OrderUpdate{
 		OrderID: orderID,
 		Data:    data,
 	}
 }
 
-// SendLocationUpdate envía una actualización de ubicación
+// SendLocationUpdate envía una actualización de ubicación a través del broker o directamente a los clientes locales
 func (h *Hub) SendLocationUpdate(orderID string, data *websocket.LocationUpdateData) {
 	logs.Debug("Details function", map[string]interface{}{
 		"order_id":    orderID,
@@ -289,19 +569,36 @@ func (h *Hub) SendLocationUpdate(orderID string, data *websocket.LocationUpdateD
 		"longitude":   data.Longitude,
 	})
 
+	if h.publish(LocationUpdatesChannel, &brokerEnvelope{OrderID: orderID, Location: data}) {
+		return
+	}
+
 	h.locationUpdates <- &LocationUpdate{
 		OrderID: orderID,
 		Data:    data,
 	}
 }
 
-// NewClient crea un nuevo cliente WebSocket
-func NewClient(hub *Hub, conn *ws.Conn, userID string) *Client {
+// SendNotification envía una notificación a las conexiones del usuario a través del broker o directamente a los clientes locales
+func (h *Hub) SendNotification(userID string, data *websocket.NotificationData) {
+	if h.publish(NotificationsChannel, &brokerEnvelope{UserID: userID, Notification: data}) {
+		return
+	}
+
+	h.notifications <- &UserNotification{
+		UserID: userID,
+		Data:   data,
+	}
+}
+
+// NewClient crea un nuevo cliente WebSocket para el usuario autenticado
+func NewClient(hub *Hub, conn *ws.Conn, claims *auth.AuthClaims) *Client {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Client{
 		hub:      hub,
 		conn:     conn,
-		userID:   userID,
+		userID:   claims.UserID,
+		claims:   claims,
 		orderIDs: make(map[string]bool),
 		send:     make(chan interface{}, 256),
 		ctx:      ctx,
@@ -361,9 +658,7 @@ func (c *Client) readPump() {
 		// Manejar según el tipo de mensaje
 		switch message.Type {
 		case websocket.ClientSubscribe:
-			if message.OrderID != "" {
-				c.hub.SubscribeToOrder(c, message.OrderID)
-			}
+			c.handleSubscribe(message.OrderID)
 		case websocket.ClientUnsubscribe:
 			if message.OrderID != "" {
 				c.hub.UnsubscribeFromOrder(c, message.OrderID)
@@ -372,6 +667,50 @@ func (c *Client) readPump() {
 	}
 }
 
+// handleSubscribe autoriza la suscripción antes de registrarla en el hub,
+// si no está permitida responde al cliente con un mensaje de error
+func (c *Client) handleSubscribe(orderID string) {
+	if orderID == "" {
+		c.sendError("", websocket.ErrorCodeInvalidMessage, "order_id is required to subscribe")
+		return
+	}
+
+	if err := c.hub.authorizeSubscription(c, orderID); err != nil {
+		logs.Warn("Order subscription rejected", map[string]interface{}{
+			"user_id":  c.userID,
+			"order_id": orderID,
+			"error":    err.Error(),
+		})
+		// Mismo mensaje para pedidos inexistentes y ajenos para no revelar cuáles existen
+		c.sendError(orderID, websocket.ErrorCodeSubscriptionDenied, "you are not allowed to subscribe to this order")
+		return
+	}
+
+	c.hub.SubscribeToOrder(c, orderID)
+}
+
+// sendError envía un mensaje de error al cliente sin bloquear la lectura
+func (c *Client) sendError(orderID, code, message string) {
+	msg := websocket.Message{
+		Type:      websocket.ServerError,
+		OrderID:   orderID,
+		Timestamp: time.Now(),
+		Data: &websocket.ErrorData{
+			Code:    code,
+			Message: message,
+		},
+	}
+
+	select {
+	case c.send <- msg:
+	default:
+		logs.Warn("Client send buffer full, dropping error message", map[string]interface{}{
+			"user_id": c.userID,
+			"code":    code,
+		})
+	}
+}
+
 // WritePump maneja el envío de mensajes al cliente
 func (c *Client) writePump() {
 	ticker := time.NewTicker(30 * time.Second)
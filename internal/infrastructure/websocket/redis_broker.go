@@ -0,0 +1,58 @@
+package websocket
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// RedisBroker implementa Broker con Redis pub/sub para distribuir las actualizaciones entre réplicas
+type RedisBroker struct {
+	client *redis.Client
+}
+
+func NewRedisBroker(client *redis.Client) *RedisBroker {
+	return &RedisBroker{
+		client: client,
+	}
+}
+
+// Publish publica el mensaje en el canal de Redis
+func (b *RedisBroker) Publish(ctx context.Context, channel string, payload []byte) error {
+	return b.client.Publish(ctx, channel, payload).Err()
+}
+
+// Subscribe se suscribe a los canales y procesa los mensajes en segundo plano hasta que el contexto se cancela
+func (b *RedisBroker) Subscribe(ctx context.Context, channels []string, handler BrokerHandler) error {
+	pubsub := b.client.Subscribe(ctx, channels...)
+
+	// Esperar la confirmación para no perder mensajes publicados justo después de suscribirse
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return err
+	}
+
+	go func() {
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					logs.Warn("Redis pub/sub channel closed", map[string]interface{}{
+						"channels": channels,
+					})
+					return
+				}
+				handler(msg.Channel, []byte(msg.Payload))
+			}
+		}
+	}()
+
+	return nil
+}
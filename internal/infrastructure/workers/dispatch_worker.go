@@ -0,0 +1,59 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// DispatchWorker ejecuta periódicamente el despacho automático de pedidos pendientes
+type DispatchWorker struct {
+	dispatcher interfaces.Dispatcher
+	interval   time.Duration
+}
+
+func NewDispatchWorker(dispatcher interfaces.Dispatcher, interval time.Duration) *DispatchWorker {
+	return &DispatchWorker{
+		dispatcher: dispatcher,
+		interval:   interval,
+	}
+}
+
+// Run bloquea hasta que el contexto se cancela, un intervalo menor o igual a cero desactiva el worker
+func (w *DispatchWorker) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		logs.Info("Dispatch worker disabled")
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logs.Info("Dispatch worker started", map[string]interface{}{
+		"interval": w.interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			logs.Info("Dispatch worker stopped")
+			return
+		case <-ticker.C:
+			results, err := w.dispatcher.DispatchPendingOrders(ctx)
+			if err != nil {
+				logs.Error("Dispatch worker run failed", map[string]interface{}{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			if len(results) > 0 {
+				logs.Info("Dispatch worker assigned drivers", map[string]interface{}{
+					"dispatched": len(results),
+				})
+			}
+		}
+	}
+}
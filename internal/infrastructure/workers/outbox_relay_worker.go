@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// defaultOutboxRelayInterval se usa si no se configura el intervalo, sin el relay no se notifica ningún cambio
+const defaultOutboxRelayInterval = time.Second
+
+// OutboxRelayWorker publica periódicamente los eventos pendientes del outbox
+type OutboxRelayWorker struct {
+	relay    interfaces.OutboxRelayer
+	interval time.Duration
+}
+
+func NewOutboxRelayWorker(relay interfaces.OutboxRelayer, interval time.Duration) *OutboxRelayWorker {
+	if interval <= 0 {
+		interval = defaultOutboxRelayInterval
+	}
+
+	return &OutboxRelayWorker{
+		relay:    relay,
+		interval: interval,
+	}
+}
+
+// Run bloquea hasta que el contexto se cancela. Mientras haya eventos pendientes publica lotes
+// seguidos sin esperar al siguiente tick.
+func (w *OutboxRelayWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logs.Info("Outbox relay worker started", map[string]interface{}{
+		"interval": w.interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			logs.Info("Outbox relay worker stopped")
+			return
+		case <-ticker.C:
+			for {
+				published, err := w.relay.RelayPending(ctx)
+				if err != nil {
+					logs.Error("Outbox relay run failed", map[string]interface{}{
+						"error": err.Error(),
+					})
+					break
+				}
+				if published == 0 || ctx.Err() != nil {
+					break
+				}
+
+				logs.Debug("Outbox relay published events", map[string]interface{}{
+					"published": published,
+				})
+			}
+		}
+	}
+}
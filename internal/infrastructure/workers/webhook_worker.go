@@ -0,0 +1,59 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// WebhookWorker reintenta periódicamente las entregas de webhooks pendientes
+type WebhookWorker struct {
+	webhooks ports.WebhookManager
+	interval time.Duration
+}
+
+func NewWebhookWorker(webhooks ports.WebhookManager, interval time.Duration) *WebhookWorker {
+	return &WebhookWorker{
+		webhooks: webhooks,
+		interval: interval,
+	}
+}
+
+// Run bloquea hasta que el contexto se cancela, un intervalo menor o igual a cero desactiva el worker
+func (w *WebhookWorker) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		logs.Info("Webhook worker disabled")
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logs.Info("Webhook worker started", map[string]interface{}{
+		"interval": w.interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			logs.Info("Webhook worker stopped")
+			return
+		case <-ticker.C:
+			sent, err := w.webhooks.ProcessDueDeliveries(ctx)
+			if err != nil {
+				logs.Error("Webhook worker run failed", map[string]interface{}{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			if sent > 0 {
+				logs.Info("Webhook worker sent deliveries", map[string]interface{}{
+					"attempts": sent,
+				})
+			}
+		}
+	}
+}
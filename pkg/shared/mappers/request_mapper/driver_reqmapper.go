@@ -0,0 +1,87 @@
+package request_mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// DriverRequestToDriver convierte un DTO de creación de repartidor a una entidad de dominio
+func DriverRequestToDriver(req *dto.DriverCreateRequest) (*entities.Driver, error) {
+	now := time.Now()
+
+	driver := &entities.Driver{
+		UserID:        req.UserID,
+		LicenseNumber: req.LicenseNumber,
+		LicenseExpiry: req.LicenseExpiry,
+		VehicleType:   strings.ToUpper(req.VehicleType),
+		VehiclePlate:  strings.ToUpper(req.VehiclePlate),
+		VehicleModel:  req.VehicleModel,
+		VehicleColor:  req.VehicleColor,
+		IsActive:      true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	// Procesar los campos JSON, usando un objeto vacío por defecto
+	var err error
+	if driver.VehicleDetails, err = marshalJSONField(req.VehicleDetails); err != nil {
+		return nil, fmt.Errorf("error serializing vehicle details: %w", err)
+	}
+	if driver.Documentation, err = marshalJSONField(req.Documentation); err != nil {
+		return nil, fmt.Errorf("error serializing documentation: %w", err)
+	}
+
+	return driver, nil
+}
+
+// DriverUpdateRequestToDriver convierte un DTO de actualización de repartidor a una entidad de dominio
+func DriverUpdateRequestToDriver(driverID string, req *dto.DriverUpdateRequest) (*entities.Driver, error) {
+	// Crear objeto base del repartidor para actualización parcial
+	driver := &entities.Driver{
+		UserID:        driverID,
+		LicenseNumber: req.LicenseNumber,
+		VehicleType:   strings.ToUpper(req.VehicleType),
+		VehiclePlate:  strings.ToUpper(req.VehiclePlate),
+		VehicleModel:  req.VehicleModel,
+		VehicleColor:  req.VehicleColor,
+		UpdatedAt:     time.Now(),
+	}
+
+	if req.LicenseExpiry != nil {
+		driver.LicenseExpiry = *req.LicenseExpiry
+	}
+
+	// Procesar los campos JSON solo si se proporcionan
+	var err error
+	if req.VehicleDetails != nil {
+		if driver.VehicleDetails, err = marshalJSONField(req.VehicleDetails); err != nil {
+			return nil, fmt.Errorf("error serializing vehicle details: %w", err)
+		}
+	}
+	if req.Documentation != nil {
+		if driver.Documentation, err = marshalJSONField(req.Documentation); err != nil {
+			return nil, fmt.Errorf("error serializing documentation: %w", err)
+		}
+	}
+
+	return driver, nil
+}
+
+// marshalJSONField serializa un mapa a JSON devolviendo "{}" si está vacío
+func marshalJSONField(value map[string]interface{}) (string, error) {
+	if len(value) == 0 {
+		return "{}", nil
+	}
+
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
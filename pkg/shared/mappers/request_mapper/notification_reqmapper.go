@@ -0,0 +1,22 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// NotificationPreferenceRequestToSettings convierte un DTO de preferencias de notificación a los canales de cada tipo
+func NotificationPreferenceRequestToSettings(req *dto.NotificationPreferenceRequest) []entities.NotificationChannelSettings {
+	settings := make([]entities.NotificationChannelSettings, len(req.Preferences))
+
+	for i, preference := range req.Preferences {
+		settings[i] = entities.NotificationChannelSettings{
+			NotificationType: preference.NotificationType,
+			EmailEnabled:     preference.EmailEnabled,
+			PushEnabled:      preference.PushEnabled,
+			SMSEnabled:       preference.SMSEnabled,
+		}
+	}
+
+	return settings
+}
@@ -25,8 +25,6 @@ func OrderRequestToOrder(req *dto.OrderCreateRequest, companyAddress *entities.C
 	// Crear detalles del pedido (información esencial)
 	order.Detail = &entities.Details{
 		OrderID:           orderID,
-		Price:             req.Price,
-		Distance:          req.Distance,
 		PickupTime:        req.PickupTime,
 		DeliveryDeadline:  req.DeliveryDeadline,
 		RequiresSignature: req.RequiresSignature,
@@ -52,6 +50,8 @@ func OrderRequestToOrder(req *dto.OrderCreateRequest, companyAddress *entities.C
 		State:          req.DeliveryAddress.State,
 		PostalCode:     req.DeliveryAddress.PostalCode,
 		AddressNotes:   req.DeliveryAddress.AddressNotes,
+		Latitude:       req.DeliveryAddress.Latitude,
+		Longitude:      req.DeliveryAddress.Longitude,
 		CreatedAt:      time.Now(),
 	}
 
@@ -82,6 +82,26 @@ func OrderRequestToOrder(req *dto.OrderCreateRequest, companyAddress *entities.C
 	return order, nil
 }
 
+// OrderQuoteRequestToOrder arma un pedido con los datos necesarios para cotizar su precio
+func OrderQuoteRequestToOrder(req *dto.OrderQuoteRequest, companyAddress *entities.CompanyAddress) (*entities.Order, error) {
+	packageDetail, err := createPackageDetail(req.PackageDetails, "")
+	if err != nil {
+		return nil, fmt.Errorf("error creating package details: %w", err)
+	}
+
+	return &entities.Order{
+		PackageDetail: packageDetail,
+		PickupAddress: &entities.PickupAddress{
+			Latitude:  companyAddress.Latitude,
+			Longitude: companyAddress.Longitude,
+		},
+		DeliveryAddress: &entities.DeliveryAddress{
+			Latitude:  req.DeliveryLatitude,
+			Longitude: req.DeliveryLongitude,
+		},
+	}, nil
+}
+
 // En el mapper que procesa el DTO
 func createPackageDetail(req dto.PackageDetailRequest, orderID string) (*entities.PackageDetail, error) {
 	dimensionsJSON := ""
@@ -129,12 +149,6 @@ func UpdateOrderFromRequest(orderID string, req *dto.OrderUpdateRequest) (*entit
 		}
 
 		// Agregar solo los campos con valores
-		if req.Price > 0 {
-			order.Detail.Price = req.Price
-		}
-		if req.Distance > 0 {
-			order.Detail.Distance = req.Distance
-		}
 		if req.PickupTime != nil {
 			order.Detail.PickupTime = *req.PickupTime
 		}
@@ -247,9 +261,7 @@ func UpdateOrderFromRequest(orderID string, req *dto.OrderUpdateRequest) (*entit
 // Funciones auxiliares para verificar si hay campos a actualizar
 
 func hasDetailFields(req *dto.OrderUpdateRequest) bool {
-	return req.Price > 0 ||
-		req.Distance > 0 ||
-		req.PickupTime != nil ||
+	return req.PickupTime != nil ||
 		req.DeliveryDeadline != nil ||
 		req.RequiresSignature != nil ||
 		req.DeliveryNotes != ""
@@ -0,0 +1,57 @@
+package request_mapper
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// RoleRequestToRole convierte un DTO de creación de rol a una entidad de dominio
+func RoleRequestToRole(req *dto.RoleCreateRequest) *entities.Role {
+	now := time.Now()
+
+	return &entities.Role{
+		ID:          uuid.NewString(),
+		Name:        req.Name,
+		Description: req.Description,
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// RoleUpdateRequestToRole convierte un DTO de actualización de rol a una entidad de dominio
+func RoleUpdateRequestToRole(roleID string, req *dto.RoleUpdateRequest) *entities.Role {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return &entities.Role{
+		ID:          roleID,
+		Description: req.Description,
+		IsActive:    isActive,
+	}
+}
+
+// PermissionRequestToPermission convierte un DTO de permiso a una entidad de dominio.
+// Si permissionID está vacío se genera un nuevo ID.
+func PermissionRequestToPermission(permissionID string, req *dto.PermissionRequest) *entities.Permission {
+	now := time.Now()
+	if permissionID == "" {
+		permissionID = uuid.NewString()
+	}
+
+	return &entities.Permission{
+		ID:          permissionID,
+		Name:        req.Name,
+		Description: req.Description,
+		Resource:    req.Resource,
+		Action:      req.Action,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
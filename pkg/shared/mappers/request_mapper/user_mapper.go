@@ -2,7 +2,6 @@ package request_mapper
 
 import (
 	"errors"
-	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
@@ -159,10 +158,6 @@ func UpdateUserRequestToModel(req *dto.UpdateUserDTO) (*entities.User, error) {
 				return nil, error2.NewGeneralServiceError("UpdateUserDTO", "Validate", error2.ErrRoleMissing)
 			}
 
-			if !constants.ValidRoles[strings.ToUpper(role)] {
-				return nil, error2.NewGeneralServiceError("UpdateUserDTO", "Validate", error2.ErrInvalidRole)
-			}
-
 			roles = append(roles, entities.UserRole{
 				Role: &entities.Role{
 					Name: strings.ToUpper(role),
@@ -0,0 +1,30 @@
+package request_mapper
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// ZoneRequestToInput convierte un DTO de zona a los datos de dominio. Los límites pueden llegar como
+// objeto GeoJSON o como texto, el texto puede ser WKT o GeoJSON serializado
+func ZoneRequestToInput(req *dto.ZoneRequest) *entities.ZoneInput {
+	boundaries := strings.TrimSpace(string(req.Boundaries))
+
+	var text string
+	if strings.HasPrefix(boundaries, `"`) && json.Unmarshal(req.Boundaries, &text) == nil {
+		boundaries = text
+	}
+
+	return &entities.ZoneInput{
+		Name:            req.Name,
+		Code:            req.Code,
+		Boundaries:      boundaries,
+		BaseRate:        req.BaseRate,
+		MaxDeliveryTime: req.MaxDeliveryTime,
+		PriorityLevel:   req.PriorityLevel,
+		IsActive:        req.IsActive,
+	}
+}
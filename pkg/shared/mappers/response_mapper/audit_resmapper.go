@@ -0,0 +1,55 @@
+package response_mapper
+
+import (
+	"encoding/json"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// MapAuditLogsToResponse mapea los registros de auditoría a DTOs de respuesta
+func MapAuditLogsToResponse(auditLogs []entities.AuditLog, params *entities.AuditLogQueryParams, total int64) *dto.PaginatedResponse {
+	response := make([]dto.AuditLogResponse, len(auditLogs))
+
+	for i, log := range auditLogs {
+		response[i] = dto.AuditLogResponse{
+			ID:         log.ID,
+			CompanyID:  log.CompanyID,
+			Action:     log.Action,
+			EntityType: log.EntityType,
+			EntityID:   log.EntityID,
+			OldValues:  rawJSON(log.OldValues),
+			NewValues:  rawJSON(log.NewValues),
+			IPAddress:  log.IPAddress,
+			UserAgent:  log.UserAgent,
+			CreatedAt:  log.CreatedAt,
+		}
+
+		if log.UserID != nil {
+			response[i].UserID = *log.UserID
+		}
+		if log.User != nil {
+			response[i].UserName = log.User.FullName
+			response[i].UserEmail = log.User.Email
+		}
+		if log.APIKeyID != nil {
+			response[i].APIKeyID = *log.APIKeyID
+		}
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       response,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
+
+// rawJSON devuelve el JSON guardado o null si no hay valor
+func rawJSON(value *string) json.RawMessage {
+	if value == nil || *value == "" {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(*value)
+}
@@ -0,0 +1,31 @@
+package response_mapper
+
+import (
+	"encoding/json"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// SessionsToResponse convierte las sesiones de un usuario a DTOs marcando la sesión actual
+func SessionsToResponse(sessions []entities.UserSession, currentSessionID string) []dto.SessionResponse {
+	response := make([]dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		var deviceInfo map[string]interface{}
+		if session.DeviceInfo != "" {
+			_ = json.Unmarshal([]byte(session.DeviceInfo), &deviceInfo)
+		}
+
+		response = append(response, dto.SessionResponse{
+			ID:           session.ID,
+			DeviceInfo:   deviceInfo,
+			IPAddress:    session.IPAddress,
+			LastActivity: session.LastActivity,
+			ExpiresAt:    session.ExpiresAt,
+			CreatedAt:    session.CreatedAt,
+			Current:      currentSessionID != "" && session.ID == currentSessionID,
+		})
+	}
+
+	return response
+}
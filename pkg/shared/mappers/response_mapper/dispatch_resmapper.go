@@ -0,0 +1,34 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// DispatchResultToResponseDTO convierte el resultado de un despacho a su DTO de respuesta
+func DispatchResultToResponseDTO(result *entities.DispatchResult) *dto.DispatchResultResponse {
+	return &dto.DispatchResultResponse{
+		OrderID:    result.OrderID,
+		DriverID:   result.DriverID,
+		ZoneID:     result.ZoneID,
+		Strategy:   result.Strategy,
+		DistanceKm: result.DistanceKm,
+		Score:      result.Score,
+		Candidates: result.Candidates,
+		AssignedAt: result.AssignedAt,
+	}
+}
+
+// DispatchResultsToResponseDTO convierte el resultado del despacho por lotes a su DTO de respuesta
+func DispatchResultsToResponseDTO(results []entities.DispatchResult) *dto.DispatchBatchResponse {
+	response := &dto.DispatchBatchResponse{
+		Dispatched: len(results),
+		Results:    make([]dto.DispatchResultResponse, 0, len(results)),
+	}
+
+	for i := range results {
+		response.Results = append(response.Results, *DispatchResultToResponseDTO(&results[i]))
+	}
+
+	return response
+}
@@ -0,0 +1,92 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// DriverToResponseDTO mapea una entidad de repartidor a su DTO de respuesta
+func DriverToResponseDTO(driver *entities.Driver) dto.DriverResponse {
+	response := dto.DriverResponse{
+		UserID:              driver.UserID,
+		LicenseNumber:       driver.LicenseNumber,
+		LicenseExpiry:       driver.LicenseExpiry,
+		VehicleType:         driver.VehicleType,
+		VehiclePlate:        driver.VehiclePlate,
+		VehicleModel:        driver.VehicleModel,
+		VehicleColor:        driver.VehicleColor,
+		VehicleDetails:      driver.VehicleDetails,
+		Documentation:       driver.Documentation,
+		IsActive:            driver.IsActive,
+		Rating:              driver.Rating,
+		CompletedDeliveries: driver.CompletedDeliveries,
+		LastDelivery:        driver.LastDelivery,
+		Zones:               DriverZonesToResponseDTO(driver.DriverZones),
+		CreatedAt:           driver.CreatedAt,
+		UpdatedAt:           driver.UpdatedAt,
+	}
+
+	// Incluir datos del usuario si están disponibles
+	if driver.User != nil {
+		response.FullName = driver.User.FullName
+		response.Email = driver.User.Email
+		response.Phone = driver.User.Phone
+	}
+
+	return response
+}
+
+// DriverZonesToResponseDTO mapea las zonas de un repartidor a sus DTOs de respuesta
+func DriverZonesToResponseDTO(zones []entities.DriverZone) []dto.DriverZoneResponse {
+	response := make([]dto.DriverZoneResponse, len(zones))
+
+	for i, zone := range zones {
+		response[i] = dto.DriverZoneResponse{
+			ZoneID:              zone.ZoneID,
+			IsPrimary:           zone.IsPrimary,
+			EfficiencyRating:    zone.EfficiencyRating,
+			DeliveriesCompleted: zone.DeliveriesCompleted,
+		}
+
+		if zone.Zone != nil {
+			response[i].ZoneName = zone.Zone.Name
+		}
+	}
+
+	return response
+}
+
+// MapDriversToResponse mapea un conjunto de repartidores a una respuesta paginada
+func MapDriversToResponse(drivers []entities.Driver, params *entities.DriverQueryParams, total int64) *dto.PaginatedResponse {
+	responseItems := make([]dto.DriverListResponse, len(drivers))
+
+	for i, driver := range drivers {
+		responseItems[i] = dto.DriverListResponse{
+			UserID:       driver.UserID,
+			VehicleType:  driver.VehicleType,
+			VehiclePlate: driver.VehiclePlate,
+			IsActive:     driver.IsActive,
+			Rating:       driver.Rating,
+			CreatedAt:    driver.CreatedAt,
+		}
+
+		if driver.User != nil {
+			responseItems[i].FullName = driver.User.FullName
+		}
+
+		for _, zone := range driver.DriverZones {
+			if zone.IsPrimary && zone.Zone != nil {
+				responseItems[i].PrimaryZoneName = zone.Zone.Name
+				break
+			}
+		}
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       responseItems,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
@@ -0,0 +1,67 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// MapNotificationToResponse mapea una notificación de la bandeja a su DTO de respuesta
+func MapNotificationToResponse(notification *entities.Notification) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Content:   notification.Content,
+		Metadata:  rawJSON(&notification.Metadata),
+		IsRead:    notification.IsRead,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+// MapNotificationsToResponse mapea la bandeja del usuario a una respuesta paginada
+func MapNotificationsToResponse(notifications []entities.Notification, params *entities.NotificationQueryParams, total int64) *dto.PaginatedResponse {
+	response := make([]dto.NotificationResponse, len(notifications))
+
+	for i := range notifications {
+		response[i] = MapNotificationToResponse(&notifications[i])
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       response,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
+
+// MapNotificationDeviceToResponse mapea un dispositivo push a su DTO de respuesta, el token no se devuelve
+func MapNotificationDeviceToResponse(device *entities.NotificationDevice) dto.NotificationDeviceResponse {
+	return dto.NotificationDeviceResponse{
+		ID:         device.ID,
+		DeviceType: device.DeviceType,
+		IsActive:   device.IsActive,
+		LastUsedAt: device.LastUsedAt,
+		CreatedAt:  device.CreatedAt,
+	}
+}
+
+// MapNotificationPreferencesToResponse mapea los canales efectivos de cada tipo de notificación a su DTO de respuesta
+func MapNotificationPreferencesToResponse(settings []entities.NotificationChannelSettings) []dto.NotificationPreferenceResponse {
+	response := make([]dto.NotificationPreferenceResponse, len(settings))
+
+	for i, setting := range settings {
+		response[i] = dto.NotificationPreferenceResponse{
+			NotificationPreferenceItem: dto.NotificationPreferenceItem{
+				NotificationType: setting.NotificationType,
+				EmailEnabled:     setting.EmailEnabled,
+				PushEnabled:      setting.PushEnabled,
+				SMSEnabled:       setting.SMSEnabled,
+			},
+			Source: setting.Source,
+		}
+	}
+
+	return response
+}
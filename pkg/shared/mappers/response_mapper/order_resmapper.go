@@ -15,6 +15,7 @@ func OrderToResponseDTO(order *entities.Order) *dto.OrderResponse {
 		BranchID:       order.BranchID,
 		ClientID:       order.ClientID,
 		DriverID:       order.DriverID,
+		ZoneID:         order.ZoneID,
 		TrackingNumber: order.TrackingNumber,
 		Status:         order.Status,
 		CreatedAt:      order.CreatedAt,
@@ -107,6 +108,28 @@ func OrderToResponseDTO(order *entities.Order) *dto.OrderResponse {
 	return response
 }
 
+// PriceQuoteToResponseDTO mapea la cotización de un pedido a su DTO de respuesta
+func PriceQuoteToResponseDTO(quote *entities.PriceQuote) *dto.PriceQuoteResponse {
+	items := make([]dto.PriceQuoteItemResponse, len(quote.Items))
+	for i, item := range quote.Items {
+		items[i] = dto.PriceQuoteItemResponse{
+			Concept:     item.Concept,
+			Description: item.Description,
+			Amount:      item.Amount,
+		}
+	}
+
+	return &dto.PriceQuoteResponse{
+		ZoneID:          quote.ZoneID,
+		DistanceKm:      quote.DistanceKm,
+		BillableWeight:  quote.BillableWeight,
+		SurgeMultiplier: quote.SurgeMultiplier,
+		Currency:        quote.Currency,
+		Items:           items,
+		Total:           quote.Total,
+	}
+}
+
 // MapOrdersToResponse mapea las órdenes a DTOs de respuesta
 func MapOrdersToResponse(orders []entities.Order, params *entities.OrderQueryParams, total int64) *dto.PaginatedResponse {
 	response := make([]dto.OrderListResponse, len(orders))
@@ -0,0 +1,57 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// MapSystemEventsToResponse mapea los eventos del sistema y sus logs a DTOs de respuesta
+func MapSystemEventsToResponse(events []entities.SystemEvent, params *entities.SystemEventQueryParams, total int64) *dto.PaginatedResponse {
+	response := make([]dto.SystemEventResponse, len(events))
+
+	for i, event := range events {
+		response[i] = dto.SystemEventResponse{
+			ID:         event.ID,
+			EventType:  event.EventType,
+			Source:     event.Source,
+			SourceID:   event.SourceID,
+			Severity:   event.Severity,
+			EventData:  rawJSON(&event.EventData),
+			OccurredAt: event.OccurredAt,
+			Logs:       make([]dto.EventLogResponse, len(event.Logs)),
+		}
+
+		for j, log := range event.Logs {
+			response[i].Logs[j] = dto.EventLogResponse{
+				ID:          log.ID,
+				LogLevel:    log.LogLevel,
+				Description: log.Description,
+				Metadata:    rawJSON(&log.Metadata),
+				CreatedAt:   log.CreatedAt,
+			}
+		}
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       response,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
+
+// MapSystemEventSummariesToResponse mapea el conteo de eventos por tipo y severidad
+func MapSystemEventSummariesToResponse(summaries []entities.SystemEventSummary) []dto.SystemEventSummaryResponse {
+	response := make([]dto.SystemEventSummaryResponse, len(summaries))
+
+	for i, summary := range summaries {
+		response[i] = dto.SystemEventSummaryResponse{
+			EventType: summary.EventType,
+			Severity:  summary.Severity,
+			Count:     summary.Count,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,49 @@
+package response_mapper
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// LocationHistoryToGeoJSON convierte el recorrido de un pedido a un Feature GeoJSON con geometría LineString
+func LocationHistoryToGeoJSON(order *entities.Order, pings []entities.LocationPing) *dto.LocationHistoryResponse {
+	response := &dto.LocationHistoryResponse{
+		Type: "Feature",
+		Properties: dto.LocationHistoryProperties{
+			OrderID:        order.ID,
+			TrackingNumber: order.TrackingNumber,
+			DriverIDs:      []string{},
+			Points:         len(pings),
+			Timestamps:     make([]time.Time, 0, len(pings)),
+		},
+	}
+
+	coordinates := make([][2]float64, 0, len(pings))
+	seenDrivers := make(map[string]bool)
+	for _, ping := range pings {
+		coordinates = append(coordinates, [2]float64{ping.Longitude, ping.Latitude})
+		response.Properties.Timestamps = append(response.Properties.Timestamps, ping.RecordedAt)
+
+		if !seenDrivers[ping.DriverID] {
+			seenDrivers[ping.DriverID] = true
+			response.Properties.DriverIDs = append(response.Properties.DriverIDs, ping.DriverID)
+		}
+	}
+
+	if len(pings) > 0 {
+		response.Properties.StartedAt = &pings[0].RecordedAt
+		response.Properties.EndedAt = &pings[len(pings)-1].RecordedAt
+	}
+
+	// Un LineString requiere al menos dos posiciones
+	if len(coordinates) >= 2 {
+		response.Geometry = &dto.LineStringGeometry{
+			Type:        "LineString",
+			Coordinates: coordinates,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,47 @@
+package response_mapper
+
+import (
+	"encoding/json"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// MapZoneToResponse mapea una zona a su DTO de respuesta, los límites WKT se devuelven como GeoJSON
+func MapZoneToResponse(zone *entities.Zone) dto.ZoneResponse {
+	response := dto.ZoneResponse{
+		ID:              zone.ID,
+		Name:            zone.Name,
+		Code:            zone.Code,
+		BaseRate:        zone.BaseRate,
+		MaxDeliveryTime: zone.MaxDeliveryTime,
+		PriorityLevel:   zone.PriorityLevel,
+		IsActive:        zone.IsActive,
+		CreatedAt:       zone.CreatedAt,
+		UpdatedAt:       zone.UpdatedAt,
+	}
+
+	if polygon, err := value_objects.NewGeoPolygonFromWKT(zone.Boundaries); err == nil {
+		response.Boundaries = json.RawMessage(polygon.ToGeoJSON())
+	}
+	if center, err := value_objects.NewGeoPointFromWKT(zone.CenterPoint); err == nil {
+		response.CenterPoint = &dto.ZoneCenterPoint{
+			Latitude:  center.Latitude(),
+			Longitude: center.Longitude(),
+		}
+	}
+
+	return response
+}
+
+// MapZonesToResponse mapea una lista de zonas a sus DTOs de respuesta
+func MapZonesToResponse(zones []entities.Zone) []dto.ZoneResponse {
+	response := make([]dto.ZoneResponse, len(zones))
+
+	for i := range zones {
+		response[i] = MapZoneToResponse(&zones[i])
+	}
+
+	return response
+}
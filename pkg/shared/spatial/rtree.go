@@ -0,0 +1,159 @@
+package spatial
+
+import (
+	"math"
+	"sort"
+)
+
+// nodeCapacity es la cantidad máxima de hijos o entradas por nodo
+const nodeCapacity = 8
+
+// Rect es un rectángulo alineado a los ejes, X es la longitud y Y la latitud
+type Rect struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// Contains indica si el punto está dentro del rectángulo, incluyendo sus bordes
+func (r Rect) Contains(x, y float64) bool {
+	return x >= r.MinX && x <= r.MaxX && y >= r.MinY && y <= r.MaxY
+}
+
+func (r Rect) union(other Rect) Rect {
+	return Rect{
+		MinX: math.Min(r.MinX, other.MinX),
+		MinY: math.Min(r.MinY, other.MinY),
+		MaxX: math.Max(r.MaxX, other.MaxX),
+		MaxY: math.Max(r.MaxY, other.MaxY),
+	}
+}
+
+func (r Rect) center() (float64, float64) {
+	return (r.MinX + r.MaxX) / 2, (r.MinY + r.MaxY) / 2
+}
+
+// Entry es un valor indexado por su rectángulo envolvente
+type Entry[T any] struct {
+	Bounds Rect
+	Value  T
+}
+
+type node[T any] struct {
+	bounds   Rect
+	children []*node[T]
+	entries  []Entry[T]
+}
+
+// RTree es un árbol R de solo lectura que se construye de una vez con Sort-Tile-Recursive. No admite
+// inserciones: cuando los datos cambian se construye otro, así las búsquedas no necesitan bloqueos
+type RTree[T any] struct {
+	root *node[T]
+	size int
+}
+
+// NewRTree construye el árbol con las entradas indicadas
+func NewRTree[T any](entries []Entry[T]) *RTree[T] {
+	tree := &RTree[T]{size: len(entries)}
+	if len(entries) == 0 {
+		return tree
+	}
+
+	// 1. Agrupar las entradas en hojas de rectángulos cercanos
+	bounds := make([]Rect, len(entries))
+	for i, entry := range entries {
+		bounds[i] = entry.Bounds
+	}
+
+	var level []*node[T]
+	for _, group := range strGroups(bounds) {
+		leaf := &node[T]{bounds: entries[group[0]].Bounds}
+		for _, i := range group {
+			leaf.entries = append(leaf.entries, entries[i])
+			leaf.bounds = leaf.bounds.union(entries[i].Bounds)
+		}
+		level = append(level, leaf)
+	}
+
+	// 2. Agrupar los nodos de cada nivel hasta llegar a la raíz
+	for len(level) > 1 {
+		bounds = make([]Rect, len(level))
+		for i, n := range level {
+			bounds[i] = n.bounds
+		}
+
+		var parents []*node[T]
+		for _, group := range strGroups(bounds) {
+			parent := &node[T]{bounds: level[group[0]].bounds}
+			for _, i := range group {
+				parent.children = append(parent.children, level[i])
+				parent.bounds = parent.bounds.union(level[i].bounds)
+			}
+			parents = append(parents, parent)
+		}
+		level = parents
+	}
+
+	tree.root = level[0]
+	return tree
+}
+
+// Search retorna los valores cuyo rectángulo contiene el punto
+func (t *RTree[T]) Search(x, y float64) []T {
+	var found []T
+	if t.root != nil {
+		t.root.search(x, y, &found)
+	}
+	return found
+}
+
+// Len retorna la cantidad de entradas del árbol
+func (t *RTree[T]) Len() int {
+	return t.size
+}
+
+func (n *node[T]) search(x, y float64, found *[]T) {
+	if !n.bounds.Contains(x, y) {
+		return
+	}
+
+	for _, entry := range n.entries {
+		if entry.Bounds.Contains(x, y) {
+			*found = append(*found, entry.Value)
+		}
+	}
+	for _, child := range n.children {
+		child.search(x, y, found)
+	}
+}
+
+// strGroups reparte los rectángulos en grupos de hasta nodeCapacity: los ordena por X en franjas
+// verticales y cada franja por Y, así cada grupo cubre un área compacta
+func strGroups(bounds []Rect) [][]int {
+	indexes := make([]int, len(bounds))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.Slice(indexes, func(a, b int) bool {
+		ax, _ := bounds[indexes[a]].center()
+		bx, _ := bounds[indexes[b]].center()
+		return ax < bx
+	})
+
+	nodes := int(math.Ceil(float64(len(bounds)) / nodeCapacity))
+	sliceSize := int(math.Ceil(math.Sqrt(float64(nodes)))) * nodeCapacity
+
+	var groups [][]int
+	for start := 0; start < len(indexes); start += sliceSize {
+		slice := indexes[start:min(start+sliceSize, len(indexes))]
+		sort.Slice(slice, func(a, b int) bool {
+			_, ay := bounds[slice[a]].center()
+			_, by := bounds[slice[b]].center()
+			return ay < by
+		})
+
+		for i := 0; i < len(slice); i += nodeCapacity {
+			groups = append(groups, slice[i:min(i+nodeCapacity, len(slice))])
+		}
+	}
+
+	return groups
+}
@@ -335,4 +335,80 @@ INSERT INTO company_users
 VALUES
     ('a1b2c3d4-e5f6-7890-a1b2-c3d4e5f6g7h8', 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11',
      'Jefe de empresa', 'Operaciones', NULL, true, '2025-03-04 03:53:32', '2025-03-04 03:53:32',
-     'b5f8c3d1-2e59-4c4b-a6e8-e5f3c0c3d1b5');
\ No newline at end of file
+     'b5f8c3d1-2e59-4c4b-a6e8-e5f3c0c3d1b5');
+
+-- Inicializacion de permisos (recurso:acción), el rol ADMIN tiene acceso completo sin necesidad de permisos
+-- El servidor también crea al iniciar los permisos que falten (internal/infrastructure/database/permission_seed.go)
+INSERT INTO permissions (id, name, description, resource, action, created_at, updated_at) VALUES
+    ('972a7f19-2157-5a13-900a-88991e556b6b', 'companies:create', 'Crear empresas', 'companies', 'create', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('86d25571-6aae-5774-a85a-e976a013eb45', 'companies:list', 'Listar empresas', 'companies', 'list', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('dbb79c37-4e50-57c7-b004-3a6176b162d4', 'companies:read', 'Consultar la empresa propia', 'companies', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('762e53c8-be76-55ba-bff4-8b5cfce98138', 'companies:update', 'Actualizar la empresa propia', 'companies', 'update', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('ab3cd2fe-59b9-53ec-832c-d877457e01ff', 'branches:read', 'Consultar sucursales', 'branches', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('899c42d3-8d15-5d1b-8c5b-a33fba35a134', 'branches:manage', 'Gestionar sucursales', 'branches', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('eb3c6663-35b6-5c3b-b62f-6b1629d83cd4', 'orders:create', 'Crear y cotizar pedidos', 'orders', 'create', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f', 'orders:read', 'Consultar pedidos', 'orders', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('91778558-50ab-588a-925f-7412dd78b65a', 'orders:update', 'Actualizar pedidos y su estado', 'orders', 'update', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('56899b47-dff3-5b4e-849f-e84e4be10ae5', 'orders:delete', 'Eliminar y restaurar pedidos', 'orders', 'delete', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('07b3e371-50d6-5c22-b1d2-5495ce390f1f', 'orders:dispatch', 'Asignar repartidores a pedidos', 'orders', 'dispatch', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('6cd8977f-cc2d-517a-a3de-57e5226d7f4d', 'drivers:read', 'Consultar repartidores', 'drivers', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('2a809578-d8ee-5987-b8c5-a317045a54d6', 'drivers:manage', 'Gestionar repartidores', 'drivers', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('22a3989e-cb66-53d3-8da4-dc4c8a88892a', 'tracking:read', 'Consultar el seguimiento de pedidos', 'tracking', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('66bbcbad-f1d8-56e9-8f30-6f9cd70322bc', 'tracking:update', 'Reportar la ubicación del repartidor', 'tracking', 'update', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('98f926fc-c168-5aed-98b9-e37e3148628b', 'users:create', 'Crear usuarios', 'users', 'create', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('439606ce-e48b-5708-be19-7831971b0681', 'users:read', 'Consultar usuarios', 'users', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('ab21c518-7fe2-56a8-8c09-d2a526cc2e0a', 'users:update', 'Actualizar usuarios', 'users', 'update', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('ec59c47b-910a-539b-bc72-0f41743f0054', 'users:delete', 'Eliminar usuarios', 'users', 'delete', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('6a44d09e-b225-512d-8753-767cbaf6b354', 'users:roles', 'Gestionar los roles de usuarios', 'users', 'roles', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('5fbd4b65-43e3-53e6-8a1a-3317b08dd8b7', 'roles:read', 'Consultar roles', 'roles', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('81dc7366-3464-5c1b-976d-3ac57f07157b', 'roles:manage', 'Gestionar roles y permisos', 'roles', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('616b427b-7011-5393-a9e4-040ecbfa660d', 'api_keys:manage', 'Gestionar las API keys de la empresa', 'api_keys', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('9b722bf7-9d25-500a-a211-afb17b3bc53e', 'webhooks:manage', 'Gestionar los webhooks de la empresa', 'webhooks', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('150f6e27-77ca-521c-b744-3a7f3da7d348', 'audit_logs:read', 'Consultar la auditoría de la empresa', 'audit_logs', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('7ce48cd9-4604-5241-8854-46d7b2a96dad', 'notifications:manage', 'Gestionar las preferencias de notificación de la empresa', 'notifications', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('4dc9e284-9d8a-5bc6-bd48-66eb191b0206', 'zones:read', 'Consultar las zonas de cobertura', 'zones', 'read', '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('aa395c24-0b0f-567c-87fc-e81549c600e2', 'zones:manage', 'Gestionar las zonas de cobertura', 'zones', 'manage', '2025-03-04 01:54:24', '2025-03-04 01:54:24');
+
+-- Asignacion de permisos por defecto a los roles
+INSERT INTO role_permissions (role_id, permission_id, created_at) VALUES
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', 'dbb79c37-4e50-57c7-b004-3a6176b162d4', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '762e53c8-be76-55ba-bff4-8b5cfce98138', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', 'ab3cd2fe-59b9-53ec-832c-d877457e01ff', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '899c42d3-8d15-5d1b-8c5b-a33fba35a134', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', 'eb3c6663-35b6-5c3b-b62f-6b1629d83cd4', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '91778558-50ab-588a-925f-7412dd78b65a', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '56899b47-dff3-5b4e-849f-e84e4be10ae5', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '6cd8977f-cc2d-517a-a3de-57e5226d7f4d', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '22a3989e-cb66-53d3-8da4-dc4c8a88892a', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '98f926fc-c168-5aed-98b9-e37e3148628b', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '439606ce-e48b-5708-be19-7831971b0681', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', 'ab21c518-7fe2-56a8-8c09-d2a526cc2e0a', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '5fbd4b65-43e3-53e6-8a1a-3317b08dd8b7', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '616b427b-7011-5393-a9e4-040ecbfa660d', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '9b722bf7-9d25-500a-a211-afb17b3bc53e', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '150f6e27-77ca-521c-b744-3a7f3da7d348', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '7ce48cd9-4604-5241-8854-46d7b2a96dad', '2025-03-04 01:54:24'),
+    ('991dfbd6-f89b-11ef-a120-0242ac120003', '4dc9e284-9d8a-5bc6-bd48-66eb191b0206', '2025-03-04 01:54:24'),
+    ('991e016f-f89b-11ef-a120-0242ac120003', '4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f', '2025-03-04 01:54:24'),
+    ('991e016f-f89b-11ef-a120-0242ac120003', '91778558-50ab-588a-925f-7412dd78b65a', '2025-03-04 01:54:24'),
+    ('991e016f-f89b-11ef-a120-0242ac120003', '22a3989e-cb66-53d3-8da4-dc4c8a88892a', '2025-03-04 01:54:24'),
+    ('991e016f-f89b-11ef-a120-0242ac120003', '66bbcbad-f1d8-56e9-8f30-6f9cd70322bc', '2025-03-04 01:54:24'),
+    ('991e01c7-f89b-11ef-a120-0242ac120003', '4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f', '2025-03-04 01:54:24'),
+    ('991e01c7-f89b-11ef-a120-0242ac120003', '91778558-50ab-588a-925f-7412dd78b65a', '2025-03-04 01:54:24'),
+    ('991e01c7-f89b-11ef-a120-0242ac120003', '22a3989e-cb66-53d3-8da4-dc4c8a88892a', '2025-03-04 01:54:24'),
+    ('991e01ed-f89b-11ef-a120-0242ac120003', '4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f', '2025-03-04 01:54:24'),
+    ('991e01ed-f89b-11ef-a120-0242ac120003', '91778558-50ab-588a-925f-7412dd78b65a', '2025-03-04 01:54:24'),
+    ('991e01ed-f89b-11ef-a120-0242ac120003', '22a3989e-cb66-53d3-8da4-dc4c8a88892a', '2025-03-04 01:54:24'),
+    ('991e01ed-f89b-11ef-a120-0242ac120003', '66bbcbad-f1d8-56e9-8f30-6f9cd70322bc', '2025-03-04 01:54:24'),
+    ('991a01ed-f89b-11ef-a120-0242ac120003', '4cce5ab9-d305-5a4f-a3e5-e3dfdb815c9f', '2025-03-04 01:54:24'),
+    ('991a01ed-f89b-11ef-a120-0242ac120003', '22a3989e-cb66-53d3-8da4-dc4c8a88892a', '2025-03-04 01:54:24');
+
+-- Plantillas de notificación de pedidos, se renderizan con text/template usando las variables indicadas
+INSERT INTO notification_templates (id, name, type, title_template, content_template, variables, is_active, created_at, updated_at) VALUES
+    ('292baae2-d32e-533b-8e36-10a29ac9278a', 'Pedido creado', 'ORDER_CREATED', 'Pedido {{.tracking_number}} recibido', 'Recibimos tu pedido {{.tracking_number}}, te avisaremos cuando tenga un repartidor asignado.', '["tracking_number"]', TRUE, '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('4c2ad14a-9a8c-5215-abcc-c3028f1243fe', 'Cambio de estado', 'ORDER_STATUS_CHANGED', 'Pedido {{.tracking_number}} actualizado', 'Tu pedido {{.tracking_number}} cambió de {{.previous_status}} a {{.status}}.', '["tracking_number", "status", "previous_status"]', TRUE, '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('05b92de2-8548-5547-9a03-ed38190ce71f', 'Repartidor asignado', 'ORDER_DRIVER_ASSIGNED', 'Pedido {{.tracking_number}} en camino', 'Se asignó un repartidor a tu pedido {{.tracking_number}}.', '["tracking_number"]', TRUE, '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('5bd41249-e0ff-54a2-9b5c-a43488da10e2', 'Pedido asignado al repartidor', 'ORDER_ASSIGNED_TO_DRIVER', 'Nuevo pedido asignado', 'Se te asignó el pedido {{.tracking_number}}, revisa la dirección de recogida en la aplicación.', '["tracking_number"]', TRUE, '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('7abc1347-c8d9-5688-801c-bef7480b7882', 'Pedido entregado', 'ORDER_DELIVERED', 'Pedido {{.tracking_number}} entregado', 'Tu pedido {{.tracking_number}} fue entregado, gracias por usar nuestro servicio.', '["tracking_number"]', TRUE, '2025-03-04 01:54:24', '2025-03-04 01:54:24'),
+    ('b65e8bf2-b5ac-5611-ab9f-af0b2d433d41', 'Pedido eliminado', 'ORDER_DELETED', 'Pedido {{.tracking_number}} cancelado', 'Tu pedido {{.tracking_number}} fue cancelado.', '["tracking_number"]', TRUE, '2025-03-04 01:54:24', '2025-03-04 01:54:24');
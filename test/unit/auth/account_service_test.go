@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	authAdapter "github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/auth"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/token"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// fakeUserRepository implementa solo las operaciones usadas por el servicio de cuentas
+type fakeUserRepository struct {
+	domainPorts.UserRepository
+	users    map[string]*entities.User
+	emailErr error
+}
+
+func (r *fakeUserRepository) GetByEmail(_ context.Context, email string) (*entities.User, error) {
+	if r.emailErr != nil {
+		return nil, r.emailErr
+	}
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeUserRepository) Update(_ context.Context, id string, changes *entities.User) error {
+	user, ok := r.users[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	if changes.PasswordHash != "" {
+		user.PasswordHash = changes.PasswordHash
+	}
+	if changes.EmailVerifiedAt != nil {
+		user.EmailVerifiedAt = changes.EmailVerifiedAt
+	}
+	return nil
+}
+
+// fakeAuthenticator registra las sesiones revocadas
+type fakeAuthenticator struct {
+	ports.Authenticator
+	sessions []entities.UserSession
+	revoked  []string
+}
+
+func (a *fakeAuthenticator) GetActiveSessions(_ context.Context, _ string) ([]entities.UserSession, error) {
+	return a.sessions, nil
+}
+
+func (a *fakeAuthenticator) RevokeSession(_ context.Context, _ string, sessionID string) error {
+	a.revoked = append(a.revoked, sessionID)
+	return nil
+}
+
+// memoryMailSender guarda los correos enviados
+type memoryMailSender struct {
+	sent []*ports.MailMessage
+}
+
+func (s *memoryMailSender) Send(_ context.Context, message *ports.MailMessage) error {
+	s.sent = append(s.sent, message)
+	return nil
+}
+
+var tokenPattern = regexp.MustCompile(`token=([^\s]+)`)
+
+func tokenFromMail(t *testing.T, message *ports.MailMessage) string {
+	t.Helper()
+	match := tokenPattern.FindStringSubmatch(message.Body)
+	if match == nil {
+		t.Fatalf("no token link in mail body %q", message.Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("unescape token: %v", err)
+	}
+	return token
+}
+
+func newAccountFixture() (ports.AccountRecoverer, *fakeUserRepository, *fakeAuthenticator, *memoryMailSender) {
+	users := &fakeUserRepository{users: map[string]*entities.User{
+		"user-1": {ID: "user-1", Email: "user@example.com", FullName: "Jane Doe", IsActive: true, PasswordHash: "old"},
+	}}
+	authenticator := &fakeAuthenticator{sessions: []entities.UserSession{{ID: "session-1"}, {ID: "session-2"}}}
+	sender := &memoryMailSender{}
+	service := authAdapter.NewAccountService(users, authenticator, token.NewOneTimeTokenService(newMemoryCache()), sender, "https://app.example.com/")
+
+	return service, users, authenticator, sender
+}
+
+func TestPasswordResetFlow(t *testing.T) {
+	service, users, authenticator, sender := newAccountFixture()
+	ctx := context.Background()
+
+	if err := service.RequestPasswordReset(ctx, "user@example.com"); err != nil {
+		t.Fatalf("RequestPasswordReset() error = %v", err)
+	}
+	if len(sender.sent) != 1 || sender.sent[0].To != "user@example.com" {
+		t.Fatalf("sent mails = %+v", sender.sent)
+	}
+	resetToken := tokenFromMail(t, sender.sent[0])
+
+	// Una contraseña inválida no consume el token
+	if err := service.ResetPassword(ctx, resetToken, "weak"); err == nil {
+		t.Fatal("ResetPassword() with weak password returned no error")
+	}
+
+	if err := service.ResetPassword(ctx, resetToken, "N3wP@ssword"); err != nil {
+		t.Fatalf("ResetPassword() error = %v", err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(users.users["user-1"].PasswordHash), []byte("N3wP@ssword")) != nil {
+		t.Error("password hash was not updated")
+	}
+	if len(authenticator.revoked) != 2 {
+		t.Errorf("revoked sessions = %v, want 2", authenticator.revoked)
+	}
+
+	// El token es de un solo uso
+	err := service.ResetPassword(ctx, resetToken, "An0therP@ss")
+	if !errors.Is(err, errPackage.ErrInvalidAccountToken) {
+		t.Errorf("second ResetPassword() error = %v, want ErrInvalidAccountToken", err)
+	}
+}
+
+func TestPasswordResetInvalidatesPreviousToken(t *testing.T) {
+	service, _, _, sender := newAccountFixture()
+	ctx := context.Background()
+
+	_ = service.RequestPasswordReset(ctx, "user@example.com")
+	_ = service.RequestPasswordReset(ctx, "user@example.com")
+	firstToken := tokenFromMail(t, sender.sent[0])
+	secondToken := tokenFromMail(t, sender.sent[1])
+
+	if err := service.ResetPassword(ctx, firstToken, "N3wP@ssword"); !errors.Is(err, errPackage.ErrInvalidAccountToken) {
+		t.Errorf("ResetPassword() with replaced token error = %v, want ErrInvalidAccountToken", err)
+	}
+	if err := service.ResetPassword(ctx, secondToken, "N3wP@ssword"); err != nil {
+		t.Errorf("ResetPassword() with latest token error = %v", err)
+	}
+}
+
+func TestPasswordResetUnknownEmailIsSilent(t *testing.T) {
+	service, _, _, sender := newAccountFixture()
+
+	if err := service.RequestPasswordReset(context.Background(), "nobody@example.com"); err != nil {
+		t.Fatalf("RequestPasswordReset() error = %v", err)
+	}
+	if len(sender.sent) != 0 {
+		t.Errorf("sent %d mails for an unknown email", len(sender.sent))
+	}
+}
+
+func TestPasswordResetSurfacesLookupFailures(t *testing.T) {
+	service, users, _, sender := newAccountFixture()
+	lookupErr := errors.New("connection refused")
+	users.emailErr = lookupErr
+
+	if err := service.RequestPasswordReset(context.Background(), "user@example.com"); !errors.Is(err, lookupErr) {
+		t.Fatalf("RequestPasswordReset() error = %v, want %v", err, lookupErr)
+	}
+	if len(sender.sent) != 0 {
+		t.Errorf("sent %d mails after a failed lookup", len(sender.sent))
+	}
+}
+
+func TestEmailVerificationFlow(t *testing.T) {
+	service, users, _, sender := newAccountFixture()
+	ctx := context.Background()
+
+	if err := service.RequestEmailVerification(ctx, "user@example.com"); err != nil {
+		t.Fatalf("RequestEmailVerification() error = %v", err)
+	}
+	if err := service.VerifyEmail(ctx, tokenFromMail(t, sender.sent[0])); err != nil {
+		t.Fatalf("VerifyEmail() error = %v", err)
+	}
+	if users.users["user-1"].EmailVerifiedAt == nil {
+		t.Fatal("EmailVerifiedAt was not set")
+	}
+
+	// Un correo ya verificado no recibe nuevos enlaces
+	if err := service.RequestEmailVerification(ctx, "user@example.com"); err != nil {
+		t.Fatalf("RequestEmailVerification() error = %v", err)
+	}
+	if len(sender.sent) != 1 {
+		t.Errorf("sent %d mails, want 1", len(sender.sent))
+	}
+}
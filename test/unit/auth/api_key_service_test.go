@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	authAdapter "github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/auth"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// memoryAPIKeyRepository guarda las API keys en memoria
+type memoryAPIKeyRepository struct {
+	keys map[string]*entities.CompanyAPIKey
+}
+
+func (r *memoryAPIKeyRepository) Create(_ context.Context, key *entities.CompanyAPIKey) error {
+	key.CreatedAt = time.Now()
+	r.keys[key.ID] = key
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) GetByID(_ context.Context, id string) (*entities.CompanyAPIKey, error) {
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return key, nil
+}
+
+func (r *memoryAPIKeyRepository) GetByHash(_ context.Context, keyHash string) (*entities.CompanyAPIKey, error) {
+	for _, key := range r.keys {
+		if key.KeyHash == keyHash {
+			return key, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryAPIKeyRepository) ListByCompany(_ context.Context, companyID string) ([]entities.CompanyAPIKey, error) {
+	var keys []entities.CompanyAPIKey
+	for _, key := range r.keys {
+		if key.CompanyID == companyID {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *memoryAPIKeyRepository) Rotate(_ context.Context, id, prefix, keyHash string) error {
+	key, ok := r.keys[id]
+	if !ok || key.RevokedAt != nil {
+		return gorm.ErrRecordNotFound
+	}
+	key.Prefix, key.KeyHash = prefix, keyHash
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) Revoke(_ context.Context, id string, at time.Time) error {
+	key, ok := r.keys[id]
+	if !ok || key.RevokedAt != nil {
+		return gorm.ErrRecordNotFound
+	}
+	key.RevokedAt = &at
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) TouchLastUsed(_ context.Context, id string, at time.Time, _ time.Duration) error {
+	r.keys[id].LastUsedAt = &at
+	return nil
+}
+
+// fakeCompanyRepository implementa solo la búsqueda de empresas y sucursales
+type fakeCompanyRepository struct {
+	domainPorts.CompanyRepository
+	companies map[string]*entities.Company
+	branches  map[string]*entities.Branch
+}
+
+func (r *fakeCompanyRepository) GetCompanyByID(_ context.Context, id string) (*entities.Company, error) {
+	company, ok := r.companies[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return company, nil
+}
+
+func (r *fakeCompanyRepository) GetBranchByID(_ context.Context, branchID string) (*entities.Branch, error) {
+	branch, ok := r.branches[branchID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return branch, nil
+}
+
+func newAPIKeyService() (ports.APIKeyManager, *fakeCompanyRepository) {
+	companies := &fakeCompanyRepository{
+		companies: map[string]*entities.Company{
+			"company-1": {ID: "company-1", IsActive: true},
+			"company-2": {ID: "company-2", IsActive: true},
+		},
+		branches: map[string]*entities.Branch{
+			"branch-1": {ID: "branch-1", CompanyID: "company-1", IsActive: true},
+			"branch-2": {ID: "branch-2", CompanyID: "company-2", IsActive: true},
+		},
+	}
+
+	return authAdapter.NewAPIKeyService(&memoryAPIKeyRepository{keys: map[string]*entities.CompanyAPIKey{}}, companies), companies
+}
+
+func TestAPIKeyLifecycle(t *testing.T) {
+	service, companies := newAPIKeyService()
+	ctx := context.Background()
+
+	issued, err := service.Create(ctx, &auth.APIKeyInput{
+		CompanyID: "company-1",
+		BranchID:  "branch-1",
+		Name:      "ERP",
+		Scopes:    []string{constants.PermissionOrdersRead, constants.PermissionOrdersCreate, constants.PermissionOrdersRead},
+		CreatedBy: "user-1",
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !strings.HasPrefix(issued.PlainKey, issued.Key.Prefix+"_") || strings.Contains(issued.Key.KeyHash, issued.PlainKey) {
+		t.Fatalf("unexpected key format: prefix %q, key %q", issued.Key.Prefix, issued.PlainKey)
+	}
+	if issued.Key.Scopes != "orders:create,orders:read" {
+		t.Errorf("Scopes = %q, want deduplicated and sorted", issued.Key.Scopes)
+	}
+
+	// La key autentica como la identidad de integración de la empresa
+	claims, err := service.Authenticate(ctx, issued.PlainKey)
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if !claims.IsAPIKey() || claims.CompanyID != "company-1" || claims.BranchID != "branch-1" || claims.Role != constants.IntegrationRole {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+
+	// Al rotar, la key anterior deja de funcionar
+	rotated, err := service.Rotate(ctx, issued.Key.ID)
+	if err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	if _, err := service.Authenticate(ctx, issued.PlainKey); !errors.Is(err, errPackage.ErrInvalidAPIKey) {
+		t.Errorf("Authenticate(old key) error = %v, want ErrInvalidAPIKey", err)
+	}
+	if _, err := service.Authenticate(ctx, rotated.PlainKey); err != nil {
+		t.Fatalf("Authenticate(rotated key) error = %v", err)
+	}
+
+	// Una empresa inactiva no puede usar sus keys
+	companies.companies["company-1"].IsActive = false
+	if _, err := service.Authenticate(ctx, rotated.PlainKey); !errors.Is(err, errPackage.ErrInvalidAPIKey) {
+		t.Errorf("Authenticate(inactive company) error = %v, want ErrInvalidAPIKey", err)
+	}
+	companies.companies["company-1"].IsActive = true
+
+	// Una key revocada no se puede usar ni rotar
+	if err := service.Revoke(ctx, issued.Key.ID); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if _, err := service.Authenticate(ctx, rotated.PlainKey); !errors.Is(err, errPackage.ErrInvalidAPIKey) {
+		t.Errorf("Authenticate(revoked key) error = %v, want ErrInvalidAPIKey", err)
+	}
+	if _, err := service.Rotate(ctx, issued.Key.ID); !errors.Is(err, errPackage.ErrAPIKeyNotFound) {
+		t.Errorf("Rotate(revoked key) error = %v, want ErrAPIKeyNotFound", err)
+	}
+}
+
+func TestAPIKeyCreateValidation(t *testing.T) {
+	service, _ := newAPIKeyService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		input auth.APIKeyInput
+		want  error
+	}{
+		{"missing name", auth.APIKeyInput{CompanyID: "company-1", BranchID: "branch-1", Scopes: []string{"orders:read"}}, errPackage.ErrAPIKeyNameRequired},
+		{"no scopes", auth.APIKeyInput{CompanyID: "company-1", BranchID: "branch-1", Name: "ERP"}, errPackage.ErrInvalidAPIKeyScopes},
+		{"scope not allowed", auth.APIKeyInput{CompanyID: "company-1", BranchID: "branch-1", Name: "ERP", Scopes: []string{"users:delete"}}, errPackage.ErrInvalidAPIKeyScopes},
+		{"branch of another company", auth.APIKeyInput{CompanyID: "company-1", BranchID: "branch-2", Name: "ERP", Scopes: []string{"orders:read"}}, errPackage.ErrAPIKeyBranchInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			if _, err := service.Create(ctx, &input); !errors.Is(err, tt.want) {
+				t.Errorf("Create() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyExpired(t *testing.T) {
+	service, _ := newAPIKeyService()
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(-time.Minute)
+	issued, err := service.Create(ctx, &auth.APIKeyInput{
+		CompanyID: "company-1",
+		BranchID:  "branch-1",
+		Name:      "ERP",
+		Scopes:    []string{"tracking:read"},
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := service.Authenticate(ctx, issued.PlainKey); !errors.Is(err, errPackage.ErrInvalidAPIKey) {
+		t.Errorf("Authenticate(expired key) error = %v, want ErrInvalidAPIKey", err)
+	}
+	if _, err := service.Authenticate(ctx, "Bearer something"); !errors.Is(err, errPackage.ErrInvalidAPIKey) {
+		t.Errorf("Authenticate(malformed key) error = %v, want ErrInvalidAPIKey", err)
+	}
+}
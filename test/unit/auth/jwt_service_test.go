@@ -1 +1,326 @@
 package auth
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	authAdapter "github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/auth"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/token"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// memoryCache implementa ports.Cacher en memoria para las pruebas
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+	ttls   map[string]time.Duration
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: map[string]string{}, ttls: map[string]time.Duration{}}
+}
+
+func (c *memoryCache) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = string(value)
+	c.ttls[key] = ttl
+	return nil
+}
+
+func (c *memoryCache) Get(key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return "", redis.Nil
+	}
+	return value, nil
+}
+
+func (c *memoryCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func (c *memoryCache) Increment(key string, ttl time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	count, _ := strconv.ParseInt(c.values[key], 10, 64)
+	count++
+	if count == 1 {
+		c.ttls[key] = ttl
+	}
+	c.values[key] = strconv.FormatInt(count, 10)
+	return count, nil
+}
+
+func (c *memoryCache) GetRedisClient() *redis.Client                 { return nil }
+func (c *memoryCache) RPush(string, []byte) error                    { return nil }
+func (c *memoryCache) LPush(string, []byte) error                    { return nil }
+func (c *memoryCache) LRange(string, int64, int64) ([]string, error) { return nil, nil }
+func (c *memoryCache) LLen(string) (int64, error)                    { return 0, nil }
+func (c *memoryCache) LTrim(string, int64, int64) error              { return nil }
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	os.Exit(m.Run())
+}
+
+func TestGenerateTokenUsesConfiguredTTL(t *testing.T) {
+	cache := newMemoryCache()
+	service := token.NewJWTService("secret", 5*time.Minute, cache)
+
+	claims := &auth.AuthClaims{UserID: "user-1", Role: "ADMIN", SessionID: "session-1"}
+	signed, err := service.GenerateToken(claims)
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	if ttl := cache.ttls["token:"+signed]; ttl != 5*time.Minute {
+		t.Errorf("cached ttl = %v, want %v", ttl, 5*time.Minute)
+	}
+
+	validated, err := service.ValidateToken(signed)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if validated.SessionID != "session-1" {
+		t.Errorf("session id = %q, want %q", validated.SessionID, "session-1")
+	}
+	if remaining := time.Until(validated.ExpiresAt); remaining > 5*time.Minute || remaining < 4*time.Minute {
+		t.Errorf("expires in %v, want about 5m", remaining)
+	}
+}
+
+func TestNewJWTServiceDefaultsToShortLivedTokens(t *testing.T) {
+	service := token.NewJWTService("secret", 0, newMemoryCache())
+
+	if ttl := service.GetTokenTTL(); ttl != 15*time.Minute {
+		t.Errorf("GetTokenTTL() = %v, want %v", ttl, 15*time.Minute)
+	}
+}
+
+func TestRevokedTokenIsRejected(t *testing.T) {
+	service := token.NewJWTService("secret", time.Minute, newMemoryCache())
+
+	signed, err := service.GenerateToken(&auth.AuthClaims{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	if err = service.RevokeToken(signed); err != nil {
+		t.Fatalf("RevokeToken() error = %v", err)
+	}
+
+	if _, err = service.ValidateToken(signed); err == nil {
+		t.Error("ValidateToken() on a revoked token returned no error")
+	}
+}
+
+// memorySessionRepository guarda sesiones y refresh tokens en memoria con la misma semántica que el repositorio:
+// RotateRefreshToken solo marca el token si no se había usado y retorna gorm.ErrRecordNotFound en caso contrario
+type memorySessionRepository struct {
+	domainPorts.UserRepository
+	mu       sync.Mutex
+	user     *entities.User
+	sessions map[string]*entities.UserSession
+	tokens   map[string]*entities.SessionRefreshToken
+
+	// readGate, si existe, detiene cada lectura de un refresh token hasta que todas las peticiones lo leyeron
+	readGate *sync.WaitGroup
+}
+
+func newMemorySessionRepository() *memorySessionRepository {
+	return &memorySessionRepository{
+		user:     &entities.User{ID: "user-1", Email: "user@example.com", IsActive: true},
+		sessions: map[string]*entities.UserSession{},
+		tokens:   map[string]*entities.SessionRefreshToken{},
+	}
+}
+
+func (r *memorySessionRepository) GetByID(_ context.Context, _ string) (*entities.User, error) {
+	user := *r.user
+	return &user, nil
+}
+
+func (r *memorySessionRepository) GetUserRoles(_ context.Context, _ string) ([]entities.Role, error) {
+	return []entities.Role{{Name: "COMPANY_USER"}}, nil
+}
+
+func (r *memorySessionRepository) CreateSessionWithRefreshToken(_ context.Context, session *entities.UserSession, refreshToken *entities.SessionRefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *session
+	r.sessions[session.ID] = &stored
+	storedToken := *refreshToken
+	r.tokens[refreshToken.ID] = &storedToken
+	return nil
+}
+
+func (r *memorySessionRepository) GetRefreshTokenByHash(_ context.Context, tokenHash string) (*entities.SessionRefreshToken, error) {
+	r.mu.Lock()
+	var found *entities.SessionRefreshToken
+	for _, refreshToken := range r.tokens {
+		if refreshToken.TokenHash == tokenHash {
+			copied := *refreshToken
+			if session, ok := r.sessions[refreshToken.SessionID]; ok {
+				sessionCopy := *session
+				copied.Session = &sessionCopy
+			}
+			found = &copied
+		}
+	}
+	r.mu.Unlock()
+
+	if r.readGate != nil {
+		r.readGate.Done()
+		r.readGate.Wait()
+	}
+	if found == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func (r *memorySessionRepository) RotateRefreshToken(_ context.Context, usedTokenID string, session *entities.UserSession, newToken *entities.SessionRefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	used, ok := r.tokens[usedTokenID]
+	if !ok || used.UsedAt != nil {
+		return gorm.ErrRecordNotFound
+	}
+	now := time.Now()
+	used.UsedAt = &now
+
+	stored := *session
+	r.sessions[session.ID] = &stored
+	storedToken := *newToken
+	r.tokens[newToken.ID] = &storedToken
+	return nil
+}
+
+func (r *memorySessionRepository) DeleteSession(_ context.Context, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.sessions[sessionID]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(r.sessions, sessionID)
+	for id, refreshToken := range r.tokens {
+		if refreshToken.SessionID == sessionID {
+			delete(r.tokens, id)
+		}
+	}
+	return nil
+}
+
+func newRotationFixture(t *testing.T) (*memorySessionRepository, *token.JWTService, *auth.TokenPair, func(string) (*auth.TokenPair, error)) {
+	repo := newMemorySessionRepository()
+	jwtService := token.NewJWTService("secret", time.Minute, newMemoryCache())
+	authService := authAdapter.NewAuthService(repo, jwtService, time.Hour)
+
+	pair, err := authService.CreateSession(context.Background(), repo.user, nil, "10.0.0.1")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+
+	return repo, jwtService, pair, func(refreshToken string) (*auth.TokenPair, error) {
+		return authService.RefreshSession(context.Background(), refreshToken)
+	}
+}
+
+func TestRotateRefreshTokenIssuesNewPair(t *testing.T) {
+	repo, jwtService, pair, refresh := newRotationFixture(t)
+
+	rotated, err := refresh(pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshSession() error = %v", err)
+	}
+	if rotated.RefreshToken == pair.RefreshToken {
+		t.Error("refresh token was not rotated")
+	}
+	if _, err = jwtService.ValidateToken(rotated.AccessToken); err != nil {
+		t.Errorf("new access token is not valid: %v", err)
+	}
+	if len(repo.sessions) != 1 || len(repo.tokens) != 2 {
+		t.Errorf("sessions = %d, refresh tokens = %d; want 1 session with the used and the new token", len(repo.sessions), len(repo.tokens))
+	}
+
+	// El token nuevo se puede rotar a su vez
+	if _, err = refresh(rotated.RefreshToken); err != nil {
+		t.Errorf("RefreshSession() with the rotated token error = %v", err)
+	}
+}
+
+func TestReusedRefreshTokenRevokesSessionFamily(t *testing.T) {
+	repo, jwtService, pair, refresh := newRotationFixture(t)
+
+	rotated, err := refresh(pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshSession() error = %v", err)
+	}
+
+	// Reutilizar el token ya rotado revoca la sesión con todos sus tokens
+	if _, err = refresh(pair.RefreshToken); !errors.Is(err, errPackage.ErrRefreshTokenReused) {
+		t.Fatalf("RefreshSession() with a used token error = %v, want ErrRefreshTokenReused", err)
+	}
+	if len(repo.sessions) != 0 || len(repo.tokens) != 0 {
+		t.Errorf("sessions = %d, refresh tokens = %d; want the session family deleted", len(repo.sessions), len(repo.tokens))
+	}
+	if _, err = jwtService.ValidateToken(rotated.AccessToken); err == nil {
+		t.Error("access token of the revoked session is still valid")
+	}
+	if _, err = refresh(rotated.RefreshToken); !errors.Is(err, errPackage.ErrInvalidRefreshToken) {
+		t.Errorf("RefreshSession() with the latest token error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestConcurrentRefreshRotatesOnlyOnce(t *testing.T) {
+	repo, _, pair, refresh := newRotationFixture(t)
+
+	// Las dos peticiones leen el token antes de que alguna lo rote
+	const requests = 2
+	repo.readGate = &sync.WaitGroup{}
+	repo.readGate.Add(requests)
+
+	errs := make([]error, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = refresh(pair.RefreshToken)
+		}(i)
+	}
+	wg.Wait()
+
+	var succeeded, reused int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, errPackage.ErrRefreshTokenReused):
+			reused++
+		default:
+			t.Errorf("RefreshSession() unexpected error = %v", err)
+		}
+	}
+	if succeeded != 1 || reused != 1 {
+		t.Errorf("succeeded = %d, reused = %d; want exactly one rotation", succeeded, reused)
+	}
+}
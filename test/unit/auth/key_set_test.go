@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/token"
+)
+
+func rsaPEM(t *testing.T) ([]byte, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() error = %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), key
+}
+
+func ecPEM(t *testing.T) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey() error = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func tokenHeader(t *testing.T, signed string) map[string]interface{} {
+	t.Helper()
+	parsed, _, err := new(jwt.Parser).ParseUnverified(signed, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() error = %v", err)
+	}
+	return parsed.Header
+}
+
+func TestAsymmetricSigningPublishesKid(t *testing.T) {
+	for name, pemKey := range map[string][]byte{"RS256": nil, "ES256": ecPEM(t)} {
+		t.Run(name, func(t *testing.T) {
+			if pemKey == nil {
+				pemKey, _ = rsaPEM(t)
+			}
+
+			keys, err := token.NewKeySet([][]byte{pemKey}, "")
+			if err != nil {
+				t.Fatalf("NewKeySet() error = %v", err)
+			}
+			service := token.NewJWTServiceWithKeys(keys, time.Minute, newMemoryCache())
+
+			signed, err := service.GenerateToken(&auth.AuthClaims{UserID: "user-1"})
+			if err != nil {
+				t.Fatalf("GenerateToken() error = %v", err)
+			}
+
+			header := tokenHeader(t, signed)
+			jwks := keys.JWKS()
+			if header["alg"] != name || len(jwks.Keys) != 1 || header["kid"] != jwks.Keys[0].Kid || jwks.Keys[0].Alg != name {
+				t.Fatalf("header = %v, jwks = %+v", header, jwks.Keys)
+			}
+
+			if _, err := service.ValidateToken(signed); err != nil {
+				t.Errorf("ValidateToken() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestKeyRotationKeepsPreviousTokensValid(t *testing.T) {
+	oldPEM, _ := rsaPEM(t)
+	newPEM := ecPEM(t)
+	cache := newMemoryCache()
+
+	// 1. Tokens firmados con la clave anterior
+	oldKeys, err := token.NewKeySet([][]byte{oldPEM}, "")
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+	oldToken, err := token.NewJWTServiceWithKeys(oldKeys, time.Minute, cache).GenerateToken(&auth.AuthClaims{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	// 2. La nueva clave pasa a ser la activa y la anterior se conserva para verificar
+	rotatedKeys, err := token.NewKeySet([][]byte{newPEM, oldPEM}, "")
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+	rotated := token.NewJWTServiceWithKeys(rotatedKeys, time.Minute, cache)
+
+	if _, err := rotated.ValidateToken(oldToken); err != nil {
+		t.Errorf("ValidateToken(old token) error = %v", err)
+	}
+	newToken, err := rotated.GenerateToken(&auth.AuthClaims{UserID: "user-2"})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	if alg := tokenHeader(t, newToken)["alg"]; alg != "ES256" {
+		t.Errorf("new token alg = %v, want ES256", alg)
+	}
+	if jwks := rotatedKeys.JWKS(); len(jwks.Keys) != 2 || jwks.Keys[0].Alg != "ES256" {
+		t.Errorf("JWKS() = %+v, want the active key first and the previous key", jwks.Keys)
+	}
+
+	// 3. Al retirar la clave anterior sus tokens dejan de ser válidos
+	retiredKeys, _ := token.NewKeySet([][]byte{newPEM}, "")
+	if _, err := token.NewJWTServiceWithKeys(retiredKeys, time.Minute, cache).ValidateToken(oldToken); !errors.Is(err, domainErr.ErrInvalidToken) {
+		t.Errorf("ValidateToken(retired key) error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestLegacyHS256TokensDuringMigration(t *testing.T) {
+	cache := newMemoryCache()
+	legacyToken, err := token.NewJWTService("secret", time.Minute, cache).GenerateToken(&auth.AuthClaims{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	rsaKey, _ := rsaPEM(t)
+	withSecret, _ := token.NewKeySet([][]byte{rsaKey}, "secret")
+	if _, err := token.NewJWTServiceWithKeys(withSecret, time.Minute, cache).ValidateToken(legacyToken); err != nil {
+		t.Errorf("ValidateToken(HS256 token with JWT_SECRET) error = %v", err)
+	}
+	if jwks := withSecret.JWKS(); len(jwks.Keys) != 1 {
+		t.Errorf("JWKS() published %d keys, the HMAC secret must never be published", len(jwks.Keys))
+	}
+
+	withoutSecret, _ := token.NewKeySet([][]byte{rsaKey}, "")
+	if _, err := token.NewJWTServiceWithKeys(withoutSecret, time.Minute, cache).ValidateToken(legacyToken); err == nil {
+		t.Error("ValidateToken() accepted an HS256 token without JWT_SECRET")
+	}
+}
+
+func TestAlgorithmConfusionIsRejected(t *testing.T) {
+	rsaKeyPEM, _ := rsaPEM(t)
+	keys, _ := token.NewKeySet([][]byte{rsaKeyPEM}, "")
+	cache := newMemoryCache()
+	service := token.NewJWTServiceWithKeys(keys, time.Minute, cache)
+
+	// Un token HS256 firmado con la clave pública RSA y el kid de la clave RSA
+	jwk := keys.JWKS().Keys[0]
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "attacker"})
+	forged.Header["kid"] = jwk.Kid
+	signed, err := forged.SignedString([]byte(jwk.N))
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+	_ = cache.Set("token:"+signed, []byte(`{"user_id":"attacker","expires_at":"`+time.Now().Add(time.Hour).Format(time.RFC3339)+`"}`), time.Minute)
+
+	if _, err := service.ValidateToken(signed); !errors.Is(err, domainErr.ErrInvalidToken) {
+		t.Errorf("ValidateToken(forged HS256) error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestNewKeySetRejectsInvalidKeys(t *testing.T) {
+	weak, _ := rsa.GenerateKey(rand.Reader, 1024)
+	weakPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(weak)})
+
+	_, strong := rsaPEM(t)
+	publicDER, _ := x509.MarshalPKIXPublicKey(&strong.PublicKey)
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	tests := map[string][][]byte{
+		"not PEM":               {[]byte("not a key")},
+		"weak RSA key":          {weakPEM},
+		"public key as active":  {publicPEM},
+		"invalid secondary key": {ecPEM(t), []byte(strings.Repeat("x", 10))},
+	}
+
+	for name, pems := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := token.NewKeySet(pems, ""); !errors.Is(err, domainErr.ErrInvalidSigningKey) {
+				t.Errorf("NewKeySet() error = %v, want ErrInvalidSigningKey", err)
+			}
+		})
+	}
+
+	// Una clave pública sí se acepta como clave secundaria de verificación
+	if _, err := token.NewKeySet([][]byte{ecPEM(t), publicPEM}, ""); err != nil {
+		t.Errorf("NewKeySet(private, public) error = %v", err)
+	}
+}
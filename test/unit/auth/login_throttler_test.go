@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	authAdapter "github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/auth"
+)
+
+// memoryEventRecorder guarda los eventos registrados
+type memoryEventRecorder struct {
+	events []*entities.SystemEventInput
+}
+
+func (r *memoryEventRecorder) Record(_ context.Context, input *entities.SystemEventInput) string {
+	r.events = append(r.events, input)
+	return "event-" + strconv.Itoa(len(r.events))
+}
+
+// ofType retorna los eventos registrados de un tipo
+func (r *memoryEventRecorder) ofType(eventType string) []*entities.SystemEventInput {
+	var events []*entities.SystemEventInput
+	for _, event := range r.events {
+		if event.EventType == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func newThrottler(maxEmail, maxIP int) (ports.LoginThrottler, *memoryEventRecorder) {
+	events := &memoryEventRecorder{}
+	users := &fakeUserRepository{users: map[string]*entities.User{
+		"user-1": {ID: "user-1", Email: "user@example.com", IsActive: true},
+	}}
+
+	return authAdapter.NewLoginThrottler(newMemoryCache(), users, events, maxEmail, maxIP, 15*time.Minute), events
+}
+
+func TestLoginThrottlerProgressiveDelayAndLockout(t *testing.T) {
+	throttler, events := newThrottler(6, 100)
+	ctx := context.Background()
+
+	// Los primeros intentos (la mitad del máximo) no tienen demora
+	for i := 1; i <= 3; i++ {
+		wait, err := throttler.RegisterFailure(ctx, "user@example.com", "10.0.0.1")
+		if err != nil || wait != 0 {
+			t.Fatalf("failure %d: wait = %v, err = %v; want no delay", i, wait, err)
+		}
+	}
+
+	// Luego la demora se duplica con cada fallo
+	for i, want := range []time.Duration{time.Second, 2 * time.Second} {
+		wait, _ := throttler.RegisterFailure(ctx, "user@example.com", "10.0.0.1")
+		if wait != want {
+			t.Errorf("failure %d: wait = %v, want %v", i+4, wait, want)
+		}
+	}
+	if retryAfter, _ := throttler.Check(ctx, "USER@example.com ", "10.0.0.2"); retryAfter <= 0 {
+		t.Error("Check() did not report the delay for the same email with different case")
+	}
+
+	// Al llegar al máximo se bloquea y se registra el evento
+	wait, _ := throttler.RegisterFailure(ctx, "user@example.com", "10.0.0.1")
+	if wait != 15*time.Minute {
+		t.Fatalf("lockout wait = %v, want 15m", wait)
+	}
+	lockouts := events.ofType(constants.SystemEventLoginLockout)
+	if len(lockouts) != 1 {
+		t.Fatalf("recorded %d lockout events, want 1", len(lockouts))
+	}
+	emailSum := sha256.Sum256([]byte("user@example.com"))
+	if event := lockouts[0]; event.SourceID != "user-1" || event.Severity != constants.SystemEventSeverityWarning || event.Data["subject"] != hex.EncodeToString(emailSum[:]) {
+		t.Errorf("unexpected lockout event %+v", event)
+	}
+
+	// Los intentos fallidos no generan eventos y el correo nunca se guarda en claro
+	if failures := events.ofType(constants.SystemEventLoginFailed); len(failures) != 0 {
+		t.Errorf("recorded %d failed login events, want only the lockout", len(failures))
+	}
+	for _, event := range events.events {
+		for key, value := range event.Data {
+			if strings.Contains(fmt.Sprint(value), "user@example.com") {
+				t.Errorf("event %s field %q contains the plaintext email", event.EventType, key)
+			}
+		}
+	}
+	if retryAfter, _ := throttler.Check(ctx, "user@example.com", "10.0.0.3"); retryAfter < 14*time.Minute {
+		t.Errorf("Check() after lockout = %v, want about 15m", retryAfter)
+	}
+}
+
+func TestLoginThrottlerLocksIPAcrossEmails(t *testing.T) {
+	throttler, events := newThrottler(100, 4)
+	ctx := context.Background()
+
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"} {
+		_, _ = throttler.RegisterFailure(ctx, email, "10.0.0.9")
+	}
+
+	if retryAfter, _ := throttler.Check(ctx, "new@example.com", "10.0.0.9"); retryAfter < 14*time.Minute {
+		t.Errorf("Check() for locked IP = %v, want about 15m", retryAfter)
+	}
+	if retryAfter, _ := throttler.Check(ctx, "new@example.com", "10.0.0.10"); retryAfter != 0 {
+		t.Errorf("Check() for another IP = %v, want 0", retryAfter)
+	}
+	lockouts := events.ofType(constants.SystemEventLoginLockout)
+	if len(lockouts) != 1 || lockouts[0].Data["subject"] != "10.0.0.9" || lockouts[0].SourceID != "" {
+		t.Errorf("unexpected lockout events %+v", lockouts)
+	}
+}
+
+func TestLoginThrottlerSuccessResetsEmail(t *testing.T) {
+	throttler, _ := newThrottler(6, 100)
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		_, _ = throttler.RegisterFailure(ctx, "user@example.com", "10.0.0.1")
+	}
+	if err := throttler.RegisterSuccess(ctx, "user@example.com", "10.0.0.1"); err != nil {
+		t.Fatalf("RegisterSuccess() error = %v", err)
+	}
+
+	if retryAfter, _ := throttler.Check(ctx, "user@example.com", "10.0.0.5"); retryAfter != 0 {
+		t.Errorf("Check() after success = %v, want 0", retryAfter)
+	}
+	if wait, _ := throttler.RegisterFailure(ctx, "user@example.com", "10.0.0.5"); wait != 0 {
+		t.Errorf("first failure after success wait = %v, want 0", wait)
+	}
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	authUseCase "github.com/MarlonG1/delivery-backend/internal/application/usecases/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	authModel "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// fakeLoginAuthenticator acepta una única contraseña y emite tokens fijos
+type fakeLoginAuthenticator struct {
+	ports.Authenticator
+	user     *entities.User
+	sessions int
+}
+
+func (a *fakeLoginAuthenticator) ValidateCredentials(_ context.Context, _, password string) (*entities.User, error) {
+	if password != "P@ssw0rd" {
+		return nil, errPackage.ErrInvalidCredentials
+	}
+	return a.user, nil
+}
+
+func (a *fakeLoginAuthenticator) CreateSession(_ context.Context, _ *entities.User, _ map[string]interface{}, _ string) (*authModel.TokenPair, error) {
+	a.sessions++
+	return &authModel.TokenPair{AccessToken: "access", RefreshToken: "refresh"}, nil
+}
+
+func retryAfterOf(err error) time.Duration {
+	var retryErr *errPackage.RetryAfterError
+	if errors.As(err, &retryErr) {
+		return retryErr.RetryAfter
+	}
+	return 0
+}
+
+func TestMFAAttemptsAreLimitedAcrossChallenges(t *testing.T) {
+	throttler, events := newThrottler(2, 100)
+	mfaService, repo := newMFAFixture(t)
+	ctx := context.Background()
+
+	enrollment, _ := mfaService.BeginEnrollment(ctx, "user-1")
+	if _, err := mfaService.ConfirmEnrollment(ctx, "user-1", codeAt(t, enrollment.Secret, time.Now())); err != nil {
+		t.Fatalf("ConfirmEnrollment() error = %v", err)
+	}
+
+	authenticator := &fakeLoginAuthenticator{user: repo.user}
+	useCase := authUseCase.NewAuthUseCase(authenticator, mfaService, throttler)
+	credentials := &authModel.Credentials{Email: repo.user.Email, Password: "P@ssw0rd", IPAddress: "10.0.0.1"}
+
+	// 1. Cada contraseña correcta emite un reto nuevo, pero los códigos incorrectos se acumulan por usuario
+	var challenge *authModel.MFAChallenge
+	for i := 0; i < 2; i++ {
+		result, err := useCase.Authenticate(ctx, credentials)
+		if err != nil || result.Challenge == nil {
+			t.Fatalf("login %d: result = %+v, err = %v; want a challenge", i, result, err)
+		}
+		challenge = result.Challenge
+
+		if _, err = useCase.VerifyMFAChallenge(ctx, challenge.Token, "000000", "10.0.0.1"); !errors.Is(err, errPackage.ErrInvalidMFACode) {
+			t.Fatalf("login %d: VerifyMFAChallenge() error = %v, want ErrInvalidMFACode", i, err)
+		}
+	}
+
+	// 2. Con el usuario bloqueado no se emiten retos nuevos
+	if _, err := useCase.Authenticate(ctx, credentials); retryAfterOf(err) < 14*time.Minute {
+		t.Fatalf("Authenticate() after lockout error = %v, want a 15m retry after", err)
+	}
+
+	// 3. Ni siquiera un código válido en un reto pendiente o desde otra IP completa el login
+	next := codeAt(t, enrollment.Secret, time.Now().Add(30*time.Second))
+	if _, err := useCase.VerifyMFAChallenge(ctx, challenge.Token, next, "10.0.0.2"); retryAfterOf(err) < 14*time.Minute {
+		t.Fatalf("VerifyMFAChallenge() after lockout error = %v, want a 15m retry after", err)
+	}
+	if authenticator.sessions != 0 {
+		t.Errorf("created %d sessions while the second factor was locked", authenticator.sessions)
+	}
+
+	lockouts := events.ofType(constants.SystemEventLoginLockout)
+	if len(lockouts) != 1 || lockouts[0].SourceID != "user-1" || lockouts[0].Data["subject_type"] != "mfa_user" {
+		t.Errorf("unexpected lockout events %+v", lockouts)
+	}
+}
+
+func TestMFAPasswordSuccessDoesNotResetLoginAttempts(t *testing.T) {
+	throttler, _ := newThrottler(4, 100)
+	mfaService, repo := newMFAFixture(t)
+	ctx := context.Background()
+
+	enrollment, _ := mfaService.BeginEnrollment(ctx, "user-1")
+	if _, err := mfaService.ConfirmEnrollment(ctx, "user-1", codeAt(t, enrollment.Secret, time.Now())); err != nil {
+		t.Fatalf("ConfirmEnrollment() error = %v", err)
+	}
+
+	useCase := authUseCase.NewAuthUseCase(&fakeLoginAuthenticator{user: repo.user}, mfaService, throttler)
+	wrong := &authModel.Credentials{Email: repo.user.Email, Password: "wrong", IPAddress: "10.0.0.1"}
+	right := &authModel.Credentials{Email: repo.user.Email, Password: "P@ssw0rd", IPAddress: "10.0.0.1"}
+
+	// Los fallos de contraseña siguen contando aunque entre medio la contraseña sea correcta
+	for i := 0; i < 2; i++ {
+		_, _ = useCase.Authenticate(ctx, wrong)
+		if _, err := useCase.Authenticate(ctx, right); err != nil {
+			t.Fatalf("Authenticate() error = %v", err)
+		}
+	}
+	_, _ = useCase.Authenticate(ctx, wrong)
+	if retryAfter, _ := throttler.Check(ctx, repo.user.Email, "10.0.0.1"); retryAfter == 0 {
+		t.Error("password attempts were reset before the second factor was verified")
+	}
+}
+
+func TestConfirmEnrollmentIsRateLimited(t *testing.T) {
+	throttler, _ := newThrottler(2, 100)
+	mfaService, _ := newMFAFixture(t)
+	useCase := authUseCase.NewMFAUseCase(mfaService, throttler)
+	ctx := context.WithValue(context.Background(), "claims", &authModel.AuthClaims{UserID: "user-1"})
+
+	enrollment, err := useCase.BeginEnrollment(ctx)
+	if err != nil {
+		t.Fatalf("BeginEnrollment() error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = useCase.ConfirmEnrollment(ctx, "000000"); !errors.Is(err, errPackage.ErrInvalidMFACode) {
+			t.Fatalf("attempt %d error = %v, want ErrInvalidMFACode", i, err)
+		}
+	}
+
+	if _, err = useCase.ConfirmEnrollment(ctx, codeAt(t, enrollment.Secret, time.Now())); retryAfterOf(err) < 14*time.Minute {
+		t.Errorf("ConfirmEnrollment() after lockout error = %v, want a 15m retry after", err)
+	}
+}
@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/mfa"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// fakeMFARepository guarda la configuración MFA en memoria
+type fakeMFARepository struct {
+	domainPorts.UserRepository
+	user  *entities.User
+	roles []entities.Role
+	mfa   *entities.UserMFA
+	codes []entities.MFARecoveryCode
+}
+
+func (r *fakeMFARepository) GetByID(_ context.Context, id string) (*entities.User, error) {
+	if r.user.ID != id {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.user, nil
+}
+
+func (r *fakeMFARepository) GetUserRoles(_ context.Context, _ string) ([]entities.Role, error) {
+	return r.roles, nil
+}
+
+func (r *fakeMFARepository) GetMFA(_ context.Context, _ string) (*entities.UserMFA, error) {
+	if r.mfa == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *r.mfa
+	return &copied, nil
+}
+
+func (r *fakeMFARepository) SaveMFA(_ context.Context, mfa *entities.UserMFA) error {
+	r.mfa = mfa
+	return nil
+}
+
+func (r *fakeMFARepository) EnableMFA(_ context.Context, _ string, enabledAt time.Time, codes []entities.MFARecoveryCode) error {
+	r.mfa.EnabledAt = &enabledAt
+	r.codes = codes
+	return nil
+}
+
+func (r *fakeMFARepository) AdvanceMFAStep(_ context.Context, _ string, step int64) error {
+	if r.mfa.LastUsedStep >= step {
+		return gorm.ErrRecordNotFound
+	}
+	r.mfa.LastUsedStep = step
+	return nil
+}
+
+func (r *fakeMFARepository) DeleteMFA(_ context.Context, _ string) error {
+	r.mfa, r.codes = nil, nil
+	return nil
+}
+
+func (r *fakeMFARepository) ReplaceRecoveryCodes(_ context.Context, _ string, codes []entities.MFARecoveryCode) error {
+	r.codes = codes
+	return nil
+}
+
+func (r *fakeMFARepository) UseRecoveryCode(_ context.Context, _ string, codeHash string) error {
+	for i := range r.codes {
+		if r.codes[i].CodeHash == codeHash && r.codes[i].UsedAt == nil {
+			now := time.Now()
+			r.codes[i].UsedAt = &now
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *fakeMFARepository) CountUnusedRecoveryCodes(_ context.Context, _ string) (int64, error) {
+	var count int64
+	for _, code := range r.codes {
+		if code.UsedAt == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func newMFAFixture(t *testing.T, requiredRoles ...string) (ports.MFAManager, *fakeMFARepository) {
+	t.Helper()
+	repo := &fakeMFARepository{
+		user:  &entities.User{ID: "user-1", Email: "admin@example.com", IsActive: true},
+		roles: []entities.Role{{Name: "ADMIN"}},
+	}
+	service, err := mfa.NewMFAService(repo, newMemoryCache(), "encryption-key", "Delivery", requiredRoles)
+	if err != nil {
+		t.Fatalf("NewMFAService() error = %v", err)
+	}
+	return service, repo
+}
+
+func codeAt(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := mfa.GenerateTOTPCode(secret, at)
+	if err != nil {
+		t.Fatalf("GenerateTOTPCode() error = %v", err)
+	}
+	return code
+}
+
+// Vectores de RFC 6238 (SHA1), truncados a 6 dígitos
+func TestTOTPRFCVectors(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // "12345678901234567890"
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, want := range vectors {
+		got, err := mfa.GenerateTOTPCode(secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatalf("GenerateTOTPCode(%d) error = %v", unix, err)
+		}
+		if got != want {
+			t.Errorf("GenerateTOTPCode(%d) = %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestTOTPValidationWindow(t *testing.T) {
+	secret, err := mfa.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("GenerateTOTPSecret() error = %v", err)
+	}
+	now := time.Now()
+
+	if _, ok := mfa.ValidateTOTPCode(secret, codeAt(t, secret, now.Add(-mfa.TOTPPeriod*time.Second)), now); !ok {
+		t.Error("previous step code was rejected")
+	}
+	if _, ok := mfa.ValidateTOTPCode(secret, codeAt(t, secret, now.Add(-3*mfa.TOTPPeriod*time.Second)), now); ok {
+		t.Error("code three steps old was accepted")
+	}
+}
+
+func TestMFAEnrollmentAndChallenge(t *testing.T) {
+	service, repo := newMFAFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	// 1. Inscripción y confirmación
+	enrollment, err := service.BeginEnrollment(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("BeginEnrollment() error = %v", err)
+	}
+	if repo.mfa.Secret == enrollment.Secret {
+		t.Fatal("secret is stored in plain text")
+	}
+	codes, err := service.ConfirmEnrollment(ctx, "user-1", codeAt(t, enrollment.Secret, now))
+	if err != nil {
+		t.Fatalf("ConfirmEnrollment() error = %v", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("got %d recovery codes, want 10", len(codes))
+	}
+
+	// 2. El mismo código no puede reutilizarse en el login
+	challenge, err := service.CreateChallenge(ctx, repo.user, nil, "127.0.0.1", false)
+	if err != nil {
+		t.Fatalf("CreateChallenge() error = %v", err)
+	}
+	if _, err = service.VerifyChallenge(ctx, challenge.Token, codeAt(t, enrollment.Secret, now)); !errors.Is(err, errPackage.ErrInvalidMFACode) {
+		t.Fatalf("VerifyChallenge() with replayed code error = %v, want ErrInvalidMFACode", err)
+	}
+
+	// 3. Un código de recuperación completa el reto una sola vez
+	result, err := service.VerifyChallenge(ctx, challenge.Token, codes[0])
+	if err != nil {
+		t.Fatalf("VerifyChallenge() with recovery code error = %v", err)
+	}
+	if result.User.ID != "user-1" || result.IPAddress != "127.0.0.1" {
+		t.Errorf("unexpected challenge result %+v", result)
+	}
+	if _, err = service.VerifyChallenge(ctx, challenge.Token, codes[1]); !errors.Is(err, errPackage.ErrMFAChallengeInvalid) {
+		t.Errorf("reused challenge error = %v, want ErrMFAChallengeInvalid", err)
+	}
+
+	status, err := service.GetStatus(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	if !status.Enabled || status.RecoveryCodesRemaining != 9 {
+		t.Errorf("status = %+v, want enabled with 9 recovery codes", status)
+	}
+}
+
+func TestMFAChallengeInvalidatedAfterTooManyAttempts(t *testing.T) {
+	service, repo := newMFAFixture(t)
+	ctx := context.Background()
+
+	enrollment, _ := service.BeginEnrollment(ctx, "user-1")
+	if _, err := service.ConfirmEnrollment(ctx, "user-1", codeAt(t, enrollment.Secret, time.Now())); err != nil {
+		t.Fatalf("ConfirmEnrollment() error = %v", err)
+	}
+
+	challenge, _ := service.CreateChallenge(ctx, repo.user, nil, "127.0.0.1", false)
+	for i := 0; i < 5; i++ {
+		if _, err := service.VerifyChallenge(ctx, challenge.Token, "WRONG-CODE"); !errors.Is(err, errPackage.ErrInvalidMFACode) {
+			t.Fatalf("attempt %d error = %v, want ErrInvalidMFACode", i, err)
+		}
+	}
+
+	next := codeAt(t, enrollment.Secret, time.Now().Add(mfa.TOTPPeriod*time.Second))
+	if _, err := service.VerifyChallenge(ctx, challenge.Token, next); !errors.Is(err, errPackage.ErrMFAChallengeInvalid) {
+		t.Errorf("VerifyChallenge() after max attempts error = %v, want ErrMFAChallengeInvalid", err)
+	}
+}
+
+func TestMFAEnrollmentDuringLoginForRequiredRole(t *testing.T) {
+	service, repo := newMFAFixture(t, "ADMIN", "COMPANY_USER")
+	ctx := context.Background()
+
+	status, err := service.GetStatus(ctx, "user-1")
+	if err != nil || !status.Required || status.Enabled {
+		t.Fatalf("GetStatus() = %+v, %v; want required and not enabled", status, err)
+	}
+
+	challenge, _ := service.CreateChallenge(ctx, repo.user, nil, "127.0.0.1", true)
+	enrollment, err := service.BeginChallengeEnrollment(ctx, challenge.Token)
+	if err != nil {
+		t.Fatalf("BeginChallengeEnrollment() error = %v", err)
+	}
+
+	result, err := service.VerifyChallenge(ctx, challenge.Token, codeAt(t, enrollment.Secret, time.Now()))
+	if err != nil {
+		t.Fatalf("VerifyChallenge() error = %v", err)
+	}
+	if len(result.RecoveryCodes) != 10 {
+		t.Errorf("got %d recovery codes, want 10", len(result.RecoveryCodes))
+	}
+
+	// Un rol que exige MFA no puede desactivarlo
+	next := codeAt(t, enrollment.Secret, time.Now().Add(mfa.TOTPPeriod*time.Second))
+	if err = service.Disable(ctx, "user-1", next); !errors.Is(err, errPackage.ErrMFARequiredByRole) {
+		t.Errorf("Disable() error = %v, want ErrMFARequiredByRole", err)
+	}
+}
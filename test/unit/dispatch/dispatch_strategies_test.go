@@ -0,0 +1,189 @@
+package dispatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+func candidate(driverID string, distance float64, activeOrders int, primary bool, efficiency float64) entities.DispatchCandidate {
+	return entities.DispatchCandidate{
+		DriverID:         driverID,
+		ZoneID:           "zone-1",
+		DistanceKm:       distance,
+		ActiveOrders:     activeOrders,
+		IsPrimaryZone:    primary,
+		EfficiencyRating: efficiency,
+	}
+}
+
+func TestNearestDriverStrategy(t *testing.T) {
+	cases := []struct {
+		name       string
+		candidates []entities.DispatchCandidate
+		want       string
+	}{
+		{
+			name: "closest driver wins",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 3.2, 0, true, 5),
+				candidate("driver-b", 1.1, 2, false, 1),
+				candidate("driver-c", 2.4, 0, true, 5),
+			},
+			want: "driver-b",
+		},
+		{
+			name: "same distance prefers the primary zone",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 1.5, 0, false, 5),
+				candidate("driver-b", 1.5, 0, true, 1),
+			},
+			want: "driver-b",
+		},
+		{
+			name: "same distance and zone prefers the higher efficiency",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 1.5, 0, true, 3.5),
+				candidate("driver-b", 1.5, 0, true, 4.8),
+			},
+			want: "driver-b",
+		},
+		{
+			name: "full tie keeps the original order",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-b", 1.5, 0, true, 4),
+				candidate("driver-a", 1.5, 0, true, 4),
+			},
+			want: "driver-b",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected := services.NewNearestDriverStrategy().SelectDriver(&entities.Order{}, tc.candidates)
+			if selected.DriverID != tc.want {
+				t.Errorf("SelectDriver() = %s, want %s", selected.DriverID, tc.want)
+			}
+			if selected.Score != selected.DistanceKm {
+				t.Errorf("Score = %f, want the distance %f", selected.Score, selected.DistanceKm)
+			}
+		})
+	}
+}
+
+func TestLoadBalancedStrategy(t *testing.T) {
+	cases := []struct {
+		name       string
+		candidates []entities.DispatchCandidate
+		want       string
+	}{
+		{
+			name: "fewest active orders wins over distance",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 0.5, 2, true, 5),
+				candidate("driver-b", 4.0, 0, false, 1),
+			},
+			want: "driver-b",
+		},
+		{
+			name: "same load prefers the closest",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 2.0, 1, true, 5),
+				candidate("driver-b", 0.8, 1, false, 1),
+			},
+			want: "driver-b",
+		},
+		{
+			name: "same load and distance prefers the primary zone",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 1.0, 1, false, 5),
+				candidate("driver-b", 1.0, 1, true, 1),
+			},
+			want: "driver-b",
+		},
+		{
+			name: "same load, distance and zone prefers the higher efficiency",
+			candidates: []entities.DispatchCandidate{
+				candidate("driver-a", 1.0, 1, true, 2),
+				candidate("driver-b", 1.0, 1, true, 3),
+			},
+			want: "driver-b",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected := services.NewLoadBalancedStrategy().SelectDriver(&entities.Order{}, tc.candidates)
+			if selected.DriverID != tc.want {
+				t.Errorf("SelectDriver() = %s, want %s", selected.DriverID, tc.want)
+			}
+			if selected.Score != float64(selected.ActiveOrders) {
+				t.Errorf("Score = %f, want the active orders %d", selected.Score, selected.ActiveOrders)
+			}
+		})
+	}
+}
+
+func TestRoundRobinStrategy_RotatesByDriverIDPerZone(t *testing.T) {
+	strategy := services.NewRoundRobinStrategy()
+	drivers := func(zoneID string) []entities.DispatchCandidate {
+		// Fuera de orden y con distancias distintas, el turno solo depende del ID
+		candidates := []entities.DispatchCandidate{
+			candidate("driver-c", 0.1, 0, true, 5),
+			candidate("driver-a", 9.0, 0, true, 5),
+			candidate("driver-b", 5.0, 0, true, 5),
+		}
+		for i := range candidates {
+			candidates[i].ZoneID = zoneID
+		}
+		return candidates
+	}
+
+	cases := []struct {
+		zoneID string
+		want   string
+	}{
+		{"zone-1", "driver-a"},
+		{"zone-1", "driver-b"},
+		{"zone-2", "driver-a"},
+		{"zone-1", "driver-c"},
+		{"zone-1", "driver-a"},
+		{"zone-2", "driver-b"},
+	}
+
+	for i, tc := range cases {
+		selected := strategy.SelectDriver(&entities.Order{}, drivers(tc.zoneID))
+		if selected.DriverID != tc.want {
+			t.Errorf("turn %d in %s = %s, want %s", i, tc.zoneID, selected.DriverID, tc.want)
+		}
+	}
+
+	// Si el siguiente en turno ya no está disponible se pasa al próximo ID
+	remaining := []entities.DispatchCandidate{candidate("driver-a", 1, 0, true, 5), candidate("driver-c", 1, 0, true, 5)}
+	if selected := strategy.SelectDriver(&entities.Order{}, remaining); selected.DriverID != "driver-c" {
+		t.Errorf("turn without driver-b = %s, want driver-c", selected.DriverID)
+	}
+}
+
+func TestNewDispatchStrategy(t *testing.T) {
+	cases := map[string]string{
+		"":              constants.DispatchStrategyNearest,
+		"nearest":       constants.DispatchStrategyNearest,
+		"ROUND_ROBIN":   constants.DispatchStrategyRoundRobin,
+		"load_balanced": constants.DispatchStrategyLoadBalanced,
+	}
+	for name, want := range cases {
+		strategy, err := services.NewDispatchStrategy(name)
+		if err != nil || strategy.Name() != want {
+			t.Errorf("NewDispatchStrategy(%q) = %v, %v, want %s", name, strategy, err, want)
+		}
+	}
+
+	if _, err := services.NewDispatchStrategy("random"); !errors.Is(err, errPackage.ErrInvalidDispatchStrategy) {
+		t.Errorf("NewDispatchStrategy(random) error = %v, want ErrInvalidDispatchStrategy", err)
+	}
+}
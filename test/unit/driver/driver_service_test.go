@@ -0,0 +1,295 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// memoryDriverRepository guarda los repartidores y sus zonas en memoria
+type memoryDriverRepository struct {
+	ports.DriverRepository
+	drivers map[string]*entities.Driver
+	zones   map[string][]entities.DriverZone
+}
+
+func newMemoryDriverRepository() *memoryDriverRepository {
+	return &memoryDriverRepository{drivers: make(map[string]*entities.Driver), zones: make(map[string][]entities.DriverZone)}
+}
+
+func (r *memoryDriverRepository) CreateDriver(_ context.Context, driver *entities.Driver) error {
+	stored := *driver
+	r.drivers[driver.UserID] = &stored
+	return nil
+}
+
+func (r *memoryDriverRepository) GetDriverByID(_ context.Context, driverID string) (*entities.Driver, error) {
+	driver, ok := r.drivers[driverID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := *driver
+	return &stored, nil
+}
+
+func (r *memoryDriverRepository) UpdateDriver(_ context.Context, driver *entities.Driver) error {
+	stored := r.drivers[driver.UserID]
+	if driver.LicenseNumber != "" {
+		stored.LicenseNumber = driver.LicenseNumber
+	}
+	if driver.VehiclePlate != "" {
+		stored.VehiclePlate = driver.VehiclePlate
+	}
+	return nil
+}
+
+func (r *memoryDriverRepository) ExistsDriver(_ context.Context, driverID string) (bool, error) {
+	_, ok := r.drivers[driverID]
+	return ok, nil
+}
+
+func (r *memoryDriverRepository) ExistsLicenseNumber(_ context.Context, licenseNumber string, excludeID string) (bool, error) {
+	for _, driver := range r.drivers {
+		if driver.LicenseNumber == licenseNumber && driver.UserID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryDriverRepository) ExistsVehiclePlate(_ context.Context, vehiclePlate string, excludeID string) (bool, error) {
+	for _, driver := range r.drivers {
+		if driver.VehiclePlate == vehiclePlate && driver.UserID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryDriverRepository) ReplaceDriverZones(_ context.Context, driverID string, zones []entities.DriverZone) error {
+	r.zones[driverID] = zones
+	return nil
+}
+
+// stubUserRepository retorna usuarios con sus roles
+type stubUserRepository struct {
+	ports.UserRepository
+	users map[string]*entities.User
+	roles map[string]string
+}
+
+func (r *stubUserRepository) GetByID(_ context.Context, id string) (*entities.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+func (r *stubUserRepository) GetUserRoles(_ context.Context, userID string) ([]entities.Role, error) {
+	return []entities.Role{{Name: r.roles[userID]}}, nil
+}
+
+// stubZoneRepository retorna las zonas registradas
+type stubZoneRepository struct {
+	ports.CompanyRepository
+	zones map[string]*entities.Zone
+}
+
+func (r *stubZoneRepository) GetZoneByID(_ context.Context, zoneID string) (*entities.Zone, error) {
+	zone, ok := r.zones[zoneID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return zone, nil
+}
+
+func newDriverService(repo *memoryDriverRepository) interfaces.Driverer {
+	users := &stubUserRepository{
+		users: map[string]*entities.User{
+			"driver-1":   {ID: "driver-1", IsActive: true},
+			"driver-2":   {ID: "driver-2", IsActive: true},
+			"inactive-1": {ID: "inactive-1", IsActive: false},
+			"client-1":   {ID: "client-1", IsActive: true},
+		},
+		roles: map[string]string{
+			"driver-1":   constants.Driver,
+			"driver-2":   constants.Driver,
+			"inactive-1": constants.Driver,
+			"client-1":   "CLIENT",
+		},
+	}
+	zones := &stubZoneRepository{zones: map[string]*entities.Zone{
+		"zone-1": {ID: "zone-1", IsActive: true},
+		"zone-2": {ID: "zone-2", IsActive: true},
+		"zone-3": {ID: "zone-3", IsActive: false},
+	}}
+
+	return services.NewDriverService(repo, users, zones)
+}
+
+func newDriver(userID, license, plate string) *entities.Driver {
+	return &entities.Driver{
+		UserID:        userID,
+		LicenseNumber: license,
+		LicenseExpiry: time.Now().AddDate(1, 0, 0),
+		VehicleType:   constants.VehicleTypeMotorcycle,
+		VehiclePlate:  plate,
+		VehicleModel:  "Honda CB190",
+		VehicleColor:  "Rojo",
+	}
+}
+
+func TestCreateDriver_ValidatesUserAndData(t *testing.T) {
+	expired := newDriver("driver-1", "LIC-1", "P-1")
+	expired.LicenseExpiry = time.Now().AddDate(0, 0, -1)
+	invalidVehicle := newDriver("driver-1", "LIC-1", "P-1")
+	invalidVehicle.VehicleType = "ROCKET"
+	missingData := newDriver("driver-1", "LIC-1", "P-1")
+	missingData.VehicleModel = ""
+
+	cases := []struct {
+		name   string
+		driver *entities.Driver
+		want   error
+	}{
+		{"unknown user", newDriver("missing", "LIC-1", "P-1"), errPackage.ErrUserNotFoundOrUnauthorized},
+		{"deactivated user", newDriver("inactive-1", "LIC-1", "P-1"), errPackage.ErrUserDeactivated},
+		{"user without the DRIVER role", newDriver("client-1", "LIC-1", "P-1"), errPackage.ErrUserIsNotDriver},
+		{"expired license", expired, errPackage.ErrLicenseExpired},
+		{"invalid vehicle type", invalidVehicle, errPackage.ErrInvalidVehicleType},
+		{"missing vehicle data", missingData, nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newMemoryDriverRepository()
+			err := newDriverService(repo).CreateDriver(context.Background(), tc.driver)
+			if err == nil {
+				t.Fatalf("CreateDriver() succeeded, want an error")
+			}
+			if tc.want != nil && !errors.Is(err, tc.want) {
+				t.Errorf("CreateDriver() error = %v, want %v", err, tc.want)
+			}
+			if len(repo.drivers) != 0 {
+				t.Errorf("driver was stored after a validation error")
+			}
+		})
+	}
+}
+
+func TestCreateDriver_RejectsDuplicates(t *testing.T) {
+	repo := newMemoryDriverRepository()
+	service := newDriverService(repo)
+	ctx := context.Background()
+
+	if err := service.CreateDriver(ctx, newDriver("driver-1", "LIC-1", "P-1")); err != nil {
+		t.Fatalf("CreateDriver() error = %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		driver *entities.Driver
+		want   error
+	}{
+		{"same user", newDriver("driver-1", "LIC-2", "P-2"), errPackage.ErrDriverAlreadyExists},
+		{"same license number", newDriver("driver-2", "LIC-1", "P-2"), errPackage.ErrDuplicateLicenseNumber},
+		{"same vehicle plate", newDriver("driver-2", "LIC-2", "P-1"), errPackage.ErrDuplicateVehiclePlate},
+	}
+	for _, tc := range cases {
+		if err := service.CreateDriver(ctx, tc.driver); !errors.Is(err, tc.want) {
+			t.Errorf("%s: CreateDriver() error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestUpdateDriver_UniquenessIgnoresTheSameDriver(t *testing.T) {
+	repo := newMemoryDriverRepository()
+	service := newDriverService(repo)
+	ctx := context.Background()
+
+	for _, driver := range []*entities.Driver{newDriver("driver-1", "LIC-1", "P-1"), newDriver("driver-2", "LIC-2", "P-2")} {
+		if err := service.CreateDriver(ctx, driver); err != nil {
+			t.Fatalf("CreateDriver() error = %v", err)
+		}
+	}
+
+	// Conservar su propia licencia no es un duplicado
+	if err := service.UpdateDriver(ctx, &entities.Driver{UserID: "driver-1", LicenseNumber: "LIC-1", VehiclePlate: "P-9"}); err != nil {
+		t.Fatalf("UpdateDriver() error = %v", err)
+	}
+	if repo.drivers["driver-1"].VehiclePlate != "P-9" {
+		t.Errorf("vehicle plate = %s, want P-9", repo.drivers["driver-1"].VehiclePlate)
+	}
+
+	if err := service.UpdateDriver(ctx, &entities.Driver{UserID: "driver-1", VehiclePlate: "P-2"}); !errors.Is(err, errPackage.ErrDuplicateVehiclePlate) {
+		t.Errorf("UpdateDriver() error = %v, want ErrDuplicateVehiclePlate", err)
+	}
+	if err := service.UpdateDriver(ctx, &entities.Driver{UserID: "missing", VehiclePlate: "P-3"}); !errors.Is(err, errPackage.ErrDriverNotFound) {
+		t.Errorf("UpdateDriver() error = %v, want ErrDriverNotFound", err)
+	}
+}
+
+func TestAssignZones_ReplacesAssignedZones(t *testing.T) {
+	repo := newMemoryDriverRepository()
+	service := newDriverService(repo)
+	ctx := context.Background()
+
+	if err := service.CreateDriver(ctx, newDriver("driver-1", "LIC-1", "P-1")); err != nil {
+		t.Fatalf("CreateDriver() error = %v", err)
+	}
+
+	if err := service.AssignZones(ctx, "driver-1", "zone-1", []string{"zone-2"}); err != nil {
+		t.Fatalf("AssignZones() error = %v", err)
+	}
+	if err := service.AssignZones(ctx, "driver-1", "zone-2", nil); err != nil {
+		t.Fatalf("AssignZones() error = %v", err)
+	}
+
+	zones := repo.zones["driver-1"]
+	if len(zones) != 1 || zones[0].ZoneID != "zone-2" || !zones[0].IsPrimary {
+		t.Errorf("driver zones = %+v, want only zone-2 as primary", zones)
+	}
+
+	cases := []struct {
+		name      string
+		primary   string
+		secondary []string
+		want      error
+	}{
+		{"missing primary zone", "", []string{"zone-1"}, errPackage.ErrPrimaryZoneRequired},
+		{"duplicated zone", "zone-1", []string{"zone-1"}, errPackage.ErrDuplicateDriverZone},
+		{"unknown zone", "zone-1", []string{"zone-9"}, errPackage.ErrZoneNotFound},
+		{"inactive zone", "zone-3", nil, errPackage.ErrZoneInactive},
+	}
+	for _, tc := range cases {
+		if err := service.AssignZones(ctx, "driver-1", tc.primary, tc.secondary); !errors.Is(err, tc.want) {
+			t.Errorf("%s: AssignZones() error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	// Una asignación rechazada conserva las zonas anteriores
+	if zones = repo.zones["driver-1"]; len(zones) != 1 || zones[0].ZoneID != "zone-2" {
+		t.Errorf("driver zones after rejected assignments = %+v", zones)
+	}
+}
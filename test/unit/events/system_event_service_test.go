@@ -0,0 +1,157 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// memorySystemEventRepository guarda los eventos y sus logs en memoria
+type memorySystemEventRepository struct {
+	mu        sync.Mutex
+	events    []*entities.SystemEvent
+	eventLogs []entities.EventLog
+	createErr error
+}
+
+func (r *memorySystemEventRepository) Create(_ context.Context, event *entities.SystemEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.createErr != nil {
+		return r.createErr
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memorySystemEventRepository) CreateLogs(_ context.Context, eventLogs []entities.EventLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.eventLogs = append(r.eventLogs, eventLogs...)
+	return nil
+}
+
+func (r *memorySystemEventRepository) GetSystemEvents(_ context.Context, _ *entities.SystemEventQueryParams) ([]entities.SystemEvent, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *memorySystemEventRepository) AggregateSystemEvents(_ context.Context, _ *entities.SystemEventQueryParams) ([]entities.SystemEventSummary, error) {
+	return nil, nil
+}
+
+func (r *memorySystemEventRepository) logCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.eventLogs)
+}
+
+// eventually espera a que la condición se cumpla, los logs se guardan de forma asíncrona
+func eventually(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRecord_StoresEventWithDefaults(t *testing.T) {
+	repo := &memorySystemEventRepository{}
+	recorder := services.NewSystemEventService(repo)
+
+	id := recorder.Record(context.Background(), &entities.SystemEventInput{
+		EventType: constants.SystemEventCacheRecovered,
+		Source:    constants.SystemEventSourceCache,
+		Severity:  "LOUD",
+		Data:      map[string]interface{}{"operation": "Get"},
+	})
+
+	if id == "" || len(repo.events) != 1 {
+		t.Fatalf("Record() = %q with %d events, want one stored event", id, len(repo.events))
+	}
+	event := repo.events[0]
+	if event.ID != id || event.SourceID != uuid.Nil.String() || event.Severity != constants.SystemEventSeverityInfo {
+		t.Errorf("unexpected defaults in event %+v", event)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(event.EventData), &data); err != nil || data["operation"] != "Get" {
+		t.Errorf("event data = %s, err = %v", event.EventData, err)
+	}
+}
+
+func TestRecord_WritesAttachedLogsAsynchronously(t *testing.T) {
+	repo := &memorySystemEventRepository{}
+	recorder := services.NewSystemEventService(repo)
+
+	// El contexto del emisor se cancela en cuanto Record retorna, los logs deben guardarse igual
+	ctx, cancel := context.WithCancel(context.Background())
+	id := recorder.Record(ctx, &entities.SystemEventInput{
+		EventType: constants.SystemEventDispatchFailed,
+		Source:    constants.SystemEventSourceDispatch,
+		SourceID:  "order-1",
+		Severity:  constants.SystemEventSeverityError,
+		Logs: []entities.EventLogInput{
+			{LogLevel: constants.SystemEventSeverityError, Description: "failed to assign driver"},
+			{Description: "retry scheduled", Metadata: map[string]interface{}{"attempt": 2}},
+		},
+	})
+	cancel()
+
+	eventually(t, func() bool { return repo.logCount() == 2 })
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for _, eventLog := range repo.eventLogs {
+		if eventLog.EventID != id || eventLog.ID == "" || eventLog.Metadata == "" {
+			t.Errorf("unexpected event log %+v", eventLog)
+		}
+	}
+	if repo.eventLogs[1].LogLevel != constants.SystemEventSeverityInfo || repo.eventLogs[1].Metadata != `{"attempt":2}` {
+		t.Errorf("unexpected defaults in event log %+v", repo.eventLogs[1])
+	}
+}
+
+func TestRecord_NeverFailsTheCaller(t *testing.T) {
+	repo := &memorySystemEventRepository{createErr: errors.New("database is down")}
+	recorder := services.NewSystemEventService(repo)
+
+	id := recorder.Record(context.Background(), &entities.SystemEventInput{
+		EventType: constants.SystemEventLoginFailed,
+		Source:    constants.SystemEventSourceAuth,
+		Logs:      []entities.EventLogInput{{Description: "should not be written"}},
+	})
+	if id != "" {
+		t.Fatalf("Record() = %q, want empty id when the event is not stored", id)
+	}
+
+	if id = recorder.Record(context.Background(), &entities.SystemEventInput{Source: constants.SystemEventSourceAuth}); id != "" {
+		t.Fatalf("Record() without event type = %q, want empty id", id)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if repo.logCount() != 0 {
+		t.Errorf("wrote %d logs for an event that was not stored", repo.logCount())
+	}
+}
@@ -0,0 +1,172 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/mail"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// receivedMail es el correo recibido por el servidor SMTP local
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer levanta un servidor SMTP mínimo que acepta un único correo
+func startSMTPServer(t *testing.T) (string, string, <-chan receivedMail) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan receivedMail, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		var mail receivedMail
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				mail.from = strings.Trim(strings.TrimSpace(line)[len("MAIL FROM:"):], "<>")
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				mail.to = append(mail.to, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				mail.data = data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				received <- mail
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, received
+}
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	os.Exit(m.Run())
+}
+
+func TestSMTPSenderDeliversMessage(t *testing.T) {
+	host, port, received := startSMTPServer(t)
+	sender := mail.NewSMTPSender(host, port, "", "", "no-reply@delivery.local")
+
+	err := sender.Send(context.Background(), &ports.MailMessage{
+		To:      "user@example.com",
+		Subject: "Recuperación de contraseña",
+		Body:    "Hola\nUsa este enlace",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case got := <-received:
+		if got.from != "no-reply@delivery.local" {
+			t.Errorf("from = %q", got.from)
+		}
+		if len(got.to) != 1 || got.to[0] != "user@example.com" {
+			t.Errorf("to = %v", got.to)
+		}
+		if !strings.Contains(got.data, "Subject: Recuperación de contraseña\r\n") {
+			t.Errorf("missing subject header in %q", got.data)
+		}
+		if !strings.Contains(got.data, "Hola\r\nUsa este enlace") {
+			t.Errorf("missing body in %q", got.data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("mail was not received")
+	}
+}
+
+func TestSMTPSenderReturnsErrorWhenServerIsDown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	listener.Close()
+
+	sender := mail.NewSMTPSender(host, port, "", "", "no-reply@delivery.local")
+	if err = sender.Send(context.Background(), &ports.MailMessage{To: "user@example.com"}); err == nil {
+		t.Error("Send() error = nil, want error")
+	}
+}
+
+func TestLogSenderDoesNotLogBody(t *testing.T) {
+	hook := logtest.NewLocal(logs.Logger)
+	defer hook.Reset()
+
+	err := mail.NewLogSender().Send(context.Background(), &ports.MailMessage{
+		To:      "user@example.com",
+		Subject: "Recuperación de contraseña",
+		Body:    "https://app.example.com/reset-password?token=secret-token",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	entry := hook.LastEntry()
+	if entry == nil {
+		t.Fatal("no log entry was written")
+	}
+	if entry.Data["to"] != "user@example.com" || entry.Data["subject"] != "Recuperación de contraseña" {
+		t.Errorf("log fields = %v", entry.Data)
+	}
+	for key, value := range entry.Data {
+		if strings.Contains(fmt.Sprint(value), "secret-token") {
+			t.Errorf("log field %q contains the mail body", key)
+		}
+	}
+}
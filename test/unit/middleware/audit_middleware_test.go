@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/audit"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+)
+
+// fakeAuditLogger guarda el estado de las entidades en memoria, los handlers de prueba lo modifican
+type fakeAuditLogger struct {
+	state   map[string]string
+	records []*entities.AuditLog
+}
+
+func newFakeAuditLogger() *fakeAuditLogger {
+	return &fakeAuditLogger{state: make(map[string]string)}
+}
+
+func (f *fakeAuditLogger) Record(_ context.Context, log *entities.AuditLog) error {
+	f.records = append(f.records, log)
+	return nil
+}
+
+func (f *fakeAuditLogger) Snapshot(_ context.Context, entityType, entityID string) *string {
+	value, ok := f.state[entityType+":"+entityID]
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func (f *fakeAuditLogger) GetAuditLogs(_ context.Context, _ *entities.AuditLogQueryParams) ([]entities.AuditLog, int64, error) {
+	return nil, 0, nil
+}
+
+func serveAudited(router *mux.Router, method, path string, claims *auth.AuthClaims) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("X-Forwarded-For", "203.0.113.10, 10.0.0.1")
+	req.Header.Set("User-Agent", "audit-test")
+	if claims != nil {
+		req = req.WithContext(context.WithValue(req.Context(), "claims", claims))
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuditRecord_UpdateStoresBeforeAndAfter(t *testing.T) {
+	logger := newFakeAuditLogger()
+	logger.state["order:order-1"] = `{"status":"PENDING"}`
+	auditMiddleware := middleware.NewAuditMiddleware(logger)
+
+	router := mux.NewRouter()
+	spec := middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityOrder, middleware.PathParam("order_id"))
+	router.Handle("/orders/{order_id}", auditMiddleware.Record(spec, func(w http.ResponseWriter, r *http.Request) {
+		logger.state["order:order-1"] = `{"status":"ACCEPTED"}`
+		w.WriteHeader(http.StatusOK)
+	})).Methods(http.MethodPatch)
+
+	serveAudited(router, http.MethodPatch, "/orders/order-1", &auth.AuthClaims{UserID: "user-1", CompanyID: "company-1"})
+
+	if len(logger.records) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(logger.records))
+	}
+	log := logger.records[0]
+	if log.Action != constants.AuditActionUpdate || log.EntityType != constants.AuditEntityOrder || log.EntityID != "order-1" {
+		t.Fatalf("unexpected audit target: %+v", log)
+	}
+	if log.OldValues == nil || *log.OldValues != `{"status":"PENDING"}` {
+		t.Fatalf("unexpected old values: %v", log.OldValues)
+	}
+	if log.NewValues == nil || *log.NewValues != `{"status":"ACCEPTED"}` {
+		t.Fatalf("unexpected new values: %v", log.NewValues)
+	}
+	if log.UserID == nil || *log.UserID != "user-1" || log.APIKeyID != nil || log.CompanyID != "company-1" {
+		t.Fatalf("unexpected caller: %+v", log)
+	}
+	if log.IPAddress != "203.0.113.10" || log.UserAgent != "audit-test" {
+		t.Fatalf("unexpected request metadata: ip=%q ua=%q", log.IPAddress, log.UserAgent)
+	}
+}
+
+func TestAuditRecord_CreateUsesCapturedID(t *testing.T) {
+	logger := newFakeAuditLogger()
+	auditMiddleware := middleware.NewAuditMiddleware(logger)
+
+	router := mux.NewRouter()
+	spec := middleware.Audit(constants.AuditActionCreate, constants.AuditEntityBranch, nil)
+	router.Handle("/branches", auditMiddleware.Record(spec, func(w http.ResponseWriter, r *http.Request) {
+		// El repositorio completa el ID al insertar el registro
+		audit.TargetFromContext(r.Context()).CaptureID("branch-1")
+		audit.TargetFromContext(r.Context()).CaptureID("zone-link-1")
+		logger.state["branch:branch-1"] = `{"name":"Centro"}`
+		w.WriteHeader(http.StatusCreated)
+	})).Methods(http.MethodPost)
+
+	serveAudited(router, http.MethodPost, "/branches", &auth.AuthClaims{UserID: "user-1", CompanyID: "company-1"})
+
+	if len(logger.records) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(logger.records))
+	}
+	log := logger.records[0]
+	if log.EntityID != "branch-1" || log.OldValues != nil {
+		t.Fatalf("unexpected create audit log: %+v", log)
+	}
+	if log.NewValues == nil || *log.NewValues != `{"name":"Centro"}` {
+		t.Fatalf("unexpected new values: %v", log.NewValues)
+	}
+}
+
+func TestAuditRecord_SkipsFailedRequests(t *testing.T) {
+	logger := newFakeAuditLogger()
+	auditMiddleware := middleware.NewAuditMiddleware(logger)
+
+	router := mux.NewRouter()
+	spec := middleware.Audit(constants.AuditActionDelete, constants.AuditEntityUser, middleware.PathParam("user_id"))
+	router.Handle("/users/{user_id}", auditMiddleware.Record(spec, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})).Methods(http.MethodDelete)
+
+	rec := serveAudited(router, http.MethodDelete, "/users/user-2", &auth.AuthClaims{UserID: "user-1"})
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected the handler status to pass through, got %d", rec.Code)
+	}
+	if len(logger.records) != 0 {
+		t.Fatalf("failed request should not be audited, got %d logs", len(logger.records))
+	}
+}
+
+func TestAuditRecord_APIKeyCaller(t *testing.T) {
+	logger := newFakeAuditLogger()
+	auditMiddleware := middleware.NewAuditMiddleware(logger)
+
+	router := mux.NewRouter()
+	spec := middleware.Audit(constants.AuditActionUpdate, constants.AuditEntityCompany, middleware.ClaimsCompany)
+	router.Handle("/companies/profile", auditMiddleware.Record(spec, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})).Methods(http.MethodPut)
+
+	serveAudited(router, http.MethodPut, "/companies/profile", &auth.AuthClaims{UserID: "key-1", APIKeyID: "key-1", CompanyID: "company-1"})
+
+	if len(logger.records) != 1 {
+		t.Fatalf("expected 1 audit log, got %d", len(logger.records))
+	}
+	log := logger.records[0]
+	if log.UserID != nil || log.APIKeyID == nil || *log.APIKeyID != "key-1" || log.EntityID != "company-1" {
+		t.Fatalf("unexpected api key audit log: %+v", log)
+	}
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type fakePermissionResolver struct {
+	permissions map[string]map[string]bool
+	err         error
+	calls       int
+}
+
+func (f *fakePermissionResolver) GetRolePermissions(_ context.Context, roleName string) (map[string]bool, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.permissions[roleName], nil
+}
+
+func (f *fakePermissionResolver) InvalidateRolePermissions(_ context.Context, _ string) error {
+	return nil
+}
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	os.Exit(m.Run())
+}
+
+func serve(authz *middleware.AuthorizationMiddleware, policy middleware.Policy, claims *auth.AuthClaims) int {
+	handler := authz.Require(policy, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	if claims != nil {
+		req = req.WithContext(context.WithValue(req.Context(), "claims", claims))
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRequire(t *testing.T) {
+	resolver := &fakePermissionResolver{permissions: map[string]map[string]bool{
+		constants.CompanyUser: {constants.PermissionOrdersCreate: true},
+	}}
+	authz := middleware.NewAuthorizationMiddleware(resolver)
+	policy := middleware.AdminOr(constants.PermissionOrdersCreate)
+
+	tests := []struct {
+		name   string
+		policy middleware.Policy
+		claims *auth.AuthClaims
+		want   int
+	}{
+		{"admin role bypasses permissions", policy, &auth.AuthClaims{Role: constants.AdminRole}, http.StatusOK},
+		{"role with permission", policy, &auth.AuthClaims{Role: constants.CompanyUser}, http.StatusOK},
+		{"role without permission", policy, &auth.AuthClaims{Role: constants.Driver}, http.StatusForbidden},
+		{"role only policy", middleware.AnyRole(constants.AdminRole), &auth.AuthClaims{Role: constants.CompanyUser}, http.StatusForbidden},
+		{"missing claims", policy, nil, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := serve(authz, tt.policy, tt.claims); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequireResolverFailure(t *testing.T) {
+	resolver := &fakePermissionResolver{err: errors.New("redis down")}
+	authz := middleware.NewAuthorizationMiddleware(resolver)
+
+	got := serve(authz, middleware.AnyPermission(constants.PermissionOrdersRead), &auth.AuthClaims{Role: constants.FinalUser})
+	if got != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", got, http.StatusInternalServerError)
+	}
+}
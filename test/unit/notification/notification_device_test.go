@@ -0,0 +1,273 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	notificationAdapter "github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/notification"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// memoryDeviceRepository guarda los dispositivos push en memoria
+type memoryDeviceRepository struct {
+	mu      sync.Mutex
+	devices map[string]*entities.NotificationDevice
+}
+
+func newMemoryDeviceRepository() *memoryDeviceRepository {
+	return &memoryDeviceRepository{devices: make(map[string]*entities.NotificationDevice)}
+}
+
+func (r *memoryDeviceRepository) Create(_ context.Context, device *entities.NotificationDevice) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.devices {
+		if existing.DeviceToken == device.DeviceToken {
+			return errors.New("duplicate device token")
+		}
+	}
+	stored := *device
+	r.devices[device.ID] = &stored
+	return nil
+}
+
+func (r *memoryDeviceRepository) Update(_ context.Context, device *entities.NotificationDevice) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *device
+	r.devices[device.ID] = &stored
+	return nil
+}
+
+func (r *memoryDeviceRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.devices, id)
+	return nil
+}
+
+func (r *memoryDeviceRepository) GetByID(_ context.Context, id string) (*entities.NotificationDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	device, ok := r.devices[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := *device
+	return &stored, nil
+}
+
+func (r *memoryDeviceRepository) GetByToken(_ context.Context, token string) (*entities.NotificationDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, device := range r.devices {
+		if device.DeviceToken == token {
+			stored := *device
+			return &stored, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryDeviceRepository) GetActiveByUser(_ context.Context, userID string) ([]entities.NotificationDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var devices []entities.NotificationDevice
+	for _, device := range r.devices {
+		if device.UserID == userID && device.IsActive {
+			devices = append(devices, *device)
+		}
+	}
+	return devices, nil
+}
+
+func (r *memoryDeviceRepository) MarkUsed(_ context.Context, id string, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices[id].LastUsedAt = &usedAt
+	r.devices[id].FailureCount = 0
+	return nil
+}
+
+func (r *memoryDeviceRepository) IncrementFailures(_ context.Context, id string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices[id].FailureCount++
+	return r.devices[id].FailureCount, nil
+}
+
+func (r *memoryDeviceRepository) Deactivate(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices[id].IsActive = false
+	return nil
+}
+
+func (r *memoryDeviceRepository) get(id string) entities.NotificationDevice {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.devices[id]
+}
+
+func TestRegisterDevice_DeduplicatesByToken(t *testing.T) {
+	repo := newMemoryDeviceRepository()
+	service := services.NewNotificationDeviceService(repo)
+	ctx := context.Background()
+
+	first, err := service.RegisterDevice(ctx, &entities.NotificationDeviceInput{UserID: "client-1", DeviceToken: "token-1", DeviceType: "android"})
+	if err != nil {
+		t.Fatalf("RegisterDevice() error = %v", err)
+	}
+	if first.DeviceType != constants.DeviceTypeAndroid {
+		t.Errorf("device type = %s, want %s", first.DeviceType, constants.DeviceTypeAndroid)
+	}
+
+	// El mismo teléfono inicia sesión con otro usuario después de desactivarse por fallos
+	_ = repo.Deactivate(ctx, first.ID)
+	second, err := service.RegisterDevice(ctx, &entities.NotificationDeviceInput{UserID: "driver-1", DeviceToken: "token-1", DeviceType: constants.DeviceTypeAndroid})
+	if err != nil {
+		t.Fatalf("RegisterDevice() error = %v", err)
+	}
+
+	if second.ID != first.ID || len(repo.devices) != 1 {
+		t.Fatalf("token registered as a new device: %s vs %s (%d devices)", second.ID, first.ID, len(repo.devices))
+	}
+	if stored := repo.get(first.ID); stored.UserID != "driver-1" || !stored.IsActive {
+		t.Errorf("device was not reassigned and reactivated: %+v", stored)
+	}
+
+	for _, input := range []*entities.NotificationDeviceInput{
+		{UserID: "client-1", DeviceToken: "", DeviceType: constants.DeviceTypeIOS},
+		{UserID: "client-1", DeviceToken: "token-2", DeviceType: "BLACKBERRY"},
+	} {
+		if _, err = service.RegisterDevice(ctx, input); err == nil {
+			t.Errorf("RegisterDevice(%+v) accepted an invalid device", input)
+		}
+	}
+}
+
+func TestRefreshAndUnregisterDevice_OnlyForTheOwner(t *testing.T) {
+	repo := newMemoryDeviceRepository()
+	service := services.NewNotificationDeviceService(repo)
+	ctx := context.Background()
+
+	device, _ := service.RegisterDevice(ctx, &entities.NotificationDeviceInput{UserID: "client-1", DeviceToken: "token-1", DeviceType: constants.DeviceTypeIOS})
+	stale, _ := service.RegisterDevice(ctx, &entities.NotificationDeviceInput{UserID: "client-1", DeviceToken: "token-2", DeviceType: constants.DeviceTypeIOS})
+
+	refresh := &entities.NotificationDeviceInput{UserID: "driver-1", DeviceToken: "token-3"}
+	if _, err := service.RefreshDevice(ctx, device.ID, refresh); !errors.Is(err, errPackage.ErrNotificationDeviceNotFound) {
+		t.Fatalf("RefreshDevice() by another user error = %v, want ErrNotificationDeviceNotFound", err)
+	}
+	if err := service.UnregisterDevice(ctx, "driver-1", device.ID); !errors.Is(err, errPackage.ErrNotificationDeviceNotFound) {
+		t.Fatalf("UnregisterDevice() by another user error = %v, want ErrNotificationDeviceNotFound", err)
+	}
+
+	// El token nuevo ya pertenecía a otro registro, ese registro se reemplaza
+	refreshed, err := service.RefreshDevice(ctx, device.ID, &entities.NotificationDeviceInput{UserID: "client-1", DeviceToken: "token-2"})
+	if err != nil {
+		t.Fatalf("RefreshDevice() error = %v", err)
+	}
+	if refreshed.DeviceToken != "token-2" || refreshed.DeviceType != constants.DeviceTypeIOS {
+		t.Errorf("unexpected refreshed device %+v", refreshed)
+	}
+	if _, err = repo.GetByID(ctx, stale.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("replaced device %s was not removed", stale.ID)
+	}
+
+	if err = service.UnregisterDevice(ctx, "client-1", device.ID); err != nil {
+		t.Fatalf("UnregisterDevice() error = %v", err)
+	}
+	if len(repo.devices) != 0 {
+		t.Errorf("devices left = %d, want 0", len(repo.devices))
+	}
+}
+
+func TestPushSender_DeactivatesFailingDevices(t *testing.T) {
+	repo := newMemoryDeviceRepository()
+	provider := notificationAdapter.NewFakePushProvider(constants.DeviceTypeAndroid, constants.DeviceTypeIOS)
+	sender := notificationAdapter.NewPushSender(repo, 2, provider)
+	ctx := context.Background()
+
+	for id, token := range map[string]string{"device-ok": "token-ok", "device-flaky": "token-flaky", "device-gone": "token-gone"} {
+		_ = repo.Create(ctx, &entities.NotificationDevice{ID: id, UserID: "client-1", DeviceToken: token, DeviceType: constants.DeviceTypeAndroid, IsActive: true})
+	}
+	// Sin proveedor para WEB el dispositivo se omite sin contar fallos
+	_ = repo.Create(ctx, &entities.NotificationDevice{ID: "device-web", UserID: "client-1", DeviceToken: "token-web", DeviceType: constants.DeviceTypeWeb, IsActive: true})
+
+	provider.FailToken("token-flaky", errors.New("provider timeout"))
+	provider.FailToken("token-gone", errPackage.ErrInvalidDeviceToken)
+
+	notification := &entities.Notification{ID: "notification-1", Type: constants.NotificationOrderStatusChanged, Title: "Pedido TRK-1", Metadata: `{"order_id":"order-1"}`}
+	recipient := &entities.User{ID: "client-1"}
+
+	if err := sender.Send(ctx, notification, recipient); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if stored := repo.get("device-gone"); stored.IsActive {
+		t.Error("device with an invalid token is still active")
+	}
+	if stored := repo.get("device-flaky"); !stored.IsActive || stored.FailureCount != 1 {
+		t.Errorf("flaky device after one failure = %+v, want active with 1 failure", stored)
+	}
+
+	if err := sender.Send(ctx, notification, recipient); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if stored := repo.get("device-flaky"); stored.IsActive {
+		t.Error("device is still active after reaching the failure limit")
+	}
+	if stored := repo.get("device-web"); !stored.IsActive || stored.FailureCount != 0 {
+		t.Errorf("device without provider was penalized: %+v", stored)
+	}
+
+	pushed := provider.Pushed()
+	if len(pushed) != 2 || pushed[0].Token != "token-ok" || pushed[0].Message.Data["order_id"] != "order-1" {
+		t.Fatalf("pushed messages = %+v, want two to token-ok with the order", pushed)
+	}
+}
+
+func TestPushSender_FailsWithoutActiveDevices(t *testing.T) {
+	sender := notificationAdapter.NewPushSender(newMemoryDeviceRepository(), 0, notificationAdapter.NewFakePushProvider(constants.DeviceTypeAndroid))
+
+	if err := sender.Send(context.Background(), &entities.Notification{ID: "notification-1"}, &entities.User{ID: "client-1"}); err == nil {
+		t.Fatal("Send() succeeded without devices, the notification would be marked as sent")
+	}
+}
+
+func TestLogPushProviderDoesNotLogTokenOrBody(t *testing.T) {
+	hook := logtest.NewLocal(logs.Logger)
+	defer hook.Reset()
+
+	err := notificationAdapter.NewLogPushProvider().Push(context.Background(), "secret-device-token", &entities.PushMessage{
+		Title: "Pedido entregado",
+		Body:  "Tu pedido a Calle secreta 123 fue entregado",
+	})
+	if err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+
+	entry := hook.LastEntry()
+	if entry == nil {
+		t.Fatal("no log entry was written")
+	}
+	if entry.Data["title"] != "Pedido entregado" || entry.Data["token_hash"] == "" {
+		t.Errorf("log fields = %v", entry.Data)
+	}
+	for key, value := range entry.Data {
+		if text := fmt.Sprint(value); strings.Contains(text, "secret-device-token") || strings.Contains(text, "Calle secreta") {
+			t.Errorf("log field %q contains the device token or the message body", key)
+		}
+	}
+}
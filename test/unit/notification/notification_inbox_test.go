@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+func TestNotify_PushesNewNotificationsWithUnreadCount(t *testing.T) {
+	f := newNotificationFixture()
+	ctx := context.Background()
+
+	first, err := f.service.Notify(ctx, statusChangedInput("event-1"))
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if _, err = f.service.Notify(ctx, statusChangedInput("event-2")); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	// Un reintento del mismo evento no vuelve a enviarse en vivo
+	if _, err = f.service.Notify(ctx, statusChangedInput("event-1")); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	pushed := f.live.Pushed()
+	if len(pushed) != 2 {
+		t.Fatalf("pushed %d notifications, want 2", len(pushed))
+	}
+	if pushed[0].ID != first.ID || pushed[0].Title != "Pedido TRK-1" || string(pushed[0].Metadata) != `{"order_id":"order-1"}` {
+		t.Errorf("unexpected pushed notification %+v", pushed[0])
+	}
+	if pushed[0].UnreadCount != 1 || pushed[1].UnreadCount != 2 {
+		t.Errorf("unread counts = %d, %d, want 1, 2", pushed[0].UnreadCount, pushed[1].UnreadCount)
+	}
+}
+
+func TestMarkAsRead_OnlyForTheOwner(t *testing.T) {
+	f := newNotificationFixture()
+	ctx := context.Background()
+
+	notification, err := f.service.Notify(ctx, statusChangedInput(""))
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	// Una notificación ajena se trata como inexistente
+	if _, err = f.service.MarkAsRead(ctx, "driver-1", notification.ID); !errors.Is(err, errPackage.ErrNotificationNotFound) {
+		t.Fatalf("MarkAsRead() by another user error = %v, want ErrNotificationNotFound", err)
+	}
+	if _, err = f.service.MarkAsRead(ctx, "client-1", "missing"); !errors.Is(err, errPackage.ErrNotificationNotFound) {
+		t.Fatalf("MarkAsRead() of a missing notification error = %v, want ErrNotificationNotFound", err)
+	}
+
+	read, err := f.service.MarkAsRead(ctx, "client-1", notification.ID)
+	if err != nil {
+		t.Fatalf("MarkAsRead() error = %v", err)
+	}
+	if !read.IsRead || read.ReadAt == nil {
+		t.Fatalf("notification was not marked as read: %+v", read)
+	}
+
+	// Marcarla otra vez conserva la fecha original
+	again, err := f.service.MarkAsRead(ctx, "client-1", notification.ID)
+	if err != nil {
+		t.Fatalf("MarkAsRead() error = %v", err)
+	}
+	if !again.ReadAt.Equal(*read.ReadAt) {
+		t.Errorf("read_at changed from %v to %v", read.ReadAt, again.ReadAt)
+	}
+}
+
+func TestMarkAllAsRead_ClearsUnreadInbox(t *testing.T) {
+	f := newNotificationFixture()
+	ctx := context.Background()
+
+	for _, key := range []string{"event-1", "event-2"} {
+		if _, err := f.service.Notify(ctx, statusChangedInput(key)); err != nil {
+			t.Fatalf("Notify() error = %v", err)
+		}
+	}
+	driverInput := statusChangedInput("event-3")
+	driverInput.UserID = "driver-1"
+	if _, err := f.service.Notify(ctx, driverInput); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	updated, err := f.service.MarkAllAsRead(ctx, "client-1")
+	if err != nil || updated != 2 {
+		t.Fatalf("MarkAllAsRead() = %d, %v, want 2", updated, err)
+	}
+
+	unread, _, err := f.service.GetUserNotifications(ctx, &entities.NotificationQueryParams{UserID: "client-1", UnreadOnly: true})
+	if err != nil || len(unread) != 0 {
+		t.Fatalf("unread notifications = %d, %v, want 0", len(unread), err)
+	}
+
+	// Las notificaciones de otros usuarios no se marcan
+	if count, _ := f.service.CountUnread(ctx, "driver-1"); count != 1 {
+		t.Errorf("driver unread count = %d, want 1", count)
+	}
+
+	all, total, err := f.service.GetUserNotifications(ctx, &entities.NotificationQueryParams{UserID: "client-1"})
+	if err != nil || total != 2 || len(all) != 2 {
+		t.Fatalf("inbox = %d (total %d), %v, want 2", len(all), total, err)
+	}
+	for _, notification := range all {
+		if notification.Type != constants.NotificationOrderStatusChanged || !notification.IsRead {
+			t.Errorf("unexpected notification %+v", notification)
+		}
+	}
+}
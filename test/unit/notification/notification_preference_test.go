@@ -0,0 +1,164 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	notificationUseCase "github.com/MarlonG1/delivery-backend/internal/application/usecases/notification"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+func settingsByType(settings []entities.NotificationChannelSettings) map[string]entities.NotificationChannelSettings {
+	byType := make(map[string]entities.NotificationChannelSettings, len(settings))
+	for _, setting := range settings {
+		byType[setting.NotificationType] = setting
+	}
+	return byType
+}
+
+func TestNotificationPreferences_ListEveryTypeWithDefaults(t *testing.T) {
+	repo := newMemoryNotificationRepository()
+	service := services.NewNotificationPreferenceService(repo)
+
+	settings, err := service.GetUserPreferences(context.Background(), "client-1", "company-1")
+	if err != nil {
+		t.Fatalf("GetUserPreferences() error = %v", err)
+	}
+
+	if len(settings) != len(constants.NotificationTypes) {
+		t.Fatalf("got %d preferences, want one per notification type (%d)", len(settings), len(constants.NotificationTypes))
+	}
+	for i, setting := range settings {
+		if setting.NotificationType != constants.NotificationTypes[i] {
+			t.Errorf("preference %d type = %s, want %s", i, setting.NotificationType, constants.NotificationTypes[i])
+		}
+		if setting.Source != constants.NotificationPreferenceSourceDefault ||
+			setting.EmailEnabled != constants.DefaultNotificationEmailEnabled ||
+			setting.PushEnabled != constants.DefaultNotificationPushEnabled ||
+			setting.SMSEnabled != constants.DefaultNotificationSMSEnabled {
+			t.Errorf("preference %+v does not use the system defaults", setting)
+		}
+	}
+}
+
+func TestNotificationPreferences_UserOverridesCompanyDefault(t *testing.T) {
+	repo := newMemoryNotificationRepository()
+	service := services.NewNotificationPreferenceService(repo)
+	ctx := context.Background()
+
+	_, err := service.UpdateCompanyPreferences(ctx, "company-1", []entities.NotificationChannelSettings{
+		{NotificationType: constants.NotificationOrderCreated, SMSEnabled: true},
+		{NotificationType: constants.NotificationOrderDelivered, PushEnabled: true},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCompanyPreferences() error = %v", err)
+	}
+
+	// El tipo se acepta en minúsculas y se guarda normalizado
+	settings, err := service.UpdateUserPreferences(ctx, "client-1", "company-1", []entities.NotificationChannelSettings{
+		{NotificationType: "order_created", EmailEnabled: true},
+	})
+	if err != nil {
+		t.Fatalf("UpdateUserPreferences() error = %v", err)
+	}
+
+	byType := settingsByType(settings)
+	if created := byType[constants.NotificationOrderCreated]; created.Source != constants.NotificationPreferenceSourceUser || !created.EmailEnabled || created.SMSEnabled {
+		t.Errorf("ORDER_CREATED = %+v, want the user preference", created)
+	}
+	if delivered := byType[constants.NotificationOrderDelivered]; delivered.Source != constants.NotificationPreferenceSourceCompany || delivered.EmailEnabled || !delivered.PushEnabled {
+		t.Errorf("ORDER_DELIVERED = %+v, want the company default", delivered)
+	}
+	if deleted := byType[constants.NotificationOrderDeleted]; deleted.Source != constants.NotificationPreferenceSourceDefault {
+		t.Errorf("ORDER_DELETED source = %s, want DEFAULT", deleted.Source)
+	}
+
+	// Otra empresa no ve los valores por defecto de company-1
+	other, err := service.GetCompanyPreferences(ctx, "company-2")
+	if err != nil {
+		t.Fatalf("GetCompanyPreferences() error = %v", err)
+	}
+	if delivered := settingsByType(other)[constants.NotificationOrderDelivered]; delivered.Source != constants.NotificationPreferenceSourceDefault {
+		t.Errorf("company-2 ORDER_DELIVERED source = %s, want DEFAULT", delivered.Source)
+	}
+}
+
+func TestNotificationPreferences_RejectsUnknownOrRepeatedTypes(t *testing.T) {
+	repo := newMemoryNotificationRepository()
+	service := services.NewNotificationPreferenceService(repo)
+
+	cases := map[string][]entities.NotificationChannelSettings{
+		"unknown":  {{NotificationType: "ORDER_LOST"}},
+		"repeated": {{NotificationType: constants.NotificationOrderCreated}, {NotificationType: "order_created", SMSEnabled: true}},
+	}
+	for name, settings := range cases {
+		_, err := service.UpdateUserPreferences(context.Background(), "client-1", "company-1", settings)
+		if !errors.Is(err, errPackage.ErrInvalidNotificationType) {
+			t.Errorf("%s: error = %v, want ErrInvalidNotificationType", name, err)
+		}
+	}
+	if len(repo.preferences) != 0 {
+		t.Errorf("stored %d preferences after rejected updates", len(repo.preferences))
+	}
+}
+
+func TestCompanyPreferences_AdminWithoutCompanyMustPassCompanyID(t *testing.T) {
+	repo := newMemoryNotificationRepository()
+	useCase := notificationUseCase.NewNotificationPreferenceUseCase(services.NewNotificationPreferenceService(repo))
+	ctx := context.WithValue(context.Background(), "claims", &auth.AuthClaims{UserID: "admin-1", Role: constants.AdminRole})
+
+	// Sin company_id no hay empresa sobre la que operar
+	if _, err := useCase.GetCompanyPreferences(ctx, ""); !errors.Is(err, infraErr.ErrCompanyRequired) {
+		t.Errorf("GetCompanyPreferences() error = %v, want ErrCompanyRequired", err)
+	}
+	settings := []entities.NotificationChannelSettings{{NotificationType: constants.NotificationOrderCreated, SMSEnabled: true}}
+	if _, err := useCase.UpdateCompanyPreferences(ctx, "", settings); !errors.Is(err, infraErr.ErrCompanyRequired) {
+		t.Errorf("UpdateCompanyPreferences() error = %v, want ErrCompanyRequired", err)
+	}
+	if len(repo.companyPrefs) != 0 {
+		t.Errorf("stored %d company preferences without a company", len(repo.companyPrefs))
+	}
+
+	// Con company_id el administrador opera sobre la empresa indicada
+	if _, err := useCase.UpdateCompanyPreferences(ctx, "company-1", settings); err != nil {
+		t.Fatalf("UpdateCompanyPreferences() error = %v", err)
+	}
+	if _, ok := repo.companyPrefs["company-1:"+constants.NotificationOrderCreated]; !ok {
+		t.Error("company-1 default was not stored")
+	}
+}
+
+func TestNotify_UsesCompanyDefaultWhenUserHasNoPreference(t *testing.T) {
+	f := newNotificationFixture()
+	f.repo.companyPrefs["company-1:"+constants.NotificationOrderStatusChanged] = &entities.CompanyNotificationPreference{
+		CompanyID:        "company-1",
+		NotificationType: constants.NotificationOrderStatusChanged,
+		SMSEnabled:       true,
+	}
+
+	if _, err := f.service.Notify(context.Background(), statusChangedInput("")); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if len(f.email.Sent()) != 0 || len(f.sms.Sent()) != 1 {
+		t.Errorf("deliveries email=%d sms=%d, want the company default 0 and 1", len(f.email.Sent()), len(f.sms.Sent()))
+	}
+
+	// La preferencia del usuario tiene prioridad sobre la de la empresa
+	f.repo.preferences["client-1:"+constants.NotificationOrderStatusChanged] = &entities.NotificationPreference{
+		UserID:           "client-1",
+		NotificationType: constants.NotificationOrderStatusChanged,
+		EmailEnabled:     true,
+	}
+	if _, err := f.service.Notify(context.Background(), statusChangedInput("")); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if len(f.email.Sent()) != 1 || len(f.sms.Sent()) != 1 {
+		t.Errorf("deliveries email=%d sms=%d, want the user preference 1 and 1", len(f.email.Sent()), len(f.sms.Sent()))
+	}
+}
@@ -0,0 +1,430 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	wsModels "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	notificationAdapter "github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/notification"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// memoryNotificationRepository guarda plantillas, preferencias y notificaciones en memoria
+type memoryNotificationRepository struct {
+	mu            sync.Mutex
+	templates     map[string]*entities.NotificationTemplate
+	preferences   map[string]*entities.NotificationPreference
+	companyPrefs  map[string]*entities.CompanyNotificationPreference
+	notifications map[string]*entities.Notification
+}
+
+func newMemoryNotificationRepository() *memoryNotificationRepository {
+	repo := &memoryNotificationRepository{
+		templates:     make(map[string]*entities.NotificationTemplate),
+		preferences:   make(map[string]*entities.NotificationPreference),
+		companyPrefs:  make(map[string]*entities.CompanyNotificationPreference),
+		notifications: make(map[string]*entities.Notification),
+	}
+	repo.addTemplate(constants.NotificationOrderStatusChanged, "Pedido {{.tracking_number}}", "Cambió de {{.previous_status}} a {{.status}}")
+	repo.addTemplate(constants.NotificationOrderDriverAssigned, "Pedido {{.tracking_number}} en camino", "Repartidor asignado")
+	repo.addTemplate(constants.NotificationOrderAssignedToDriver, "Nuevo pedido", "Recoge el pedido {{.tracking_number}}")
+	return repo
+}
+
+func (r *memoryNotificationRepository) addTemplate(notificationType, title, content string) {
+	r.templates[notificationType] = &entities.NotificationTemplate{
+		ID:              "template-" + notificationType,
+		Name:            notificationType,
+		Type:            notificationType,
+		TitleTemplate:   title,
+		ContentTemplate: content,
+		IsActive:        true,
+	}
+}
+
+func (r *memoryNotificationRepository) GetActiveTemplateByType(_ context.Context, notificationType string) (*entities.NotificationTemplate, error) {
+	template, ok := r.templates[notificationType]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return template, nil
+}
+
+func (r *memoryNotificationRepository) GetPreference(_ context.Context, userID, notificationType string) (*entities.NotificationPreference, error) {
+	return r.preferences[userID+":"+notificationType], nil
+}
+
+func (r *memoryNotificationRepository) GetCompanyPreference(_ context.Context, companyID, notificationType string) (*entities.CompanyNotificationPreference, error) {
+	return r.companyPrefs[companyID+":"+notificationType], nil
+}
+
+func (r *memoryNotificationRepository) GetUserPreferences(_ context.Context, userID string) ([]entities.NotificationPreference, error) {
+	var preferences []entities.NotificationPreference
+	for _, preference := range r.preferences {
+		if preference.UserID == userID {
+			preferences = append(preferences, *preference)
+		}
+	}
+	return preferences, nil
+}
+
+func (r *memoryNotificationRepository) GetCompanyPreferences(_ context.Context, companyID string) ([]entities.CompanyNotificationPreference, error) {
+	var preferences []entities.CompanyNotificationPreference
+	for _, preference := range r.companyPrefs {
+		if preference.CompanyID == companyID {
+			preferences = append(preferences, *preference)
+		}
+	}
+	return preferences, nil
+}
+
+func (r *memoryNotificationRepository) SaveUserPreferences(_ context.Context, preferences []entities.NotificationPreference) error {
+	for i := range preferences {
+		preference := preferences[i]
+		r.preferences[preference.UserID+":"+preference.NotificationType] = &preference
+	}
+	return nil
+}
+
+func (r *memoryNotificationRepository) SaveCompanyPreferences(_ context.Context, preferences []entities.CompanyNotificationPreference) error {
+	for i := range preferences {
+		preference := preferences[i]
+		r.companyPrefs[preference.CompanyID+":"+preference.NotificationType] = &preference
+	}
+	return nil
+}
+
+func (r *memoryNotificationRepository) Create(_ context.Context, notification *entities.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.notifications[notification.ID]; ok {
+		return errors.New("duplicate notification")
+	}
+	stored := *notification
+	r.notifications[notification.ID] = &stored
+	return nil
+}
+
+func (r *memoryNotificationRepository) GetByID(_ context.Context, id string) (*entities.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	notification, ok := r.notifications[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := *notification
+	return &stored, nil
+}
+
+func (r *memoryNotificationRepository) MarkSent(_ context.Context, id string, sentAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifications[id].SentAt = &sentAt
+	return nil
+}
+
+func (r *memoryNotificationRepository) GetUserNotifications(_ context.Context, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var notifications []entities.Notification
+	for _, notification := range r.notifications {
+		if notification.UserID != params.UserID || (params.UnreadOnly && notification.IsRead) {
+			continue
+		}
+		notifications = append(notifications, *notification)
+	}
+	return notifications, int64(len(notifications)), nil
+}
+
+func (r *memoryNotificationRepository) CountUnread(_ context.Context, userID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unread int64
+	for _, notification := range r.notifications {
+		if notification.UserID == userID && !notification.IsRead {
+			unread++
+		}
+	}
+	return unread, nil
+}
+
+func (r *memoryNotificationRepository) MarkRead(_ context.Context, userID, id string, readAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if notification, ok := r.notifications[id]; ok && notification.UserID == userID && !notification.IsRead {
+		notification.IsRead = true
+		notification.ReadAt = &readAt
+	}
+	return nil
+}
+
+func (r *memoryNotificationRepository) MarkAllRead(_ context.Context, userID string, readAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var updated int64
+	for _, notification := range r.notifications {
+		if notification.UserID == userID && !notification.IsRead {
+			notification.IsRead = true
+			notification.ReadAt = &readAt
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// memoryLiveNotifications registra las notificaciones enviadas en vivo
+type memoryLiveNotifications struct {
+	mu     sync.Mutex
+	pushed []*wsModels.NotificationData
+}
+
+func (l *memoryLiveNotifications) SendNotification(_ string, data *wsModels.NotificationData) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pushed = append(l.pushed, data)
+	return nil
+}
+
+func (l *memoryLiveNotifications) Pushed() []*wsModels.NotificationData {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]*wsModels.NotificationData(nil), l.pushed...)
+}
+
+// stubUserRepository solo implementa la búsqueda por ID
+type stubUserRepository struct {
+	ports.UserRepository
+	users map[string]*entities.User
+}
+
+func (r *stubUserRepository) GetByID(_ context.Context, id string) (*entities.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+// stubOrderRepository solo implementa la búsqueda del pedido por ID
+type stubOrderRepository struct {
+	ports.OrdererRepository
+	order *entities.Order
+}
+
+func (r *stubOrderRepository) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	return r.order, nil
+}
+
+type notificationFixture struct {
+	repo    *memoryNotificationRepository
+	live    *memoryLiveNotifications
+	email   *notificationAdapter.InMemorySender
+	sms     *notificationAdapter.InMemorySender
+	service interfaces.Notifier
+}
+
+func newNotificationFixture() *notificationFixture {
+	f := &notificationFixture{
+		repo:  newMemoryNotificationRepository(),
+		live:  &memoryLiveNotifications{},
+		email: notificationAdapter.NewInMemorySender(constants.NotificationChannelEmail),
+		sms:   notificationAdapter.NewInMemorySender(constants.NotificationChannelSMS),
+	}
+	users := &stubUserRepository{users: map[string]*entities.User{
+		"client-1": {ID: "client-1", CompanyID: "company-1", Email: "client@example.com"},
+		"driver-1": {ID: "driver-1", CompanyID: "company-1", Email: "driver@example.com"},
+	}}
+	f.service = services.NewNotificationService(f.repo, users, f.live, f.email, f.sms)
+	return f
+}
+
+func statusChangedInput(dedupKey string) *entities.NotificationInput {
+	return &entities.NotificationInput{
+		UserID: "client-1",
+		Type:   constants.NotificationOrderStatusChanged,
+		Variables: map[string]interface{}{
+			"tracking_number": "TRK-1",
+			"status":          "IN_TRANSIT",
+			"previous_status": "ACCEPTED",
+		},
+		Metadata: map[string]interface{}{"order_id": "order-1"},
+		DedupKey: dedupKey,
+	}
+}
+
+func TestNotify_RendersTemplateAndUsesDefaultPreferences(t *testing.T) {
+	f := newNotificationFixture()
+
+	notification, err := f.service.Notify(context.Background(), statusChangedInput(""))
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	if notification.Title != "Pedido TRK-1" || notification.Content != "Cambió de ACCEPTED a IN_TRANSIT" {
+		t.Errorf("rendered %q / %q", notification.Title, notification.Content)
+	}
+	if notification.TemplateID != "template-"+constants.NotificationOrderStatusChanged || notification.Metadata != `{"order_id":"order-1"}` {
+		t.Errorf("unexpected notification %+v", notification)
+	}
+
+	// Sin preferencias guardadas el correo está habilitado y el SMS no
+	if sent := f.email.Sent(); len(sent) != 1 || sent[0].Recipient.Email != "client@example.com" {
+		t.Errorf("email deliveries = %+v, want one to the client", sent)
+	}
+	if sent := f.sms.Sent(); len(sent) != 0 {
+		t.Errorf("sms deliveries = %d, want 0 by default", len(sent))
+	}
+
+	stored, _ := f.repo.GetByID(context.Background(), notification.ID)
+	if stored == nil || stored.SentAt == nil {
+		t.Fatalf("stored notification was not marked as sent: %+v", stored)
+	}
+}
+
+func TestNotify_HonorsUserPreferences(t *testing.T) {
+	f := newNotificationFixture()
+	f.repo.preferences["client-1:"+constants.NotificationOrderStatusChanged] = &entities.NotificationPreference{
+		UserID:           "client-1",
+		NotificationType: constants.NotificationOrderStatusChanged,
+		EmailEnabled:     false,
+		SMSEnabled:       true,
+	}
+
+	if _, err := f.service.Notify(context.Background(), statusChangedInput("")); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	if len(f.email.Sent()) != 0 || len(f.sms.Sent()) != 1 {
+		t.Errorf("deliveries email=%d sms=%d, want 0 and 1", len(f.email.Sent()), len(f.sms.Sent()))
+	}
+}
+
+func TestNotify_DedupKeyDoesNotRepeatDelivery(t *testing.T) {
+	f := newNotificationFixture()
+	ctx := context.Background()
+
+	// El primer intento guarda la notificación pero el canal falla, el reintento la entrega una sola vez
+	f.email.FailWith(errors.New("smtp unavailable"))
+	first, err := f.service.Notify(ctx, statusChangedInput("event-1"))
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if first.SentAt != nil {
+		t.Fatal("notification marked as sent although every channel failed")
+	}
+
+	f.email.FailWith(nil)
+	for i := 0; i < 2; i++ {
+		again, err := f.service.Notify(ctx, statusChangedInput("event-1"))
+		if err != nil {
+			t.Fatalf("retry %d: Notify() error = %v", i, err)
+		}
+		if again.ID != first.ID {
+			t.Fatalf("retry %d created notification %s, want %s", i, again.ID, first.ID)
+		}
+	}
+
+	if sent := f.email.Sent(); len(sent) != 1 {
+		t.Errorf("email deliveries = %d, want 1", len(sent))
+	}
+	if len(f.repo.notifications) != 1 {
+		t.Errorf("stored %d notifications, want 1", len(f.repo.notifications))
+	}
+}
+
+func TestNotify_MissingVariableIsRejected(t *testing.T) {
+	f := newNotificationFixture()
+	input := statusChangedInput("")
+	delete(input.Variables, "previous_status")
+
+	_, err := f.service.Notify(context.Background(), input)
+	if !errors.Is(err, errPackage.ErrInvalidNotificationTemplate) {
+		t.Fatalf("Notify() error = %v, want ErrInvalidNotificationTemplate", err)
+	}
+	if len(f.repo.notifications) != 0 || len(f.email.Sent()) != 0 {
+		t.Error("an incomplete notification was stored or sent")
+	}
+}
+
+func TestOrderNotificationPublisher_NotifiesClientAndDriver(t *testing.T) {
+	f := newNotificationFixture()
+	driverID := "driver-1"
+	orders := &stubOrderRepository{order: &entities.Order{ID: "order-1", ClientID: "client-1", DriverID: &driverID}}
+	publisher := services.NewOrderNotificationPublisher(orders, f.service)
+
+	event := &entities.OrderEvent{
+		ID:   "event-1",
+		Type: constants.OrderEventDriverAssigned,
+		Data: entities.OrderEventData{OrderID: "order-1", TrackingNumber: "TRK-1", DriverID: driverID},
+	}
+	if err := publisher.PublishOrderEvent(context.Background(), event); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+
+	sent := f.email.Sent()
+	if len(sent) != 2 {
+		t.Fatalf("email deliveries = %d, want client and driver", len(sent))
+	}
+	byRecipient := map[string]string{}
+	for _, delivery := range sent {
+		byRecipient[delivery.Recipient.ID] = delivery.Notification.Type
+	}
+	if byRecipient["client-1"] != constants.NotificationOrderDriverAssigned || byRecipient["driver-1"] != constants.NotificationOrderAssignedToDriver {
+		t.Errorf("unexpected deliveries %+v", byRecipient)
+	}
+	for _, delivery := range sent {
+		if !strings.Contains(delivery.Notification.Metadata, `"event_id":"event-1"`) {
+			t.Errorf("metadata %s does not reference the event", delivery.Notification.Metadata)
+		}
+	}
+}
+
+func TestOrderNotificationPublisher_SkipsMissingTemplatesAndDeliveredStatus(t *testing.T) {
+	f := newNotificationFixture()
+	orders := &stubOrderRepository{order: &entities.Order{ID: "order-1", ClientID: "client-1"}}
+	publisher := services.NewOrderNotificationPublisher(orders, f.service)
+	ctx := context.Background()
+
+	// La entrega llega también como cambio de estado, solo se notifica con el evento de entrega
+	delivered := &entities.OrderEvent{
+		ID:   "event-2",
+		Type: constants.OrderEventStatusChanged,
+		Data: entities.OrderEventData{OrderID: "order-1", Status: constants.OrderStatusDelivered},
+	}
+	// No hay plantilla para pedidos creados, el evento no debe reintentarse por eso
+	created := &entities.OrderEvent{
+		ID:   "event-3",
+		Type: constants.OrderEventCreated,
+		Data: entities.OrderEventData{OrderID: "order-1", TrackingNumber: "TRK-1"},
+	}
+
+	for _, event := range []*entities.OrderEvent{delivered, created} {
+		if err := publisher.PublishOrderEvent(ctx, event); err != nil {
+			t.Fatalf("PublishOrderEvent(%s) error = %v", event.Type, err)
+		}
+	}
+	if len(f.email.Sent()) != 0 || len(f.repo.notifications) != 0 {
+		t.Errorf("unexpected notifications: sent=%d stored=%d", len(f.email.Sent()), len(f.repo.notifications))
+	}
+}
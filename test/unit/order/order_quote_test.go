@@ -0,0 +1,83 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	orderUseCase "github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// stubCompanyService retorna la dirección de recogida y la sucursal del usuario
+type stubCompanyService struct {
+	interfaces.Companyrer
+}
+
+func (s *stubCompanyService) GetAddressByID(_ context.Context, id, _ string) (*entities.CompanyAddress, error) {
+	return &entities.CompanyAddress{ID: id, Latitude: 13.70, Longitude: -89.20}, nil
+}
+
+func (s *stubCompanyService) GetCompanyAndBranchForUser(_ context.Context, _ string) (string, string, error) {
+	return "company-1", "branch-1", nil
+}
+
+// stubZoneResolver ubica en una zona fija los puntos al este de la longitud indicada
+type stubZoneResolver struct {
+	zoneID       string
+	minLongitude float64
+}
+
+func (r *stubZoneResolver) ResolveZone(_ context.Context, _, longitude float64) (string, error) {
+	if longitude < r.minLongitude {
+		return "", errPackage.NewDomainErrorWithCause("stubZoneResolver", "ResolveZone", errPackage.ErrAddressOutOfCoverage.Error(), errPackage.ErrAddressOutOfCoverage)
+	}
+	return r.zoneID, nil
+}
+
+func (r *stubZoneResolver) InvalidateZones() {}
+
+func newQuoteUseCase() *orderUseCase.OrderUseCase {
+	// La sucursal está en la zona 1, la dirección de entrega en la zona 2 de tarifa más alta
+	repo := newStubCompanyRepository(0,
+		&entities.Zone{ID: "zone-1", BaseRate: 3, IsActive: true},
+		&entities.Zone{ID: "zone-2", BaseRate: 10, IsActive: true},
+	)
+	return orderUseCase.NewOrderUseCase(nil, &stubCompanyService{}, services.NewPricingService(repo), &stubZoneResolver{zoneID: "zone-2", minLongitude: -89.25}, nil)
+}
+
+func quoteRequest(longitude float64) *dto.OrderQuoteRequest {
+	return &dto.OrderQuoteRequest{
+		CompanyPickUpID:   "address-1",
+		PackageDetails:    dto.PackageDetailRequest{Weight: 1},
+		DeliveryLatitude:  13.70,
+		DeliveryLongitude: longitude,
+	}
+}
+
+func TestQuoteOrder_PricesWithTheDeliveryZone(t *testing.T) {
+	ctx := withClaims(&auth.AuthClaims{UserID: "user-1", CompanyID: "company-1", Role: constants.AdminRole})
+
+	quote, err := newQuoteUseCase().QuoteOrder(ctx, "user-1", quoteRequest(-89.21))
+	if err != nil {
+		t.Fatalf("QuoteOrder() error = %v", err)
+	}
+	if quote.ZoneID != "zone-2" || quote.Items[0].Concept != constants.PriceConceptBaseRate || quote.Items[0].Amount != 10 {
+		t.Errorf("quote zone %s with items %+v, want the zone-2 base rate of 10", quote.ZoneID, quote.Items)
+	}
+}
+
+func TestQuoteOrder_RejectsAddressesOutOfCoverage(t *testing.T) {
+	ctx := withClaims(&auth.AuthClaims{UserID: "user-1", CompanyID: "company-1", Role: constants.AdminRole})
+
+	_, err := newQuoteUseCase().QuoteOrder(ctx, "user-1", quoteRequest(-89.30))
+	if !errors.Is(err, errPackage.ErrAddressOutOfCoverage) {
+		t.Errorf("QuoteOrder() error = %v, want ErrAddressOutOfCoverage", err)
+	}
+}
@@ -0,0 +1,202 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	orderUseCase "github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// stubOrderRepository retorna siempre el mismo pedido
+type stubOrderRepository struct {
+	ports.OrdererRepository
+	order *entities.Order
+	err   error
+}
+
+func (r *stubOrderRepository) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	order := *r.order
+	return &order, nil
+}
+
+// recordingOrderService registra las operaciones que el caso de uso delega al servicio
+type recordingOrderService struct {
+	interfaces.Orderer
+	order   *entities.Order
+	calls   []string
+	updates []*entities.Order
+}
+
+func (s *recordingOrderService) UpdateOrder(_ context.Context, _ string, order *entities.Order) error {
+	s.calls = append(s.calls, "UpdateOrder")
+	s.updates = append(s.updates, order)
+	return nil
+}
+
+func (s *recordingOrderService) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	order := *s.order
+	return &order, nil
+}
+
+func (s *recordingOrderService) OrderIsDeleted(_ context.Context, _ string) bool {
+	return false
+}
+
+func (s *recordingOrderService) ChangeStatus(_ context.Context, _, status string) error {
+	s.calls = append(s.calls, "ChangeStatus:"+status)
+	return nil
+}
+
+func (s *recordingOrderService) IsAvailableForDelete(_ context.Context, _ string) error {
+	return nil
+}
+
+func (s *recordingOrderService) SoftDeleteOrder(_ context.Context, _ string) error {
+	s.calls = append(s.calls, "SoftDeleteOrder")
+	return nil
+}
+
+func (s *recordingOrderService) RestoreOrder(_ context.Context, _ string) error {
+	s.calls = append(s.calls, "RestoreOrder")
+	return nil
+}
+
+func newOrder() *entities.Order {
+	driverID := "driver-1"
+	return &entities.Order{ID: "order-1", CompanyID: "company-1", ClientID: "client-1", DriverID: &driverID, Status: constants.OrderStatusInTransit}
+}
+
+func withClaims(claims *auth.AuthClaims) context.Context {
+	return context.WithValue(context.Background(), "claims", claims)
+}
+
+func TestOrderUseCase_RejectsOrdersOfOtherTenants(t *testing.T) {
+	cases := []struct {
+		name    string
+		claims  *auth.AuthClaims
+		allowed bool
+	}{
+		{"admin", &auth.AuthClaims{UserID: "admin-1", Role: constants.AdminRole}, true},
+		{"assigned driver", &auth.AuthClaims{UserID: "driver-1", CompanyID: "company-2", Role: constants.Driver}, true},
+		{"other driver of the same company", &auth.AuthClaims{UserID: "driver-2", CompanyID: "company-1", Role: constants.Driver}, false},
+		{"collector of the same company", &auth.AuthClaims{UserID: "collector-1", CompanyID: "company-1", Role: "COLLECTOR"}, true},
+		{"collector of another company", &auth.AuthClaims{UserID: "collector-2", CompanyID: "company-2", Role: "COLLECTOR"}, false},
+		{"api key of another company", &auth.AuthClaims{UserID: "key-1", CompanyID: "company-2", Role: constants.IntegrationRole, APIKeyID: "key-1"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			orderService := &recordingOrderService{order: newOrder()}
+			access := services.NewOrderAccessService(&stubOrderRepository{order: newOrder()})
+			useCase := orderUseCase.NewOrderUseCase(orderService, nil, nil, nil, access)
+			ctx := withClaims(tc.claims)
+
+			errs := map[string]error{
+				"ChangeStatus": useCase.ChangeStatus(ctx, "order-1", constants.OrderStatusDelivered),
+				"DeleteOrder":  useCase.DeleteOrder(ctx, "order-1"),
+				"RestoreOrder": useCase.RestoreOrder(ctx, "order-1"),
+			}
+			_, errs["GetOrderByID"] = useCase.GetOrderByID(ctx, "order-1")
+
+			for operation, err := range errs {
+				if tc.allowed && err != nil {
+					t.Errorf("%s() error = %v, want nil", operation, err)
+				}
+				if !tc.allowed && !errors.Is(err, errPackage.ErrOrderAccessDenied) {
+					t.Errorf("%s() error = %v, want ErrOrderAccessDenied", operation, err)
+				}
+			}
+
+			if !tc.allowed && len(orderService.calls) != 0 {
+				t.Errorf("order service was called with %v for a denied user", orderService.calls)
+			}
+			if tc.allowed && len(orderService.calls) != 3 {
+				t.Errorf("order service calls = %v, want 3", orderService.calls)
+			}
+		})
+	}
+}
+
+func TestAuthorizeOrderAccess_OnlyMissingOrdersAreNotFound(t *testing.T) {
+	claims := &auth.AuthClaims{UserID: "admin-1", Role: constants.AdminRole}
+
+	access := services.NewOrderAccessService(&stubOrderRepository{err: gorm.ErrRecordNotFound})
+	if _, err := access.AuthorizeOrderAccess(context.Background(), claims, "order-1"); !errors.Is(err, errPackage.ErrOrderNotFound) {
+		t.Errorf("missing order: error = %v, want ErrOrderNotFound", err)
+	}
+
+	// Un fallo de la base de datos no se reporta como pedido inexistente
+	dbErr := errors.New("connection refused")
+	access = services.NewOrderAccessService(&stubOrderRepository{err: dbErr})
+	_, err := access.AuthorizeOrderAccess(context.Background(), claims, "order-1")
+	if !errors.Is(err, dbErr) || errors.Is(err, errPackage.ErrOrderNotFound) {
+		t.Errorf("database failure: error = %v, want the repository error", err)
+	}
+}
+
+func TestUpdateOrder_SavesPackageAndPriceTogether(t *testing.T) {
+	current := pricedOrder(&entities.PackageDetail{OrderID: "order-1", Weight: 2, IsFragile: true})
+	orderService := &recordingOrderService{order: current}
+	pricing := services.NewPricingService(newStubCompanyRepository(0, &entities.Zone{ID: "zone-1", BaseRate: 4, IsActive: true}))
+	useCase := orderUseCase.NewOrderUseCase(orderService, nil, pricing, nil, services.NewOrderAccessService(&stubOrderRepository{order: current}))
+	ctx := withClaims(&auth.AuthClaims{UserID: "admin-1", Role: constants.AdminRole})
+
+	// El peso nuevo se cotiza junto con el recargo por frágil que el pedido ya tenía
+	weight := 9.0
+	if err := useCase.UpdateOrder(ctx, "order-1", &dto.OrderUpdateRequest{PackageDetails: &dto.PackageDetailUpdateRequest{Weight: &weight}}); err != nil {
+		t.Fatalf("UpdateOrder() error = %v", err)
+	}
+
+	if len(orderService.updates) != 1 {
+		t.Fatalf("UpdateOrder was called %d times, want a single write", len(orderService.updates))
+	}
+	saved := orderService.updates[0]
+	if saved.PackageDetail == nil || saved.PackageDetail.Weight != 9 {
+		t.Errorf("saved package detail = %+v, want weight 9", saved.PackageDetail)
+	}
+	// 4 de tarifa base + 2 de sobrepeso + 15% por frágil
+	if saved.Detail == nil || saved.Detail.Price != 6.9 {
+		t.Errorf("saved detail = %+v, want price 6.9", saved.Detail)
+	}
+}
+
+func TestUpdateOrder_PricingFailureLeavesOrderUntouched(t *testing.T) {
+	current := pricedOrder(&entities.PackageDetail{OrderID: "order-1", Weight: 2})
+	orderService := &recordingOrderService{order: current}
+	pricing := services.NewPricingService(newStubCompanyRepository(0, &entities.Zone{ID: "zone-1", BaseRate: 4, IsActive: false}))
+	useCase := orderUseCase.NewOrderUseCase(orderService, nil, pricing, nil, services.NewOrderAccessService(&stubOrderRepository{order: current}))
+	ctx := withClaims(&auth.AuthClaims{UserID: "admin-1", Role: constants.AdminRole})
+
+	weight := 9.0
+	err := useCase.UpdateOrder(ctx, "order-1", &dto.OrderUpdateRequest{PackageDetails: &dto.PackageDetailUpdateRequest{Weight: &weight}})
+	if !errors.Is(err, errPackage.ErrZoneInactive) {
+		t.Errorf("UpdateOrder() error = %v, want ErrZoneInactive", err)
+	}
+	if len(orderService.updates) != 0 {
+		t.Errorf("order was written %d times after a pricing failure", len(orderService.updates))
+	}
+}
@@ -0,0 +1,201 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// stubCompanyRepository resuelve la compañía, la sucursal y las zonas usadas por la tarifa
+type stubCompanyRepository struct {
+	ports.CompanyRepository
+	deliveryRate float64
+	branchZoneID string
+	zones        map[string]*entities.Zone
+	surge        map[string]float64
+}
+
+func newStubCompanyRepository(deliveryRate float64, zones ...*entities.Zone) *stubCompanyRepository {
+	repo := &stubCompanyRepository{deliveryRate: deliveryRate, zones: make(map[string]*entities.Zone), surge: make(map[string]float64)}
+	for _, zone := range zones {
+		repo.zones[zone.ID] = zone
+	}
+	if len(zones) > 0 {
+		repo.branchZoneID = zones[0].ID
+	}
+	return repo
+}
+
+func (r *stubCompanyRepository) GetCompanyByID(_ context.Context, id string) (*entities.Company, error) {
+	return &entities.Company{ID: id, DeliveryRate: r.deliveryRate}, nil
+}
+
+func (r *stubCompanyRepository) GetBranchByID(_ context.Context, id string) (*entities.Branch, error) {
+	return &entities.Branch{ID: id, ZoneID: r.branchZoneID}, nil
+}
+
+func (r *stubCompanyRepository) GetZoneByID(_ context.Context, id string) (*entities.Zone, error) {
+	zone, ok := r.zones[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return zone, nil
+}
+
+func (r *stubCompanyRepository) GetZoneCoverage(_ context.Context, zoneID string) (*entities.Coverage, error) {
+	surge, ok := r.surge[zoneID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &entities.Coverage{ZoneID: zoneID, SurgeMultiplier: surge}, nil
+}
+
+func pricedOrder(pkg *entities.PackageDetail) *entities.Order {
+	return &entities.Order{
+		ID:              "order-1",
+		CompanyID:       "company-1",
+		BranchID:        "branch-1",
+		PickupAddress:   &entities.PickupAddress{Latitude: 13.70, Longitude: -89.20},
+		DeliveryAddress: &entities.DeliveryAddress{Latitude: 13.70, Longitude: -89.21},
+		PackageDetail:   pkg,
+	}
+}
+
+func TestPricingService_QuoteBreakdown(t *testing.T) {
+	distance := value_objects.NewGeoPoint(13.70, -89.20).DistanceTo(value_objects.NewGeoPoint(13.70, -89.21))
+	distance = math.Round(distance*100) / 100
+
+	cases := []struct {
+		name           string
+		ratePerKm      float64
+		baseRate       float64
+		surge          float64
+		pkg            *entities.PackageDetail
+		billableWeight float64
+		items          map[string]float64
+		total          float64
+	}{
+		{
+			name:           "base rate only",
+			baseRate:       3,
+			pkg:            &entities.PackageDetail{Weight: 2},
+			billableWeight: 2,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 3, constants.PriceConceptDistance: 0},
+			total:          3,
+		},
+		{
+			name:           "distance charged per km",
+			ratePerKm:      2,
+			baseRate:       3,
+			pkg:            &entities.PackageDetail{Weight: 2},
+			billableWeight: 2,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 3, constants.PriceConceptDistance: math.Round(distance*2*100) / 100},
+			total:          math.Round((3+distance*2)*100) / 100,
+		},
+		{
+			name:           "overweight over the free kilograms",
+			baseRate:       3,
+			pkg:            &entities.PackageDetail{Weight: 8},
+			billableWeight: 8,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 3, constants.PriceConceptDistance: 0, constants.PriceConceptWeight: 1.5},
+			total:          4.5,
+		},
+		{
+			name:           "volumetric weight wins over the real weight",
+			baseRate:       3,
+			pkg:            &entities.PackageDetail{Weight: 2, Dimensions: `{"length":50,"width":40,"height":30,"unit":"cm"}`},
+			billableWeight: 12,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 3, constants.PriceConceptDistance: 0, constants.PriceConceptWeight: 3.5},
+			total:          6.5,
+		},
+		{
+			name:           "fragile surcharge rounded to cents",
+			baseRate:       4.5,
+			pkg:            &entities.PackageDetail{Weight: 1, IsFragile: true},
+			billableWeight: 1,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 4.5, constants.PriceConceptDistance: 0, constants.PriceConceptFragile: 0.68},
+			total:          5.18,
+		},
+		{
+			name:           "fragile and urgent both apply to the subtotal",
+			baseRate:       4,
+			pkg:            &entities.PackageDetail{Weight: 1, IsFragile: true, IsUrgent: true},
+			billableWeight: 1,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 4, constants.PriceConceptDistance: 0, constants.PriceConceptFragile: 0.6, constants.PriceConceptUrgent: 1},
+			total:          5.6,
+		},
+		{
+			name:           "surge multiplier over everything else",
+			baseRate:       4,
+			surge:          1.5,
+			pkg:            &entities.PackageDetail{Weight: 1, IsUrgent: true},
+			billableWeight: 1,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 4, constants.PriceConceptDistance: 0, constants.PriceConceptUrgent: 1, constants.PriceConceptSurge: 2.5},
+			total:          7.5,
+		},
+		{
+			name:           "surge below one is ignored",
+			baseRate:       4,
+			surge:          0.8,
+			pkg:            &entities.PackageDetail{Weight: 1},
+			billableWeight: 1,
+			items:          map[string]float64{constants.PriceConceptBaseRate: 4, constants.PriceConceptDistance: 0},
+			total:          4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newStubCompanyRepository(tc.ratePerKm, &entities.Zone{ID: "zone-1", BaseRate: tc.baseRate, IsActive: true})
+			if tc.surge != 0 {
+				repo.surge["zone-1"] = tc.surge
+			}
+
+			quote, err := services.NewPricingService(repo).QuoteOrder(context.Background(), pricedOrder(tc.pkg))
+			if err != nil {
+				t.Fatalf("QuoteOrder() error = %v", err)
+			}
+
+			if quote.ZoneID != "zone-1" || quote.DistanceKm != distance || quote.BillableWeight != tc.billableWeight {
+				t.Errorf("quote zone %s, distance %f, billable weight %f, want zone-1, %f, %f", quote.ZoneID, quote.DistanceKm, quote.BillableWeight, distance, tc.billableWeight)
+			}
+			if len(quote.Items) != len(tc.items) {
+				t.Fatalf("items = %+v, want %v", quote.Items, tc.items)
+			}
+			for _, item := range quote.Items {
+				if want, ok := tc.items[item.Concept]; !ok || item.Amount != want {
+					t.Errorf("item %s = %f, want %f", item.Concept, item.Amount, want)
+				}
+			}
+			if quote.Total != tc.total {
+				t.Errorf("Total = %f, want %f", quote.Total, tc.total)
+			}
+		})
+	}
+}
+
+func TestPricingService_RejectsInvalidInput(t *testing.T) {
+	repo := newStubCompanyRepository(1, &entities.Zone{ID: "zone-1", BaseRate: 3, IsActive: true})
+	service := services.NewPricingService(repo)
+
+	order := pricedOrder(&entities.PackageDetail{Weight: 1})
+	order.DeliveryAddress.Latitude, order.DeliveryAddress.Longitude = 0, 0
+	if _, err := service.QuoteOrder(context.Background(), order); !errors.Is(err, errPackage.ErrInvalidDeliveryLocation) {
+		t.Errorf("QuoteOrder() error = %v, want ErrInvalidDeliveryLocation", err)
+	}
+
+	repo.zones["zone-1"].IsActive = false
+	if _, err := service.QuoteOrder(context.Background(), pricedOrder(&entities.PackageDetail{Weight: 1})); !errors.Is(err, errPackage.ErrZoneInactive) {
+		t.Errorf("QuoteOrder() error = %v, want ErrZoneInactive", err)
+	}
+}
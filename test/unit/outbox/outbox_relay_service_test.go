@@ -0,0 +1,356 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	os.Exit(m.Run())
+}
+
+// memoryOutboxRepository guarda los eventos del outbox en memoria con la misma semántica de reserva
+type memoryOutboxRepository struct {
+	mu     sync.Mutex
+	events map[string]*entities.OutboxEvent
+}
+
+func newMemoryOutboxRepository(events ...*entities.OutboxEvent) *memoryOutboxRepository {
+	repo := &memoryOutboxRepository{events: make(map[string]*entities.OutboxEvent)}
+	for _, event := range events {
+		repo.events[event.ID] = event
+	}
+	return repo
+}
+
+func (r *memoryOutboxRepository) GetDueEventIDs(_ context.Context, now time.Time, limit int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*entities.OutboxEvent
+	for _, event := range r.events {
+		if event.Status == constants.OutboxEventPending && !event.AvailableAt.After(now) {
+			due = append(due, event)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].CreatedAt.Before(due[j].CreatedAt) })
+
+	var ids []string
+	for _, event := range due {
+		if len(ids) == limit {
+			break
+		}
+		ids = append(ids, event.ID)
+	}
+	return ids, nil
+}
+
+func (r *memoryOutboxRepository) ClaimEvent(_ context.Context, id string, now, leaseUntil time.Time) (*entities.OutboxEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.events[id]
+	if !ok || event.Status != constants.OutboxEventPending || event.AvailableAt.After(now) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	event.AvailableAt = leaseUntil
+
+	claimed := *event
+	return &claimed, nil
+}
+
+func (r *memoryOutboxRepository) MarkPublished(_ context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := r.events[id]
+	event.Status = constants.OutboxEventPublished
+	event.PublishedAt = &at
+	event.LastError = ""
+	return nil
+}
+
+func (r *memoryOutboxRepository) RecordFailure(_ context.Context, failed *entities.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := r.events[failed.ID]
+	event.Status = failed.Status
+	event.Attempts = failed.Attempts
+	event.LastError = failed.LastError
+	event.AvailableAt = failed.AvailableAt
+	return nil
+}
+
+func (r *memoryOutboxRepository) get(id string) entities.OutboxEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.events[id]
+}
+
+// makeDue adelanta el siguiente intento para no esperar el retraso del reintento
+func (r *memoryOutboxRepository) makeDue(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events[id].AvailableAt = time.Now().Add(-time.Second)
+}
+
+// recordingPublisher guarda los eventos recibidos y falla mientras fail sea true
+type recordingPublisher struct {
+	mu     sync.Mutex
+	fail   bool
+	events []entities.OrderEvent
+}
+
+func (p *recordingPublisher) PublishOrderEvent(_ context.Context, event *entities.OrderEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, *event)
+	if p.fail {
+		return errors.New("receiver unavailable")
+	}
+	return nil
+}
+
+func (p *recordingPublisher) setFail(fail bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fail = fail
+}
+
+func (p *recordingPublisher) received() []entities.OrderEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]entities.OrderEvent(nil), p.events...)
+}
+
+func newPendingEvent(t *testing.T, id, eventType string) *entities.OutboxEvent {
+	t.Helper()
+
+	payload, err := json.Marshal(entities.OrderEvent{
+		ID:         id,
+		Type:       eventType,
+		CompanyID:  "company-1",
+		OccurredAt: time.Now(),
+		Data:       entities.OrderEventData{OrderID: "order-1", Status: constants.OrderStatusPending},
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+
+	return &entities.OutboxEvent{
+		ID:            id,
+		AggregateType: constants.OutboxAggregateOrder,
+		AggregateID:   "order-1",
+		EventType:     eventType,
+		Payload:       string(payload),
+		Status:        constants.OutboxEventPending,
+		AvailableAt:   time.Now().Add(-time.Second),
+		CreatedAt:     time.Now(),
+	}
+}
+
+func TestRelayPending_PublishesToEveryPublisher(t *testing.T) {
+	repo := newMemoryOutboxRepository(newPendingEvent(t, "event-1", constants.OrderEventCreated))
+	first, second := &recordingPublisher{}, &recordingPublisher{}
+	relay := services.NewOutboxRelayService(repo, 3, first, second)
+
+	published, err := relay.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("relay pending: %v", err)
+	}
+	if published != 1 {
+		t.Fatalf("expected 1 published event, got %d", published)
+	}
+
+	for _, publisher := range []*recordingPublisher{first, second} {
+		events := publisher.received()
+		if len(events) != 1 || events[0].ID != "event-1" || events[0].Type != constants.OrderEventCreated {
+			t.Fatalf("unexpected events received: %+v", events)
+		}
+	}
+
+	event := repo.get("event-1")
+	if event.Status != constants.OutboxEventPublished || event.PublishedAt == nil {
+		t.Fatalf("expected event to be published, got %+v", event)
+	}
+
+	// Un evento publicado no se vuelve a enviar
+	published, _ = relay.RelayPending(context.Background())
+	if published != 0 || len(first.received()) != 1 {
+		t.Fatalf("published event was relayed again")
+	}
+}
+
+func TestRelayPending_RetriesWithSameEventID(t *testing.T) {
+	repo := newMemoryOutboxRepository(newPendingEvent(t, "event-1", constants.OrderEventStatusChanged))
+	healthy, flaky := &recordingPublisher{}, &recordingPublisher{fail: true}
+	relay := services.NewOutboxRelayService(repo, 5, healthy, flaky)
+
+	published, err := relay.RelayPending(context.Background())
+	if err != nil || published != 0 {
+		t.Fatalf("expected failed relay, got published=%d err=%v", published, err)
+	}
+
+	event := repo.get("event-1")
+	if event.Status != constants.OutboxEventPending || event.Attempts != 1 || event.LastError == "" {
+		t.Fatalf("expected pending event with one failed attempt, got %+v", event)
+	}
+	if !event.AvailableAt.After(time.Now()) {
+		t.Fatalf("expected retry to be scheduled in the future, got %s", event.AvailableAt)
+	}
+
+	// El reintento aún no vence
+	if published, _ = relay.RelayPending(context.Background()); published != 0 {
+		t.Fatalf("event relayed before its retry was due")
+	}
+
+	flaky.setFail(false)
+	repo.makeDue("event-1")
+
+	if published, _ = relay.RelayPending(context.Background()); published != 1 {
+		t.Fatalf("expected event to be published on retry")
+	}
+
+	// Entrega al menos una vez: el publicador sano recibe el evento repetido con el mismo ID
+	events := healthy.received()
+	if len(events) != 2 || events[0].ID != events[1].ID {
+		t.Fatalf("expected the same event twice, got %+v", events)
+	}
+	if event = repo.get("event-1"); event.Status != constants.OutboxEventPublished || event.LastError != "" {
+		t.Fatalf("expected event to be published, got %+v", event)
+	}
+}
+
+func TestRelayPending_GivesUpAfterMaxAttempts(t *testing.T) {
+	repo := newMemoryOutboxRepository(newPendingEvent(t, "event-1", constants.OrderEventDelivered))
+	publisher := &recordingPublisher{fail: true}
+	relay := services.NewOutboxRelayService(repo, 2, publisher)
+
+	for i := 0; i < 3; i++ {
+		if _, err := relay.RelayPending(context.Background()); err != nil {
+			t.Fatalf("relay pending: %v", err)
+		}
+		if repo.get("event-1").Status == constants.OutboxEventPending {
+			repo.makeDue("event-1")
+		}
+	}
+
+	event := repo.get("event-1")
+	if event.Status != constants.OutboxEventFailed || event.Attempts != 2 {
+		t.Fatalf("expected failed event after 2 attempts, got %+v", event)
+	}
+	if len(publisher.received()) != 2 {
+		t.Fatalf("expected 2 delivery attempts, got %d", len(publisher.received()))
+	}
+}
+
+func TestRelayPending_InvalidPayloadIsRetried(t *testing.T) {
+	event := newPendingEvent(t, "event-1", constants.OrderEventCreated)
+	event.Payload = "{"
+	repo := newMemoryOutboxRepository(event)
+	publisher := &recordingPublisher{}
+	relay := services.NewOutboxRelayService(repo, 3, publisher)
+
+	if published, _ := relay.RelayPending(context.Background()); published != 0 {
+		t.Fatalf("invalid payload should not be published")
+	}
+	if len(publisher.received()) != 0 {
+		t.Fatalf("publisher should not receive invalid payloads")
+	}
+	if stored := repo.get("event-1"); stored.Attempts != 1 || stored.LastError == "" {
+		t.Fatalf("expected failed attempt to be recorded, got %+v", stored)
+	}
+}
+
+// stubOrderRepository guarda los eventos que el servicio entrega al repositorio
+type stubOrderRepository struct {
+	ports.OrdererRepository
+	order  *entities.Order
+	events []*entities.OutboxEvent
+	status string
+}
+
+func (r *stubOrderRepository) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	order := *r.order
+	return &order, nil
+}
+
+func (r *stubOrderRepository) ChangeStatus(_ context.Context, _ string, status string, events ...*entities.OutboxEvent) error {
+	r.status = status
+	r.events = append(r.events, events...)
+	return nil
+}
+
+// failingTracker falla la prueba si el servicio notifica sin pasar por el outbox
+type failingTracker struct {
+	t *testing.T
+}
+
+func (f failingTracker) SendOrderUpdate(orderID string, _ *websocket.OrderUpdateData) error {
+	f.t.Fatalf("order %s was notified directly instead of through the outbox", orderID)
+	return nil
+}
+
+func (f failingTracker) SendLocationUpdate(string, *websocket.LocationUpdateData) error {
+	return nil
+}
+
+func TestChangeStatus_WritesOutboxEventsWithTheChange(t *testing.T) {
+	repo := &stubOrderRepository{order: &entities.Order{
+		ID:             "order-1",
+		CompanyID:      "company-1",
+		TrackingNumber: "TRK-1",
+		Status:         constants.OrderStatusInTransit,
+	}}
+	service := services.NewOrderService(repo, failingTracker{t: t})
+
+	if err := service.ChangeStatus(context.Background(), "order-1", constants.OrderStatusDelivered); err != nil {
+		t.Fatalf("change status: %v", err)
+	}
+	if repo.status != constants.OrderStatusDelivered {
+		t.Fatalf("expected status to be changed, got %q", repo.status)
+	}
+	if len(repo.events) != 2 {
+		t.Fatalf("expected 2 outbox events, got %d", len(repo.events))
+	}
+
+	expectedTypes := []string{constants.OrderEventStatusChanged, constants.OrderEventDelivered}
+	for i, record := range repo.events {
+		if record.EventType != expectedTypes[i] || record.Status != constants.OutboxEventPending || record.AggregateID != "order-1" {
+			t.Fatalf("unexpected outbox record %d: %+v", i, record)
+		}
+
+		var event entities.OrderEvent
+		if err := json.Unmarshal([]byte(record.Payload), &event); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if event.ID != record.ID || event.CompanyID != "company-1" || event.Data.Status != constants.OrderStatusDelivered {
+			t.Fatalf("unexpected event payload: %+v", event)
+		}
+	}
+
+	var statusEvent entities.OrderEvent
+	_ = json.Unmarshal([]byte(repo.events[0].Payload), &statusEvent)
+	if statusEvent.Data.PreviousStatus != constants.OrderStatusInTransit {
+		t.Fatalf("expected previous status %s, got %q", constants.OrderStatusInTransit, statusEvent.Data.PreviousStatus)
+	}
+}
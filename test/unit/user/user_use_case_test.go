@@ -0,0 +1,143 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/gorm"
+
+	appPorts "github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/user"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// memoryRoleRepository retorna los roles registrados en la tabla de roles
+type memoryRoleRepository struct {
+	ports.RolerRepository
+	roles map[string]*entities.Role
+}
+
+func (r *memoryRoleRepository) GetRoleByIDOrName(_ context.Context, param string) (*entities.Role, error) {
+	for _, role := range r.roles {
+		if role.ID == param || role.Name == param {
+			return role, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryRoleRepository) IsRoleActive(ctx context.Context, param string) (bool, error) {
+	role, err := r.GetRoleByIDOrName(ctx, param)
+	if err != nil {
+		return false, err
+	}
+	return role.IsActive, nil
+}
+
+// recordingUserService registra los usuarios creados y los roles asignados
+type recordingUserService struct {
+	interfaces.Userer
+	created  []*entities.User
+	assigned []string
+}
+
+func (s *recordingUserService) CreateUser(_ context.Context, user *entities.User) error {
+	s.created = append(s.created, user)
+	return nil
+}
+
+func (s *recordingUserService) AssignRoleToUser(_ context.Context, _, roleID, _ string) error {
+	s.assigned = append(s.assigned, roleID)
+	return nil
+}
+
+// stubCompanyService resuelve la empresa del usuario autenticado
+type stubCompanyService struct {
+	interfaces.Companyrer
+}
+
+func (s *stubCompanyService) GetCompanyAndBranchForUser(_ context.Context, _ string) (string, string, error) {
+	return "company-1", "branch-1", nil
+}
+
+func newUserUseCase(users *recordingUserService) appPorts.UserUseCase {
+	roles := services.NewRoleService(&memoryRoleRepository{roles: map[string]*entities.Role{
+		"role-1": {ID: "role-1", Name: "COMPANY_USER", IsActive: true},
+		"role-2": {ID: "role-2", Name: "DISPATCHER", IsActive: true},
+		"role-3": {ID: "role-3", Name: "AUDITOR", IsActive: false},
+	}})
+
+	return user.NewUserProfileUseCase(users, roles, &stubCompanyService{}, nil)
+}
+
+func newUserWithRoles(names ...string) *entities.User {
+	var roles []entities.UserRole
+	for _, name := range names {
+		roles = append(roles, entities.UserRole{Role: &entities.Role{Name: name}})
+	}
+	return &entities.User{ID: "user-1", Email: "user@example.com", Roles: roles}
+}
+
+func TestUserDTO_AcceptsRolesOutsideTheBuiltInList(t *testing.T) {
+	req := &dto.UserDTO{
+		Email:    "user@example.com",
+		FullName: "John Doe",
+		Phone:    "21212828",
+		Password: "password",
+		Roles:    []string{"dispatcher"},
+		Profile: &dto.UserProfileDTO{
+			DocumentType:          "DNI",
+			DocumentNumber:        "12345678",
+			BirthDate:             "01-01-1990",
+			EmergencyContactName:  "Jane Doe",
+			EmergencyContactPhone: "21212829",
+		},
+	}
+
+	if err := req.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+}
+
+func TestCreateUser_ValidatesRolesAgainstRolesTable(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "claims", &auth.AuthClaims{UserID: "admin-1"})
+
+	users := &recordingUserService{}
+	if err := newUserUseCase(users).CreateUser(ctx, newUserWithRoles("dispatcher", "COMPANY_USER")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if len(users.created) != 1 || len(users.assigned) != 2 || users.assigned[0] != "role-2" {
+		t.Errorf("created = %d users, assigned roles = %v, want 1 user with [role-2 role-1]", len(users.created), users.assigned)
+	}
+
+	cases := []struct {
+		name string
+		role string
+		want error
+	}{
+		{"role missing from the table", "SUPERVISOR", errPackage.ErrRoleNotFound},
+		{"inactive role", "AUDITOR", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			users := &recordingUserService{}
+			err := newUserUseCase(users).CreateUser(ctx, newUserWithRoles("COMPANY_USER", tc.role))
+			if err == nil {
+				t.Fatalf("CreateUser() succeeded, want an error")
+			}
+			if tc.want != nil && !errors.Is(err, tc.want) {
+				t.Errorf("CreateUser() error = %v, want %v", err, tc.want)
+			}
+			if len(users.created) != 0 || len(users.assigned) != 0 {
+				t.Errorf("user was created with an invalid role")
+			}
+		})
+	}
+}
@@ -0,0 +1,557 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/webhook"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	os.Exit(m.Run())
+}
+
+// memoryWebhookRepository guarda las suscripciones y entregas en memoria, los envíos se hacen en goroutines
+type memoryWebhookRepository struct {
+	mu            sync.Mutex
+	subscriptions map[string]*entities.WebhookSubscription
+	deliveries    map[string]*entities.WebhookDelivery
+	attempts      map[string][]entities.WebhookDeliveryAttempt
+}
+
+func newMemoryWebhookRepository() *memoryWebhookRepository {
+	return &memoryWebhookRepository{
+		subscriptions: map[string]*entities.WebhookSubscription{},
+		deliveries:    map[string]*entities.WebhookDelivery{},
+		attempts:      map[string][]entities.WebhookDeliveryAttempt{},
+	}
+}
+
+func (r *memoryWebhookRepository) CreateSubscription(_ context.Context, subscription *entities.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	subscription.CreatedAt = time.Now()
+	r.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (r *memoryWebhookRepository) GetSubscriptionByID(_ context.Context, id string) (*entities.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return subscription, nil
+}
+
+func (r *memoryWebhookRepository) ListSubscriptionsByCompany(_ context.Context, companyID string) ([]entities.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var subscriptions []entities.WebhookSubscription
+	for _, subscription := range r.subscriptions {
+		if subscription.CompanyID == companyID {
+			subscriptions = append(subscriptions, *subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+func (r *memoryWebhookRepository) GetActiveSubscriptions(ctx context.Context, companyID string) ([]entities.WebhookSubscription, error) {
+	subscriptions, _ := r.ListSubscriptionsByCompany(ctx, companyID)
+	active := subscriptions[:0]
+	for _, subscription := range subscriptions {
+		if subscription.IsActive {
+			active = append(active, subscription)
+		}
+	}
+	return active, nil
+}
+
+func (r *memoryWebhookRepository) DeleteSubscription(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.subscriptions[id]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(r.subscriptions, id)
+	return nil
+}
+
+func (r *memoryWebhookRepository) CreateDeliveries(_ context.Context, deliveries []entities.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, delivery := range deliveries {
+		delivery.CreatedAt = time.Now()
+		r.deliveries[delivery.ID] = &delivery
+	}
+	return nil
+}
+
+func (r *memoryWebhookRepository) GetSubscriptionIDsForEvent(_ context.Context, eventID string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for _, delivery := range r.deliveries {
+		if delivery.EventID == eventID && delivery.ReplayOf == nil {
+			ids = append(ids, delivery.SubscriptionID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memoryWebhookRepository) GetDeliveryByID(_ context.Context, id string) (*entities.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	result := *delivery
+	result.AttemptLog = append([]entities.WebhookDeliveryAttempt(nil), r.attempts[id]...)
+	return &result, nil
+}
+
+func (r *memoryWebhookRepository) ListDeliveriesBySubscription(_ context.Context, subscriptionID string, limit int) ([]entities.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deliveries []entities.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, *delivery)
+		}
+	}
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (r *memoryWebhookRepository) GetDueDeliveryIDs(_ context.Context, now time.Time, limit int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for id, delivery := range r.deliveries {
+		if delivery.Status == constants.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) && len(ids) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memoryWebhookRepository) ClaimDelivery(_ context.Context, id string, now, leaseUntil time.Time) (*entities.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delivery, ok := r.deliveries[id]
+	if !ok || delivery.Status != constants.WebhookDeliveryPending || delivery.NextAttemptAt.After(now) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	delivery.NextAttemptAt = leaseUntil
+
+	claimed := *delivery
+	claimed.Subscription = r.subscriptions[delivery.SubscriptionID]
+	return &claimed, nil
+}
+
+func (r *memoryWebhookRepository) RecordAttempt(_ context.Context, delivery *entities.WebhookDelivery, attempt *entities.WebhookDeliveryAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := r.deliveries[delivery.ID]
+	stored.Status = delivery.Status
+	stored.Attempts = delivery.Attempts
+	stored.LastStatusCode = delivery.LastStatusCode
+	stored.LastError = delivery.LastError
+	stored.NextAttemptAt = delivery.NextAttemptAt
+	stored.DeliveredAt = delivery.DeliveredAt
+	r.attempts[delivery.ID] = append(r.attempts[delivery.ID], *attempt)
+	return nil
+}
+
+func (r *memoryWebhookRepository) deliveryIDs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]string, 0, len(r.deliveries))
+	for id := range r.deliveries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// receivedRequest es una petición recibida por el receptor de prueba
+type receivedRequest struct {
+	eventID    string
+	deliveryID string
+	validSig   bool
+	event      entities.OrderEvent
+}
+
+// receiver levanta un endpoint que verifica la firma y responde con los códigos indicados en orden
+type receiver struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	received []receivedRequest
+	secret   string
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	rec := &receiver{statuses: statuses}
+	rec.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+
+		req := receivedRequest{
+			eventID:    r.Header.Get(webhook.EventIDHeader),
+			deliveryID: r.Header.Get(webhook.DeliveryIDHeader),
+			validSig:   webhook.VerifySignature(rec.secret, r.Header.Get(webhook.SignatureHeader), body, 5*time.Minute, time.Now()),
+		}
+		_ = json.Unmarshal(body, &req.event)
+		rec.received = append(rec.received, req)
+
+		status := http.StatusOK
+		if len(rec.statuses) > 0 {
+			status, rec.statuses = rec.statuses[0], rec.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(rec.server.Close)
+	return rec
+}
+
+func (r *receiver) requests() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.received...)
+}
+
+// eventually espera a que la condición se cumpla, los envíos inmediatos son asíncronos
+func eventually(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func subscribe(t *testing.T, service webhook.WebhookService, rec *receiver, events ...string) *entities.WebhookSubscription {
+	t.Helper()
+	issued, err := service.CreateSubscription(context.Background(), &entities.WebhookSubscriptionInput{
+		CompanyID: "company-1",
+		URL:       rec.server.URL,
+		Events:    events,
+		CreatedBy: "user-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	rec.mu.Lock()
+	rec.secret = issued.Secret
+	rec.mu.Unlock()
+	return issued.Subscription
+}
+
+func orderEvent(eventType string) *entities.OrderEvent {
+	return &entities.OrderEvent{
+		ID:         "event-" + eventType,
+		Type:       eventType,
+		CompanyID:  "company-1",
+		OccurredAt: time.Now(),
+		Data:       entities.OrderEventData{OrderID: "order-1", TrackingNumber: "TRK-1", Status: constants.OrderStatusDelivered},
+	}
+}
+
+func TestWebhookDeliveryIsSignedAndRetried(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	service := webhook.NewWebhookService(repo, nil, 5, 10*time.Millisecond, true)
+	rec := newReceiver(t, http.StatusInternalServerError, http.StatusOK)
+	ctx := context.Background()
+
+	subscription := subscribe(t, service, rec, constants.OrderEventDelivered)
+
+	// 1. Los eventos a los que no está suscrito no generan entregas
+	if err := service.PublishOrderEvent(ctx, orderEvent(constants.OrderEventCreated)); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+	if ids := repo.deliveryIDs(); len(ids) != 0 {
+		t.Fatalf("got %d deliveries for an unsubscribed event", len(ids))
+	}
+
+	// 2. El primer envío falla con 500 y queda pendiente de reintento
+	if err := service.PublishOrderEvent(ctx, orderEvent(constants.OrderEventDelivered)); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+	eventually(t, func() bool { return len(rec.requests()) == 1 })
+
+	ids := repo.deliveryIDs()
+	if len(ids) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(ids))
+	}
+	eventually(t, func() bool {
+		delivery, _ := service.GetDelivery(ctx, ids[0])
+		return delivery.Attempts == 1
+	})
+	delivery, _ := service.GetDelivery(ctx, ids[0])
+	if delivery.Status != constants.WebhookDeliveryPending || delivery.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("after a failed attempt got status %s code %d", delivery.Status, delivery.LastStatusCode)
+	}
+
+	// 3. El worker reintenta cuando vence la espera y la entrega se completa
+	eventually(t, func() bool {
+		_, _ = service.ProcessDueDeliveries(ctx)
+		delivery, _ = service.GetDelivery(ctx, ids[0])
+		return delivery.Status == constants.WebhookDeliverySucceeded
+	})
+
+	if len(delivery.AttemptLog) != 2 || delivery.AttemptLog[0].StatusCode != 500 || delivery.AttemptLog[1].StatusCode != 200 {
+		t.Errorf("unexpected attempt log: %+v", delivery.AttemptLog)
+	}
+	if delivery.DeliveredAt == nil || delivery.SubscriptionID != subscription.ID {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	}
+
+	for _, req := range rec.requests() {
+		if !req.validSig || req.eventID != "event-order.delivered" || req.deliveryID != ids[0] || req.event.Data.OrderID != "order-1" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+	}
+}
+
+func TestWebhookReplayKeepsEventID(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	service := webhook.NewWebhookService(repo, nil, 5, time.Minute, true)
+	rec := newReceiver(t)
+	ctx := context.Background()
+
+	subscribe(t, service, rec, constants.OrderEventDelivered, constants.OrderEventCreated)
+	if err := service.PublishOrderEvent(ctx, orderEvent(constants.OrderEventDelivered)); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+	eventually(t, func() bool { return len(rec.requests()) == 1 })
+	original := repo.deliveryIDs()[0]
+
+	replay, err := service.ReplayDelivery(ctx, original)
+	if err != nil {
+		t.Fatalf("ReplayDelivery() error = %v", err)
+	}
+	if replay.ReplayOf == nil || *replay.ReplayOf != original || replay.ID == original {
+		t.Errorf("unexpected replay: %+v", replay)
+	}
+
+	eventually(t, func() bool { return len(rec.requests()) == 2 })
+	requests := rec.requests()
+	if requests[0].eventID != requests[1].eventID || requests[1].deliveryID != replay.ID || !requests[1].validSig {
+		t.Errorf("replay must keep the event ID: %+v", requests)
+	}
+
+	if _, err := service.ReplayDelivery(ctx, "missing"); !errors.Is(err, errPackage.ErrWebhookDeliveryNotFound) {
+		t.Errorf("ReplayDelivery(missing) error = %v, want ErrWebhookDeliveryNotFound", err)
+	}
+}
+
+func TestWebhookGivesUpAfterMaxAttempts(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	service := webhook.NewWebhookService(repo, nil, 2, time.Millisecond, true)
+	rec := newReceiver(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK)
+	ctx := context.Background()
+
+	subscribe(t, service, rec, constants.OrderEventDelivered)
+	if err := service.PublishOrderEvent(ctx, orderEvent(constants.OrderEventDelivered)); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+
+	id := ""
+	eventually(t, func() bool {
+		_, _ = service.ProcessDueDeliveries(ctx)
+		if ids := repo.deliveryIDs(); len(ids) == 1 {
+			id = ids[0]
+			delivery, _ := service.GetDelivery(ctx, id)
+			return delivery.Status == constants.WebhookDeliveryFailed
+		}
+		return false
+	})
+
+	delivery, _ := service.GetDelivery(ctx, id)
+	if delivery.Attempts != 2 || len(delivery.AttemptLog) != 2 || delivery.LastError == "" {
+		t.Errorf("unexpected failed delivery: %+v", delivery)
+	}
+	if sent, _ := service.ProcessDueDeliveries(ctx); sent != 0 || len(rec.requests()) != 2 {
+		t.Errorf("a failed delivery must not be retried, sent %d, received %d", sent, len(rec.requests()))
+	}
+}
+
+func TestWebhookSubscriptionValidation(t *testing.T) {
+	service := webhook.NewWebhookService(newMemoryWebhookRepository(), nil, 0, 0, true)
+
+	tests := []struct {
+		name  string
+		input entities.WebhookSubscriptionInput
+		want  error
+	}{
+		{"relative url", entities.WebhookSubscriptionInput{URL: "/hooks", Events: []string{constants.OrderEventCreated}}, errPackage.ErrInvalidWebhookURL},
+		{"unsupported scheme", entities.WebhookSubscriptionInput{URL: "ftp://example.com", Events: []string{constants.OrderEventCreated}}, errPackage.ErrInvalidWebhookURL},
+		{"no events", entities.WebhookSubscriptionInput{URL: "https://example.com"}, errPackage.ErrInvalidWebhookEvents},
+		{"unknown event", entities.WebhookSubscriptionInput{URL: "https://example.com", Events: []string{"order.cancelled"}}, errPackage.ErrInvalidWebhookEvents},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			if _, err := service.CreateSubscription(context.Background(), &input); !errors.Is(err, tt.want) {
+				t.Errorf("CreateSubscription() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookRejectsInternalURLs(t *testing.T) {
+	service := webhook.NewWebhookService(newMemoryWebhookRepository(), nil, 0, 0, false)
+
+	urls := []string{
+		"http://localhost:8080/hooks",
+		"http://api.localhost/hooks",
+		"http://127.0.0.1/hooks",
+		"http://10.0.0.5/hooks",
+		"http://172.16.5.4/hooks",
+		"http://192.168.1.1/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0/hooks",
+		"http://[::1]/hooks",
+		"http://[fe80::1]/hooks",
+		"http://[fd00::1]/hooks",
+	}
+	for _, rawURL := range urls {
+		t.Run(rawURL, func(t *testing.T) {
+			_, err := service.CreateSubscription(context.Background(), &entities.WebhookSubscriptionInput{
+				CompanyID: "company-1",
+				URL:       rawURL,
+				Events:    []string{constants.OrderEventCreated},
+			})
+			if !errors.Is(err, errPackage.ErrWebhookURLNotAllowed) {
+				t.Errorf("CreateSubscription() error = %v, want %v", err, errPackage.ErrWebhookURLNotAllowed)
+			}
+		})
+	}
+
+	if _, err := service.CreateSubscription(context.Background(), &entities.WebhookSubscriptionInput{
+		CompanyID: "company-1",
+		URL:       "https://93.184.216.34/hooks",
+		Events:    []string{constants.OrderEventCreated},
+	}); err != nil {
+		t.Errorf("CreateSubscription() rejected a public address: %v", err)
+	}
+}
+
+func TestWebhookConnectionGuardBlocksInternalAddresses(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	service := webhook.NewWebhookService(repo, nil, 1, time.Millisecond, false)
+	rec := newReceiver(t)
+	ctx := context.Background()
+
+	// La suscripción se guarda directamente, como si el DNS hubiese cambiado después de crearla
+	_ = repo.CreateSubscription(ctx, &entities.WebhookSubscription{
+		ID:        "subscription-1",
+		CompanyID: "company-1",
+		URL:       rec.server.URL,
+		Secret:    "whsec_test",
+		Events:    constants.OrderEventDelivered,
+		IsActive:  true,
+	})
+	if err := service.PublishOrderEvent(ctx, orderEvent(constants.OrderEventDelivered)); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+
+	var delivery *entities.WebhookDelivery
+	eventually(t, func() bool {
+		if ids := repo.deliveryIDs(); len(ids) == 1 {
+			delivery, _ = service.GetDelivery(ctx, ids[0])
+			return delivery.Status == constants.WebhookDeliveryFailed
+		}
+		return false
+	})
+
+	if len(rec.requests()) != 0 {
+		t.Errorf("receiver on a loopback address got %d requests", len(rec.requests()))
+	}
+	if delivery.LastStatusCode != 0 || !strings.Contains(delivery.LastError, errPackage.ErrWebhookURLNotAllowed.Error()) {
+		t.Errorf("unexpected delivery: status %d, error %q", delivery.LastStatusCode, delivery.LastError)
+	}
+}
+
+func TestWebhookDoesNotFollowRedirects(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	service := webhook.NewWebhookService(repo, nil, 1, time.Millisecond, true)
+	target := newReceiver(t)
+	redirect := httptest.NewServer(http.RedirectHandler(target.server.URL, http.StatusFound))
+	t.Cleanup(redirect.Close)
+	ctx := context.Background()
+
+	if _, err := service.CreateSubscription(ctx, &entities.WebhookSubscriptionInput{
+		CompanyID: "company-1",
+		URL:       redirect.URL,
+		Events:    []string{constants.OrderEventDelivered},
+	}); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	if err := service.PublishOrderEvent(ctx, orderEvent(constants.OrderEventDelivered)); err != nil {
+		t.Fatalf("PublishOrderEvent() error = %v", err)
+	}
+
+	var delivery *entities.WebhookDelivery
+	eventually(t, func() bool {
+		if ids := repo.deliveryIDs(); len(ids) == 1 {
+			delivery, _ = service.GetDelivery(ctx, ids[0])
+			return delivery.Status == constants.WebhookDeliveryFailed
+		}
+		return false
+	})
+
+	if delivery.LastStatusCode != http.StatusFound {
+		t.Errorf("last status code = %d, want %d", delivery.LastStatusCode, http.StatusFound)
+	}
+	if len(target.requests()) != 0 {
+		t.Errorf("redirect target got %d requests", len(target.requests()))
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"type":"order.delivered"}`)
+	now := time.Now()
+	header := webhook.Sign("whsec_test", now, body)
+
+	if !webhook.VerifySignature("whsec_test", header, body, time.Minute, now) {
+		t.Error("VerifySignature() rejected a valid signature")
+	}
+	if webhook.VerifySignature("whsec_other", header, body, time.Minute, now) {
+		t.Error("VerifySignature() accepted another secret")
+	}
+	if webhook.VerifySignature("whsec_test", header, []byte(`{"type":"order.created"}`), time.Minute, now) {
+		t.Error("VerifySignature() accepted a modified body")
+	}
+	if webhook.VerifySignature("whsec_test", header, body, time.Minute, now.Add(2*time.Minute)) {
+		t.Error("VerifySignature() accepted an expired signature")
+	}
+}
@@ -0,0 +1,189 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	wsModels "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/websocket"
+	wsInfra "github.com/MarlonG1/delivery-backend/internal/infrastructure/websocket"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	ws "github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// allowOrderAuthorizer permite suscribirse únicamente al pedido indicado
+type allowOrderAuthorizer struct {
+	orderID string
+}
+
+func (a allowOrderAuthorizer) AuthorizeOrderAccess(_ context.Context, _ *auth.AuthClaims, orderID string) (*entities.Order, error) {
+	if orderID != a.orderID {
+		return nil, errors.New("access denied")
+	}
+	return &entities.Order{ID: orderID}, nil
+}
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+
+	os.Exit(m.Run())
+}
+
+func TestHubFanOutThroughBroker(t *testing.T) {
+	// Dos réplicas del hub compartiendo el mismo broker
+	broker := wsInfra.NewInMemoryBroker()
+	publisher := wsInfra.NewHub()
+	subscriber := wsInfra.NewHub()
+	for _, hub := range []*wsInfra.Hub{publisher, subscriber} {
+		go hub.Run()
+		if err := hub.UseBroker(broker); err != nil {
+			t.Fatalf("UseBroker() error = %v", err)
+		}
+	}
+	subscriber.SetSubscriptionAuthorizer(allowOrderAuthorizer{orderID: "order-1"})
+
+	conn := dialClient(t, subscriber)
+
+	t.Run("rejects subscription to a foreign order", func(t *testing.T) {
+		sendSubscribe(t, conn, "order-2")
+
+		msg := readMessage(t, conn)
+		if msg.Type != wsModels.ServerError {
+			t.Fatalf("message type = %s, want %s", msg.Type, wsModels.ServerError)
+		}
+
+		data, _ := json.Marshal(msg.Data)
+		var errData wsModels.ErrorData
+		_ = json.Unmarshal(data, &errData)
+		if errData.Code != wsModels.ErrorCodeSubscriptionDenied {
+			t.Fatalf("error code = %s, want %s", errData.Code, wsModels.ErrorCodeSubscriptionDenied)
+		}
+		if subscriber.SubscriberCount("order-2") != 0 {
+			t.Fatal("rejected client was registered as subscriber")
+		}
+	})
+
+	t.Run("delivers updates published on another replica", func(t *testing.T) {
+		sendSubscribe(t, conn, "order-1")
+		waitForSubscribers(t, subscriber, "order-1", 1)
+
+		publisher.SendLocationUpdate("order-1", &wsModels.LocationUpdateData{
+			Latitude:  13.6929,
+			Longitude: -89.2182,
+			UpdatedAt: time.Now(),
+		})
+
+		msg := readMessage(t, conn)
+		if msg.Type != wsModels.ServerLocation || msg.OrderID != "order-1" {
+			t.Fatalf("message = %s/%s, want %s/order-1", msg.Type, msg.OrderID, wsModels.ServerLocation)
+		}
+	})
+}
+
+func TestHubDeliversNotificationsToUserConnections(t *testing.T) {
+	broker := wsInfra.NewInMemoryBroker()
+	publisher := wsInfra.NewHub()
+	subscriber := wsInfra.NewHub()
+	for _, hub := range []*wsInfra.Hub{publisher, subscriber} {
+		go hub.Run()
+		if err := hub.UseBroker(broker); err != nil {
+			t.Fatalf("UseBroker() error = %v", err)
+		}
+	}
+
+	// La notificación llega sin suscribirse a ningún pedido
+	conn := dialClient(t, subscriber)
+	deadline := time.Now().Add(2 * time.Second)
+	for subscriber.ClientCount("user-1") != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("client was not registered in the hub")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	publisher.SendNotification("user-2", &wsModels.NotificationData{ID: "notification-0", UnreadCount: 1})
+	publisher.SendNotification("user-1", &wsModels.NotificationData{
+		ID:          "notification-1",
+		Type:        "ORDER_STATUS_CHANGED",
+		Title:       "Pedido TRK-1",
+		CreatedAt:   time.Now(),
+		UnreadCount: 3,
+	})
+
+	msg := readMessage(t, conn)
+	if msg.Type != wsModels.ServerNotification {
+		t.Fatalf("message type = %s, want %s", msg.Type, wsModels.ServerNotification)
+	}
+
+	data, _ := json.Marshal(msg.Data)
+	var notification wsModels.NotificationData
+	_ = json.Unmarshal(data, &notification)
+	if notification.ID != "notification-1" || notification.UnreadCount != 3 {
+		t.Fatalf("notification = %+v, want notification-1 with 3 unread", notification)
+	}
+}
+
+// dialClient levanta un servidor que registra las conexiones entrantes en el hub y se conecta a él
+func dialClient(t *testing.T, hub *wsInfra.Hub) *ws.Conn {
+	t.Helper()
+
+	upgrader := ws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		wsInfra.NewClient(hub, conn, &auth.AuthClaims{UserID: "user-1"}).Start()
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := ws.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func sendSubscribe(t *testing.T, conn *ws.Conn, orderID string) {
+	t.Helper()
+
+	if err := conn.WriteJSON(wsModels.Message{Type: wsModels.ClientSubscribe, OrderID: orderID}); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+}
+
+func readMessage(t *testing.T, conn *ws.Conn) wsModels.Message {
+	t.Helper()
+
+	var msg wsModels.Message
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatalf("ReadJSON() error = %v", err)
+	}
+	return msg
+}
+
+func waitForSubscribers(t *testing.T, hub *wsInfra.Hub, orderID string, want int) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for hub.SubscriberCount(orderID) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("SubscriberCount(%s) = %d, want %d", orderID, hub.SubscriberCount(orderID), want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,64 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	wsInfra "github.com/MarlonG1/delivery-backend/internal/infrastructure/websocket"
+)
+
+// memoryEventRecorder guarda los eventos que registra el hub
+type memoryEventRecorder struct {
+	mu     sync.Mutex
+	events []*entities.SystemEventInput
+}
+
+func (r *memoryEventRecorder) Record(_ context.Context, input *entities.SystemEventInput) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, input)
+	return "event-1"
+}
+
+func (r *memoryEventRecorder) recorded() []*entities.SystemEventInput {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*entities.SystemEventInput(nil), r.events...)
+}
+
+func TestHubRecordsDisconnectStormOncePerWindow(t *testing.T) {
+	recorder := &memoryEventRecorder{}
+	hub := wsInfra.NewHub()
+	hub.SetEventRecorder(recorder, 3, time.Minute)
+	go hub.Run()
+
+	// Cinco clientes se desconectan dentro de la misma ventana, el umbral se alcanza con el tercero
+	for i := 0; i < 5; i++ {
+		conn := dialClient(t, hub)
+		sendSubscribe(t, conn, "order-1")
+		readMessage(t, conn) // la suscripción se rechaza porque el hub no tiene autorizador
+		conn.Close()
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(recorder.recorded()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("disconnect storm was not recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	events := recorder.recorded()
+	if len(events) != 1 {
+		t.Fatalf("recorded %d events, want 1 per window", len(events))
+	}
+	event := events[0]
+	if event.EventType != constants.SystemEventWebSocketDisconnectStorm || event.Source != constants.SystemEventSourceWebSocket || event.Data["disconnects"] != 3 {
+		t.Errorf("unexpected disconnect storm event %+v", event)
+	}
+}
@@ -0,0 +1,127 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/spatial"
+)
+
+func TestMain(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+func addZone(repo *memoryZoneRepository, id string, priority int, boundaries string) {
+	repo.zones[id] = &entities.Zone{ID: id, Code: id, PriorityLevel: priority, Boundaries: boundaries, IsActive: true}
+}
+
+func TestRTree_SearchMatchesBruteForce(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	entries := make([]spatial.Entry[int], 500)
+	for i := range entries {
+		x, y := random.Float64()*100, random.Float64()*100
+		entries[i] = spatial.Entry[int]{
+			Bounds: spatial.Rect{MinX: x, MinY: y, MaxX: x + random.Float64()*10, MaxY: y + random.Float64()*10},
+			Value:  i,
+		}
+	}
+	tree := spatial.NewRTree(entries)
+	if tree.Len() != len(entries) {
+		t.Fatalf("Len() = %d, want %d", tree.Len(), len(entries))
+	}
+
+	for i := 0; i < 200; i++ {
+		x, y := random.Float64()*110, random.Float64()*110
+
+		var want []int
+		for _, entry := range entries {
+			if entry.Bounds.Contains(x, y) {
+				want = append(want, entry.Value)
+			}
+		}
+		got := tree.Search(x, y)
+		sort.Ints(got)
+
+		if len(got) != len(want) {
+			t.Fatalf("Search(%f, %f) returned %d entries, want %d", x, y, len(got), len(want))
+		}
+		for j := range want {
+			if got[j] != want[j] {
+				t.Fatalf("Search(%f, %f) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestResolveZone_UsesCoverageAreaAndPriority(t *testing.T) {
+	repo := newMemoryZoneRepository()
+	// La zona amplia tiene una cobertura menor que sus límites, la zona centro se superpone con más prioridad
+	addZone(repo, "wide", 1, "POLYGON((-74.10 4.60, -74.00 4.60, -74.00 4.70, -74.10 4.70, -74.10 4.60))")
+	repo.coverage["wide"] = "POLYGON((-74.10 4.60, -74.05 4.60, -74.05 4.70, -74.10 4.70, -74.10 4.60))"
+	addZone(repo, "center", 2, "POLYGON((-74.08 4.62, -74.06 4.62, -74.06 4.64, -74.08 4.64, -74.08 4.62))")
+	resolver := services.NewZoneLookupService(repo, 0)
+	ctx := context.Background()
+
+	cases := map[string][2]float64{
+		"wide":   {4.68, -74.09},
+		"center": {4.63, -74.07},
+	}
+	for want, point := range cases {
+		got, err := resolver.ResolveZone(ctx, point[0], point[1])
+		if err != nil || got != want {
+			t.Errorf("ResolveZone(%v) = %q, %v, want %q", point, got, err, want)
+		}
+	}
+
+	// Dentro de los límites de la zona amplia pero fuera de su cobertura
+	_, err := resolver.ResolveZone(ctx, 4.65, -74.02)
+	if !errors.Is(err, errPackage.ErrAddressOutOfCoverage) {
+		t.Errorf("ResolveZone() error = %v, want ErrAddressOutOfCoverage", err)
+	}
+	if repo.coverageLoads != 1 {
+		t.Errorf("index loaded %d times, want 1", repo.coverageLoads)
+	}
+}
+
+func TestResolveZone_RebuildsIndexWhenZonesChange(t *testing.T) {
+	repo := newMemoryZoneRepository()
+	resolver := services.NewZoneLookupService(repo, 0)
+	service := services.NewZoneService(repo, resolver)
+	ctx := context.Background()
+
+	if _, err := resolver.ResolveZone(ctx, 4.71, -74.02); !errors.Is(err, errPackage.ErrAddressOutOfCoverage) {
+		t.Fatalf("ResolveZone() error = %v, want ErrAddressOutOfCoverage", err)
+	}
+
+	zone, err := service.CreateZone(ctx, zoneInput(squareGeoJSON))
+	if err != nil {
+		t.Fatalf("CreateZone() error = %v", err)
+	}
+	got, err := resolver.ResolveZone(ctx, 4.71, -74.02)
+	if err != nil || got != zone.ID {
+		t.Fatalf("ResolveZone() = %q, %v, want %q", got, err, zone.ID)
+	}
+
+	if err = service.DeleteZone(ctx, zone.ID); err != nil {
+		t.Fatalf("DeleteZone() error = %v", err)
+	}
+	if _, err = resolver.ResolveZone(ctx, 4.71, -74.02); !errors.Is(err, errPackage.ErrAddressOutOfCoverage) {
+		t.Errorf("ResolveZone() after delete error = %v, want ErrAddressOutOfCoverage", err)
+	}
+	if repo.coverageLoads != 3 {
+		t.Errorf("index loaded %d times, want 3", repo.coverageLoads)
+	}
+}
@@ -0,0 +1,216 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+)
+
+const squareGeoJSON = `{"type":"Polygon","coordinates":[[[-74.03,4.70],[-74.01,4.70],[-74.01,4.72],[-74.03,4.72],[-74.03,4.70]]]}`
+
+// memoryZoneRepository guarda las zonas y su cobertura en memoria
+type memoryZoneRepository struct {
+	zones         map[string]*entities.Zone
+	coverage      map[string]string
+	coverageLoads int
+}
+
+func newMemoryZoneRepository() *memoryZoneRepository {
+	return &memoryZoneRepository{zones: make(map[string]*entities.Zone), coverage: make(map[string]string)}
+}
+
+func newZoneService() interfaces.ZoneManager {
+	repo := newMemoryZoneRepository()
+	return services.NewZoneService(repo, services.NewZoneLookupService(repo, 0))
+}
+
+func (r *memoryZoneRepository) Create(_ context.Context, zone *entities.Zone) error {
+	stored := *zone
+	r.zones[zone.ID] = &stored
+	return nil
+}
+
+func (r *memoryZoneRepository) Update(_ context.Context, zone *entities.Zone) error {
+	stored := *zone
+	r.zones[zone.ID] = &stored
+	return nil
+}
+
+func (r *memoryZoneRepository) Deactivate(_ context.Context, id string) error {
+	r.zones[id].IsActive = false
+	return nil
+}
+
+func (r *memoryZoneRepository) GetByID(_ context.Context, id string) (*entities.Zone, error) {
+	zone, ok := r.zones[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := *zone
+	return &stored, nil
+}
+
+func (r *memoryZoneRepository) GetZones(_ context.Context, includeInactive bool) ([]entities.Zone, error) {
+	var zones []entities.Zone
+	for _, zone := range r.zones {
+		if includeInactive || zone.IsActive {
+			zones = append(zones, *zone)
+		}
+	}
+	return zones, nil
+}
+
+func (r *memoryZoneRepository) ExistsCode(_ context.Context, code, excludeID string) (bool, error) {
+	for _, zone := range r.zones {
+		if zone.Code == code && zone.ID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryZoneRepository) GetCoverageAreas(_ context.Context) ([]entities.ZoneCoverageArea, error) {
+	r.coverageLoads++
+	var areas []entities.ZoneCoverageArea
+	for _, zone := range r.zones {
+		if zone.IsActive {
+			areas = append(areas, entities.ZoneCoverageArea{
+				ZoneID:        zone.ID,
+				PriorityLevel: zone.PriorityLevel,
+				Boundaries:    zone.Boundaries,
+				CoverageArea:  r.coverage[zone.ID],
+			})
+		}
+	}
+	return areas, nil
+}
+
+func zoneInput(boundaries string) *entities.ZoneInput {
+	return &entities.ZoneInput{
+		Name:            "Zona Norte",
+		Code:            "znorte",
+		Boundaries:      boundaries,
+		BaseRate:        5.5,
+		MaxDeliveryTime: 60,
+	}
+}
+
+func TestCreateZone_FromGeoJSONComputesCenterPoint(t *testing.T) {
+	repo := newMemoryZoneRepository()
+	service := services.NewZoneService(repo, services.NewZoneLookupService(repo, 0))
+
+	zone, err := service.CreateZone(context.Background(), zoneInput(squareGeoJSON))
+	if err != nil {
+		t.Fatalf("CreateZone() error = %v", err)
+	}
+
+	if zone.Code != "ZNORTE" || zone.PriorityLevel != 1 || !zone.IsActive {
+		t.Errorf("unexpected zone %+v", zone)
+	}
+	if zone.Boundaries != "POLYGON((-74.030000 4.700000, -74.010000 4.700000, -74.010000 4.720000, -74.030000 4.720000, -74.030000 4.700000))" {
+		t.Errorf("boundaries = %s", zone.Boundaries)
+	}
+
+	center, err := value_objects.NewGeoPointFromWKT(zone.CenterPoint)
+	if err != nil {
+		t.Fatalf("center point %q is not WKT: %v", zone.CenterPoint, err)
+	}
+	if math.Abs(center.Latitude()-4.71) > 1e-6 || math.Abs(center.Longitude()+74.02) > 1e-6 {
+		t.Errorf("center = %f,%f, want 4.71,-74.02", center.Latitude(), center.Longitude())
+	}
+}
+
+func TestCreateZone_AcceptsWKTAndRejectsDuplicateCode(t *testing.T) {
+	service := newZoneService()
+	ctx := context.Background()
+
+	if _, err := service.CreateZone(ctx, zoneInput("POLYGON((-74.03 4.70, -74.01 4.70, -74.02 4.72, -74.03 4.70))")); err != nil {
+		t.Fatalf("CreateZone() error = %v", err)
+	}
+
+	_, err := service.CreateZone(ctx, zoneInput(squareGeoJSON))
+	if !errors.Is(err, errPackage.ErrZoneCodeAlreadyExists) {
+		t.Errorf("CreateZone() error = %v, want ErrZoneCodeAlreadyExists", err)
+	}
+}
+
+func TestCreateZone_RejectsInvalidBoundaries(t *testing.T) {
+	service := newZoneService()
+
+	cases := map[string]string{
+		"not closed":        "POLYGON((-74.03 4.70, -74.01 4.70, -74.01 4.72, -74.03 4.72))",
+		"self intersecting": "POLYGON((-74.03 4.70, -74.01 4.72, -74.01 4.70, -74.03 4.72, -74.03 4.70))",
+		"collinear":         "POLYGON((-74.03 4.70, -74.02 4.70, -74.01 4.70, -74.03 4.70))",
+		"out of range":      `{"type":"Polygon","coordinates":[[[-74.03,94.70],[-74.01,94.70],[-74.01,94.72],[-74.03,94.70]]]}`,
+		"not a polygon":     `{"type":"Point","coordinates":[-74.03,4.70]}`,
+		"empty":             "",
+	}
+	for name, boundaries := range cases {
+		_, err := service.CreateZone(context.Background(), zoneInput(boundaries))
+		if !errors.Is(err, errPackage.ErrInvalidZoneBoundaries) {
+			t.Errorf("%s: error = %v, want ErrInvalidZoneBoundaries", name, err)
+		}
+	}
+}
+
+func TestUpdateAndDeleteZone(t *testing.T) {
+	repo := newMemoryZoneRepository()
+	service := services.NewZoneService(repo, services.NewZoneLookupService(repo, 0))
+	ctx := context.Background()
+
+	zone, err := service.CreateZone(ctx, zoneInput(squareGeoJSON))
+	if err != nil {
+		t.Fatalf("CreateZone() error = %v", err)
+	}
+
+	// Sin estado se conserva el actual y el centro se recalcula con los límites nuevos
+	input := zoneInput("POLYGON((-74.03 4.70, -73.99 4.70, -73.99 4.74, -74.03 4.74, -74.03 4.70))")
+	input.PriorityLevel = 3
+	updated, err := service.UpdateZone(ctx, zone.ID, input)
+	if err != nil {
+		t.Fatalf("UpdateZone() error = %v", err)
+	}
+	if !updated.IsActive || updated.PriorityLevel != 3 || updated.CenterPoint != "POINT(-74.010000 4.720000)" {
+		t.Errorf("unexpected updated zone %+v", updated)
+	}
+
+	if err = service.DeleteZone(ctx, zone.ID); err != nil {
+		t.Fatalf("DeleteZone() error = %v", err)
+	}
+	if active, _ := service.GetZones(ctx, false); len(active) != 0 {
+		t.Errorf("GetZones() returned %d active zones after delete", len(active))
+	}
+	if all, _ := service.GetZones(ctx, true); len(all) != 1 {
+		t.Errorf("GetZones(includeInactive) returned %d zones, want 1", len(all))
+	}
+
+	err = service.DeleteZone(ctx, "missing")
+	if !errors.Is(err, errPackage.ErrZoneNotFound) {
+		t.Errorf("DeleteZone() error = %v, want ErrZoneNotFound", err)
+	}
+}
+
+func TestZoneRequestToInput_AcceptsObjectOrString(t *testing.T) {
+	cases := map[string]string{
+		squareGeoJSON:              squareGeoJSON,
+		`"POLYGON((1 1, 2 2))"`:    "POLYGON((1 1, 2 2))",
+		`"{\"type\":\"Polygon\"}"`: `{"type":"Polygon"}`,
+	}
+	for raw, want := range cases {
+		input := request_mapper.ZoneRequestToInput(&dto.ZoneRequest{Boundaries: []byte(raw)})
+		if input.Boundaries != want {
+			t.Errorf("boundaries from %s = %s, want %s", raw, input.Boundaries, want)
+		}
+	}
+}